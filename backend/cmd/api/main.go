@@ -6,10 +6,12 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/worker"
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/api/router"
 	"github.com/joho/godotenv"
 	"go.uber.org/zap"
@@ -82,6 +84,30 @@ func main() {
 		}
 	}()
 
+	// バックグラウンドジョブのワーカーとドメインイベントのディスパッチャー起動（ゴルーチン）
+	// イベントはAPIの書き込みと同じトランザクションでアウトボックスに溜まるため、ディスパッチャーはワーカー数によらず起動する
+	workerDone := make(chan struct{})
+	go func() {
+		defer close(workerDone)
+		jobCfg := container.Config.Job
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// イベントの配信はジョブの追加だけで軽いため、1つで十分
+			worker.NewPool(container.EventDispatcher, 1, jobCfg.PollInterval, logger).Run(ctx)
+		}()
+
+		if jobCfg.Workers == 0 {
+			logger.Warn("Job workers disabled; enqueued jobs are processed only by other instances")
+		} else {
+			logger.Info("Job workers started", zap.Int("workers", jobCfg.Workers))
+			worker.NewPool(container.JobUsecase, jobCfg.Workers, jobCfg.PollInterval, logger).Run(ctx)
+		}
+		wg.Wait()
+	}()
+
 	// シャットダウンシグナル待機
 	<-ctx.Done()
 	logger.Info("Shutdown signal received, starting graceful shutdown")
@@ -94,5 +120,12 @@ func main() {
 		logger.Error("Server forced to shutdown", zap.Error(err))
 	}
 
+	// 処理中のジョブが終わるまで待つ（終わらない場合は取得期限切れ後に他のワーカーが再実行する）
+	select {
+	case <-workerDone:
+	case <-shutdownCtx.Done():
+		logger.Error("Job workers did not stop in time")
+	}
+
 	logger.Info("Server exited")
 }
@@ -10,6 +10,20 @@ import (
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/telemetry"
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/api/middleware"
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/persistence/postgres"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/persistence/storage"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/sharetoken"
+	achievementusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/achievement"
+	collectionusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/collection"
+	eventusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/event"
+	jobusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/job"
+	photousecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/photo"
+	privacyusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/privacy"
+	recordusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/record"
+	shareusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/share"
+	socialusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/social"
+	statsusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/stats"
+	tagusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/tag"
+	thumbnailusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/thumbnail"
 	walkusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/walk"
 )
 
@@ -24,6 +38,18 @@ type Container struct {
 	WalkRepository         walk.Repository
 	WalkLocationRepository walk.LocationRepository
 	WalkUsecase            walkusecase.Usecase
+	StatsUsecase           statsusecase.Usecase
+	RecordUsecase          recordusecase.Usecase
+	AchievementUsecase     achievementusecase.Usecase
+	SocialUsecase          socialusecase.Usecase
+	TagUsecase             tagusecase.Usecase
+	CollectionUsecase      collectionusecase.Usecase
+	ShareUsecase           shareusecase.Usecase
+	PrivacyUsecase         privacyusecase.Usecase
+	PhotoUsecase           photousecase.Usecase
+	JobUsecase             jobusecase.Usecase
+	EventDispatcher        eventusecase.Dispatcher
+	LocalStorage           *storage.LocalStorage // STORAGE_BACKEND=local の場合のみ設定（開発用のファイル配信に使う）
 }
 
 // NewContainer は新しいコンテナを生成する
@@ -62,6 +88,32 @@ func NewContainer(ctx context.Context) (*Container, error) {
 	userRepo := postgres.NewUserRepository(db.DB)
 	walkRepo := postgres.NewWalkRepository(db.DB)
 	walkLocationRepo := postgres.NewWalkLocationRepository(db.DB)
+	statsRepo := postgres.NewStatsRepository(db.DB)
+	recordRepo := postgres.NewRecordRepository(db.DB)
+	achievementRepo := postgres.NewAchievementRepository(db.DB)
+	socialRepo := postgres.NewSocialRepository(db.DB)
+	tagRepo := postgres.NewTagRepository(db.DB)
+	collectionRepo := postgres.NewCollectionRepository(db.DB)
+	shareRepo := postgres.NewShareRepository(db.DB)
+	privacyZoneRepo := postgres.NewPrivacyZoneRepository(db.DB)
+	photoRepo := postgres.NewPhotoRepository(db.DB)
+	jobRepo := postgres.NewJobRepository(db.DB)
+	outboxRepo := postgres.NewOutboxRepository(db.DB)
+	txManager := postgres.NewTxManager(db.DB)
+
+	// Storage初期化
+	var objectStorage storage.Storage
+	var localStorage *storage.LocalStorage
+	switch cfg.Storage.Backend {
+	case config.StorageBackendLocal:
+		localStorage, err = storage.NewLocalStorage(cfg.Storage.LocalDir, cfg.Storage.LocalBaseURL)
+		objectStorage = localStorage
+	default:
+		objectStorage, err = storage.NewCloudStorageClient(cfg.Storage.BucketName)
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	// AuthMiddleware初期化
 	// Firebase認証情報はCredentialsJSON または CredentialsPath から取得
@@ -75,7 +127,32 @@ func NewContainer(ctx context.Context) (*Container, error) {
 	}
 
 	// Usecase初期化
-	walkUsecase := walkusecase.NewInteractor(walkRepo, walkLocationRepo)
+	recordUsecase := recordusecase.NewInteractor(recordRepo, cfg.Record.TimeZone)
+	achievementUsecase := achievementusecase.NewInteractor(achievementRepo, recordRepo, statsRepo)
+	thumbnailUsecase := thumbnailusecase.NewInteractor(walkRepo, objectStorage, log)
+	// 実績は更新後の連続記録・自己ベストで判定するため、記録の後に呼び出す
+	completionRecorders := jobusecase.CompletionRecorders{recordUsecase, achievementUsecase}
+	// 散歩の完了の反映はジョブとして追加し、ワーカーが処理する
+	// サムネイルの生成は失敗しても記録・実績に影響しないよう別のジョブにする
+	jobUsecase := jobusecase.NewInteractor(jobRepo, txManager, map[string]jobusecase.Handler{
+		jobusecase.TypeWalkCompleted: jobusecase.NewWalkCompletedHandler(walkRepo, walkLocationRepo, completionRecorders),
+		jobusecase.TypeWalkThumbnail: jobusecase.NewWalkCompletedHandler(walkRepo, walkLocationRepo, thumbnailUsecase),
+	}, cfg.Job.MaxAttempts, log)
+	// 散歩のドメインイベントはアウトボックスから購読者に配信する
+	eventDispatcher := eventusecase.NewDispatcher(outboxRepo, txManager, map[string][]eventusecase.Subscriber{
+		walk.EventWalkCompleted: {
+			jobusecase.NewCompletionSubscriber(jobUsecase, jobusecase.TypeWalkCompleted),
+			jobusecase.NewCompletionSubscriber(jobUsecase, jobusecase.TypeWalkThumbnail),
+		},
+	}, log)
+	photoUsecase := photousecase.NewInteractor(photoRepo, walkRepo, objectStorage, log)
+	walkUsecase := walkusecase.NewInteractor(walkRepo, walkLocationRepo, txManager, socialRepo, privacyZoneRepo, photoUsecase, thumbnailUsecase, cfg.Route.PolylineTolerance, log)
+	statsUsecase := statsusecase.NewInteractor(statsRepo, cfg.Record.TimeZone)
+	socialUsecase := socialusecase.NewInteractor(socialRepo, userRepo)
+	tagUsecase := tagusecase.NewInteractor(tagRepo)
+	collectionUsecase := collectionusecase.NewInteractor(collectionRepo)
+	privacyUsecase := privacyusecase.NewInteractor(privacyZoneRepo)
+	shareUsecase := shareusecase.NewInteractor(shareRepo, walkRepo, walkLocationRepo, privacyZoneRepo, sharetoken.NewSigner(cfg.Share.SigningKey))
 
 	return &Container{
 		Config:                 cfg,
@@ -86,6 +163,18 @@ func NewContainer(ctx context.Context) (*Container, error) {
 		WalkRepository:         walkRepo,
 		WalkLocationRepository: walkLocationRepo,
 		WalkUsecase:            walkUsecase,
+		StatsUsecase:           statsUsecase,
+		RecordUsecase:          recordUsecase,
+		AchievementUsecase:     achievementUsecase,
+		SocialUsecase:          socialUsecase,
+		TagUsecase:             tagUsecase,
+		CollectionUsecase:      collectionUsecase,
+		ShareUsecase:           shareUsecase,
+		PrivacyUsecase:         privacyUsecase,
+		PhotoUsecase:           photoUsecase,
+		JobUsecase:             jobUsecase,
+		EventDispatcher:        eventDispatcher,
+		LocalStorage:           localStorage,
 	}, nil
 }
 
@@ -0,0 +1,61 @@
+package achievement
+
+import (
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/record"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/stats"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/google/uuid"
+)
+
+// Code は実績（バッジ）の識別子
+type Code string
+
+// Achievement はユーザーが獲得した実績
+type Achievement struct {
+	UserID    string
+	Code      Code
+	WalkID    *uuid.UUID // 獲得のきっかけになった散歩（削除された場合はnil）
+	AwardedAt time.Time
+}
+
+// NewAchievement は散歩をきっかけに獲得した実績を生成する
+func NewAchievement(userID string, code Code, walkID uuid.UUID) *Achievement {
+	return &Achievement{
+		UserID:    userID,
+		Code:      code,
+		WalkID:    &walkID,
+		AwardedAt: time.Now(),
+	}
+}
+
+// Facts は実績の判定に使用する値
+// 散歩の完了を反映した後の値であること
+type Facts struct {
+	Walk    *walk.Walk              // 完了した散歩
+	Records *record.PersonalRecords // 連続記録・自己ベスト
+	Totals  stats.Bucket            // 完了済みの全散歩の合計
+}
+
+// Rule は実績の獲得条件
+type Rule struct {
+	Code        Code
+	Title       string
+	Description string
+	Satisfied   func(f Facts) bool
+}
+
+// Evaluate は未獲得のルールのうち条件を満たすものを定義順に返す
+func Evaluate(rules []Rule, f Facts, awarded map[Code]bool) []Rule {
+	earned := make([]Rule, 0)
+	for _, rule := range rules {
+		if awarded[rule.Code] {
+			continue
+		}
+		if rule.Satisfied(f) {
+			earned = append(earned, rule)
+		}
+	}
+	return earned
+}
@@ -0,0 +1,91 @@
+package achievement
+
+import (
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/record"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/stats"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+)
+
+// newFacts はテスト用の判定値を生成する
+func newFacts(distance float64, walkCount int, longestStreak int) Facts {
+	w := walk.NewWalk("user-1", "Walk", "")
+	w.TotalDistance = distance
+	r := record.NewPersonalRecords("user-1")
+	r.LongestStreak = longestStreak
+	return Facts{
+		Walk:    w,
+		Records: r,
+		Totals:  stats.Bucket{WalkCount: walkCount, TotalDistance: distance},
+	}
+}
+
+func codesOf(rules []Rule) []Code {
+	codes := make([]Code, len(rules))
+	for i, r := range rules {
+		codes[i] = r.Code
+	}
+	return codes
+}
+
+// TestEvaluate は実績の判定テスト
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name    string
+		facts   Facts
+		awarded map[Code]bool
+		want    []Code
+	}{
+		{
+			// 期待値: 初めての散歩のみ獲得
+			name:  "first short walk",
+			facts: newFacts(1200, 1, 1),
+			want:  []Code{CodeFirstWalk},
+		},
+		{
+			// 期待値: 10km以上なら5kmと10kmの両方を定義順に獲得
+			name:  "first long walk",
+			facts: newFacts(10500, 1, 1),
+			want:  []Code{CodeFirstWalk, CodeFirst5km, CodeFirst10km},
+		},
+		{
+			// 期待値: 獲得済みの実績は再度返さない
+			name:    "already awarded",
+			facts:   newFacts(6000, 10, 7),
+			awarded: map[Code]bool{CodeFirstWalk: true, CodeFirst5km: true},
+			want:    []Code{CodeWalks10, CodeStreak7Days},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := codesOf(Evaluate(Rules(), tt.facts, tt.awarded))
+			if len(got) != len(tt.want) {
+				t.Fatalf("Evaluate() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Evaluate() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+// TestRules_UniqueCodes はコードの重複がないことのテスト
+func TestRules_UniqueCodes(t *testing.T) {
+	seen := make(map[Code]bool)
+	for _, rule := range Rules() {
+		// 期待値: すべてのコードが一意
+		if seen[rule.Code] {
+			t.Errorf("duplicate achievement code: %s", rule.Code)
+		}
+		seen[rule.Code] = true
+
+		if _, ok := FindRule(rule.Code); !ok {
+			t.Errorf("FindRule(%s) not found", rule.Code)
+		}
+	}
+}
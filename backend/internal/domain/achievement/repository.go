@@ -0,0 +1,13 @@
+package achievement
+
+import (
+	"context"
+)
+
+// Repository は獲得した実績の永続化層へのインターフェース
+type Repository interface {
+	// FindByUserID はユーザーが獲得した実績を獲得日時の昇順で返す
+	FindByUserID(ctx context.Context, userID string) ([]*Achievement, error)
+	// Award は実績を付与する。獲得済みの場合は何もせず false を返す
+	Award(ctx context.Context, a *Achievement) (bool, error)
+}
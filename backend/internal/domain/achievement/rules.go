@@ -0,0 +1,88 @@
+package achievement
+
+// 定義済みの実績コード
+const (
+	CodeFirstWalk     Code = "first_walk"
+	CodeWalks10       Code = "walks_10"
+	CodeWalks100      Code = "walks_100"
+	CodeFirst5km      Code = "first_5km"
+	CodeFirst10km     Code = "first_10km"
+	CodeStreak7Days   Code = "streak_7_days"
+	CodeStreak30Days  Code = "streak_30_days"
+	CodeTotal100km    Code = "total_100km"
+	CodeSteps10kInDay Code = "steps_10000_in_day"
+)
+
+// rules は定義済みの実績の獲得条件（表示順）
+// 実績を追加する場合はここに追記する。コードは永続化されるため変更しないこと
+var rules = []Rule{
+	{
+		Code:        CodeFirstWalk,
+		Title:       "First steps",
+		Description: "Complete your first walk",
+		Satisfied:   func(f Facts) bool { return f.Totals.WalkCount >= 1 },
+	},
+	{
+		Code:        CodeWalks10,
+		Title:       "Regular walker",
+		Description: "Complete 10 walks",
+		Satisfied:   func(f Facts) bool { return f.Totals.WalkCount >= 10 },
+	},
+	{
+		Code:        CodeWalks100,
+		Title:       "Centurion",
+		Description: "Complete 100 walks",
+		Satisfied:   func(f Facts) bool { return f.Totals.WalkCount >= 100 },
+	},
+	{
+		Code:        CodeFirst5km,
+		Title:       "First 5 km",
+		Description: "Walk 5 km in a single walk",
+		Satisfied:   func(f Facts) bool { return f.Walk.TotalDistance >= 5000 },
+	},
+	{
+		Code:        CodeFirst10km,
+		Title:       "First 10 km",
+		Description: "Walk 10 km in a single walk",
+		Satisfied:   func(f Facts) bool { return f.Walk.TotalDistance >= 10000 },
+	},
+	{
+		Code:        CodeStreak7Days,
+		Title:       "One week streak",
+		Description: "Walk 7 days in a row",
+		Satisfied:   func(f Facts) bool { return f.Records.LongestStreak >= 7 },
+	},
+	{
+		Code:        CodeStreak30Days,
+		Title:       "One month streak",
+		Description: "Walk 30 days in a row",
+		Satisfied:   func(f Facts) bool { return f.Records.LongestStreak >= 30 },
+	},
+	{
+		Code:        CodeTotal100km,
+		Title:       "100 km club",
+		Description: "Walk 100 km in total",
+		Satisfied:   func(f Facts) bool { return f.Totals.TotalDistance >= 100000 },
+	},
+	{
+		Code:        CodeSteps10kInDay,
+		Title:       "10,000 steps",
+		Description: "Walk 10,000 steps in a day",
+		Satisfied:   func(f Facts) bool { return f.Records.MostStepsInDay >= 10000 },
+	},
+}
+
+// Rules は定義済みの実績の獲得条件を表示順に返す
+func Rules() []Rule {
+	return append([]Rule(nil), rules...)
+}
+
+// FindRule はコードに対応する獲得条件を返す
+func FindRule(code Code) (Rule, bool) {
+	for _, rule := range rules {
+		if rule.Code == code {
+			return rule, true
+		}
+	}
+	return Rule{}, false
+}
@@ -0,0 +1,100 @@
+package collection
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxNameLength はコレクション名の最大文字数（collections.name VARCHAR(100)）
+	MaxNameLength = 100
+	// MaxDescriptionLength はコレクションの説明の最大文字数
+	MaxDescriptionLength = 1000
+	// MaxWalks は1件のコレクションに含められる散歩の最大数
+	MaxWalks = 500
+)
+
+var (
+	// ErrCollectionNotFound はコレクションが存在しない、または他のユーザーのコレクションであることを表す
+	ErrCollectionNotFound = errors.New("collection not found")
+	// ErrWalkNotInCollection はコレクションに含まれていない散歩を外そうとしたことを表す
+	ErrWalkNotInCollection = errors.New("walk not in collection")
+)
+
+// Collection はユーザーが名前を付けて散歩をまとめたもの
+// WalkIDs の順序がコレクション内の表示順となる
+type Collection struct {
+	ID          uuid.UUID
+	UserID      string
+	Name        string
+	Description string
+	WalkIDs     []uuid.UUID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewCollection は散歩を含まない新しいコレクションを生成する
+func NewCollection(userID, name, description string) *Collection {
+	now := time.Now()
+	return &Collection{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Name:        name,
+		Description: description,
+		WalkIDs:     []uuid.UUID{},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// SetWalks はコレクション内の散歩と並び順を置き換える
+// 重複するIDは最初の位置のみ残す
+func (c *Collection) SetWalks(walkIDs []uuid.UUID) {
+	ids := make([]uuid.UUID, 0, len(walkIDs))
+	seen := make(map[uuid.UUID]bool, len(walkIDs))
+	for _, id := range walkIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	c.WalkIDs = ids
+	c.UpdatedAt = time.Now()
+}
+
+// AddWalk は散歩を末尾に追加する
+// 既に含まれている場合は並び順を変えずに false を返す
+func (c *Collection) AddWalk(walkID uuid.UUID) bool {
+	if c.Contains(walkID) {
+		return false
+	}
+	c.WalkIDs = append(c.WalkIDs, walkID)
+	c.UpdatedAt = time.Now()
+	return true
+}
+
+// RemoveWalk は散歩をコレクションから外す
+// 含まれていない場合は false を返す
+func (c *Collection) RemoveWalk(walkID uuid.UUID) bool {
+	for idx, id := range c.WalkIDs {
+		if id == walkID {
+			c.WalkIDs = append(c.WalkIDs[:idx], c.WalkIDs[idx+1:]...)
+			c.UpdatedAt = time.Now()
+			return true
+		}
+	}
+	return false
+}
+
+// Contains は散歩がコレクションに含まれているかどうかを返す
+func (c *Collection) Contains(walkID uuid.UUID) bool {
+	for _, id := range c.WalkIDs {
+		if id == walkID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// TestCollection_SetWalks は散歩の並び順置き換えのテスト
+func TestCollection_SetWalks(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	col := NewCollection("alice", "京都旅行", "")
+
+	col.SetWalks([]uuid.UUID{b, a, b, c, a})
+
+	// 期待値: 重複は最初の位置のみ残り、指定順を保つ
+	want := []uuid.UUID{b, a, c}
+	if !reflect.DeepEqual(col.WalkIDs, want) {
+		t.Errorf("WalkIDs = %v, want %v", col.WalkIDs, want)
+	}
+}
+
+// TestCollection_AddRemoveWalk は散歩の追加・削除のテスト
+func TestCollection_AddRemoveWalk(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+	col := NewCollection("alice", "朝の散歩", "")
+
+	// 期待値: 末尾に追加され、追加済みの散歩は追加されない
+	if !col.AddWalk(a) || !col.AddWalk(b) {
+		t.Fatal("AddWalk() = false, want true")
+	}
+	if col.AddWalk(a) {
+		t.Error("AddWalk(duplicate) = true, want false")
+	}
+	if want := []uuid.UUID{a, b}; !reflect.DeepEqual(col.WalkIDs, want) {
+		t.Errorf("WalkIDs = %v, want %v", col.WalkIDs, want)
+	}
+
+	// 期待値: 外した散歩以外の並び順は変わらない
+	if !col.RemoveWalk(a) {
+		t.Fatal("RemoveWalk() = false, want true")
+	}
+	if col.RemoveWalk(a) {
+		t.Error("RemoveWalk(missing) = true, want false")
+	}
+	if want := []uuid.UUID{b}; !reflect.DeepEqual(col.WalkIDs, want) {
+		t.Errorf("WalkIDs = %v, want %v", col.WalkIDs, want)
+	}
+}
@@ -0,0 +1,25 @@
+package collection
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository はコレクションの永続化層へのインターフェース
+// コレクションと散歩の並び順はまとめて保存する
+type Repository interface {
+	// ListByUserID はユーザーのコレクションを作成日時の降順で取得する
+	ListByUserID(ctx context.Context, userID string) ([]*Collection, error)
+	// FindByID はIDでコレクションを取得する。存在しない場合は sql.ErrNoRows を返す
+	FindByID(ctx context.Context, id uuid.UUID) (*Collection, error)
+	// Create はコレクションと含まれる散歩を保存する
+	Create(ctx context.Context, c *Collection) error
+	// Update はコレクションを更新し、含まれる散歩と並び順を置き換える
+	// 存在しない場合は sql.ErrNoRows を返す
+	Update(ctx context.Context, c *Collection) error
+	// Delete はコレクションを削除する（散歩自体は削除しない）。存在しない場合は sql.ErrNoRows を返す
+	Delete(ctx context.Context, id uuid.UUID) error
+	// CountOwnedWalks は指定した散歩のうちユーザーが所有するものの数を返す
+	CountOwnedWalks(ctx context.Context, userID string, walkIDs []uuid.UUID) (int, error)
+}
@@ -0,0 +1,107 @@
+package job
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status はジョブの状態
+type Status string
+
+const (
+	StatusPending Status = "pending" // 実行待ち（再試行待ちを含む）
+	StatusRunning Status = "running" // ワーカーが処理中
+	StatusDead    Status = "dead"    // 再試行の上限に達した、または再試行しても成功しない（デッドレター）
+)
+
+const (
+	// DefaultMaxAttempts は試行回数の上限のデフォルト値
+	DefaultMaxAttempts = 5
+	// BaseBackoff は1回目の失敗後に再試行するまでの待ち時間
+	BaseBackoff = 10 * time.Second
+	// MaxBackoff は再試行するまでの待ち時間の上限
+	MaxBackoff = time.Hour
+	// LeaseDuration は処理中のジョブを他のワーカーが取得し直すまでの時間
+	// ワーカーが処理中に異常終了した場合でも、この時間が過ぎれば再実行される
+	LeaseDuration = 5 * time.Minute
+)
+
+// Job はバックグラウンドで実行する処理
+// 成功したジョブは削除し、デッドレターになったジョブは調査のために残す
+type Job struct {
+	ID          uuid.UUID
+	Type        string // 処理の種類（ハンドラーの登録名）
+	Payload     []byte // 処理に渡すJSON
+	Status      Status
+	Attempts    int        // 取得された回数（処理中の試行を含む）
+	MaxAttempts int        // 試行回数の上限
+	RunAt       time.Time  // この日時以降に実行する
+	LastError   *string    // 直近の失敗の内容
+	LockedAt    *time.Time // ワーカーが取得した日時
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewJob は新しいジョブを生成する
+// maxAttempts が1未満の場合は DefaultMaxAttempts を使う
+func NewJob(jobType string, payload []byte, maxAttempts int) *Job {
+	if maxAttempts < 1 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	now := time.Now()
+	return &Job{
+		ID:          uuid.New(),
+		Type:        jobType,
+		Payload:     payload,
+		Status:      StatusPending,
+		MaxAttempts: maxAttempts,
+		RunAt:       now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// Fail は処理の失敗を記録する
+// 試行回数が上限に達した場合はデッドレターにし、それ以外はバックオフ後に再試行する
+func (j *Job) Fail(err error, now time.Time) {
+	if j.Attempts >= j.MaxAttempts {
+		j.Bury(err, now)
+		return
+	}
+	j.record(err, now)
+	j.Status = StatusPending
+	j.RunAt = now.Add(Backoff(j.Attempts))
+}
+
+// Bury は再試行せずにデッドレターにする
+func (j *Job) Bury(err error, now time.Time) {
+	j.record(err, now)
+	j.Status = StatusDead
+}
+
+// IsDead はデッドレターかどうかを返す
+func (j *Job) IsDead() bool {
+	return j.Status == StatusDead
+}
+
+// record は失敗の内容を記録し、ワーカーの取得を解除する
+func (j *Job) record(err error, now time.Time) {
+	msg := err.Error()
+	j.LastError = &msg
+	j.LockedAt = nil
+	j.UpdatedAt = now
+}
+
+// Backoff は attempts 回目の失敗後に再試行するまでの待ち時間を返す
+// BaseBackoff から失敗ごとに2倍にし、MaxBackoff で打ち止めにする
+func Backoff(attempts int) time.Duration {
+	d := BaseBackoff
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= MaxBackoff {
+			return MaxBackoff
+		}
+	}
+	return d
+}
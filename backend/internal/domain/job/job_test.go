@@ -0,0 +1,80 @@
+package job
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJob(t *testing.T) {
+	t.Run("指定した試行回数の上限で生成する", func(t *testing.T) {
+		j := NewJob("walk.completed", []byte(`{}`), 3)
+
+		assert.NotEqual(t, uuid.Nil, j.ID)
+		assert.Equal(t, "walk.completed", j.Type)
+		assert.Equal(t, StatusPending, j.Status)
+		assert.Equal(t, 0, j.Attempts)
+		assert.Equal(t, 3, j.MaxAttempts)
+	})
+
+	t.Run("上限が1未満の場合はデフォルト値", func(t *testing.T) {
+		j := NewJob("walk.completed", []byte(`{}`), 0)
+		assert.Equal(t, DefaultMaxAttempts, j.MaxAttempts)
+	})
+}
+
+func TestJob_Fail(t *testing.T) {
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	locked := now.Add(-time.Second)
+
+	t.Run("上限未満の場合はバックオフ後に再試行する", func(t *testing.T) {
+		j := NewJob("walk.completed", nil, 3)
+		j.Status = StatusRunning
+		j.Attempts = 2
+		j.LockedAt = &locked
+
+		j.Fail(errors.New("boom"), now)
+
+		// 期待値: 2回目の失敗は BaseBackoff の2倍だけ待つ
+		assert.Equal(t, StatusPending, j.Status)
+		assert.Equal(t, now.Add(2*BaseBackoff), j.RunAt)
+		require.NotNil(t, j.LastError)
+		assert.Equal(t, "boom", *j.LastError)
+		assert.Nil(t, j.LockedAt)
+	})
+
+	t.Run("上限に達した場合はデッドレターにする", func(t *testing.T) {
+		j := NewJob("walk.completed", nil, 3)
+		j.Status = StatusRunning
+		j.Attempts = 3
+
+		j.Fail(errors.New("boom"), now)
+
+		assert.True(t, j.IsDead())
+		require.NotNil(t, j.LastError)
+		assert.Equal(t, "boom", *j.LastError)
+	})
+}
+
+func TestJob_Bury(t *testing.T) {
+	j := NewJob("unknown", nil, 3)
+	j.Attempts = 1
+
+	j.Bury(errors.New("no handler"), time.Now())
+
+	// 期待値: 上限に達していなくてもデッドレターにする
+	assert.True(t, j.IsDead())
+}
+
+func TestBackoff(t *testing.T) {
+	// 期待値: 失敗ごとに2倍になり、上限で打ち止めになる
+	assert.Equal(t, BaseBackoff, Backoff(1))
+	assert.Equal(t, 2*BaseBackoff, Backoff(2))
+	assert.Equal(t, 4*BaseBackoff, Backoff(3))
+	assert.Equal(t, MaxBackoff, Backoff(20))
+	assert.Equal(t, BaseBackoff, Backoff(0))
+}
@@ -0,0 +1,22 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository はジョブキューの永続化層へのインターフェース
+type Repository interface {
+	// Enqueue はジョブを追加する
+	Enqueue(ctx context.Context, j *Job) error
+	// ClaimNext は実行日時を過ぎたジョブを1件取得して処理中にし、試行回数を1増やす
+	// 他のワーカーが取得中のジョブは読み飛ばし、処理中のまま lease を過ぎたジョブは取得し直す
+	// 実行できるジョブがない場合は sql.ErrNoRows を返す
+	ClaimNext(ctx context.Context, lease time.Duration) (*Job, error)
+	// Complete は処理が成功したジョブを削除する
+	Complete(ctx context.Context, id uuid.UUID) error
+	// SaveFailure は失敗したジョブの状態（再試行日時・デッドレター・失敗の内容）を保存する
+	SaveFailure(ctx context.Context, j *Job) error
+}
@@ -0,0 +1,67 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/job"
+	"github.com/google/uuid"
+)
+
+// Status は配信待ちのイベントの状態
+type Status string
+
+const (
+	StatusPending Status = "pending" // 配信待ち（再配信待ちを含む）
+	StatusDead    Status = "dead"    // 配信の試行回数の上限に達した
+)
+
+const (
+	// MaxAttempts は配信の試行回数の上限
+	MaxAttempts = 10
+	// LeaseDuration は配信中のイベントを他のディスパッチャーが取得し直すまでの時間
+	LeaseDuration = time.Minute
+)
+
+// Message はアウトボックスに保存したドメインイベント
+// 集約の保存と同じトランザクションで書き込み、配信に成功したものは削除する
+type Message struct {
+	ID            int64 // 書き込み順の連番（同じ散歩のイベントはこの順に配信する）
+	WalkID        uuid.UUID
+	UserID        string
+	EventName     string
+	Payload       []byte // イベントのJSON
+	OccurredAt    time.Time
+	Status        Status
+	Attempts      int        // 取得された回数（配信中の試行を含む）
+	NextAttemptAt time.Time  // この日時以降に配信する
+	LastError     *string    // 直近の配信の失敗の内容
+	LockedAt      *time.Time // ディスパッチャーが取得した日時
+	CreatedAt     time.Time
+}
+
+// Fail は配信の失敗を記録する
+// 試行回数が上限に達した場合は配信をあきらめ、それ以外はバックオフ後に再配信する
+// 再配信を待つ間、同じ散歩の後続のイベントは配信されない
+func (m *Message) Fail(err error, now time.Time) {
+	msg := err.Error()
+	m.LastError = &msg
+	m.LockedAt = nil
+	if m.Attempts >= MaxAttempts {
+		m.Status = StatusDead
+		return
+	}
+	m.NextAttemptAt = now.Add(job.Backoff(m.Attempts))
+}
+
+// Bury は再配信せずに配信をあきらめる
+func (m *Message) Bury(err error) {
+	msg := err.Error()
+	m.LastError = &msg
+	m.LockedAt = nil
+	m.Status = StatusDead
+}
+
+// IsDead は配信をあきらめたかどうかを返す
+func (m *Message) IsDead() bool {
+	return m.Status == StatusDead
+}
@@ -0,0 +1,45 @@
+package outbox
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/job"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessage_Fail(t *testing.T) {
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	locked := now.Add(-time.Second)
+
+	t.Run("上限未満の場合はバックオフ後に再配信する", func(t *testing.T) {
+		m := &Message{Status: StatusPending, Attempts: 1, LockedAt: &locked}
+
+		m.Fail(errors.New("boom"), now)
+
+		assert.Equal(t, StatusPending, m.Status)
+		assert.Equal(t, now.Add(job.Backoff(1)), m.NextAttemptAt)
+		require.NotNil(t, m.LastError)
+		assert.Equal(t, "boom", *m.LastError)
+		assert.Nil(t, m.LockedAt)
+	})
+
+	t.Run("上限に達した場合は配信をあきらめる", func(t *testing.T) {
+		m := &Message{Status: StatusPending, Attempts: MaxAttempts}
+
+		m.Fail(errors.New("boom"), now)
+
+		assert.True(t, m.IsDead())
+	})
+}
+
+func TestMessage_Bury(t *testing.T) {
+	m := &Message{Status: StatusPending, Attempts: 1}
+
+	m.Bury(errors.New("unknown event"))
+
+	assert.True(t, m.IsDead())
+	require.NotNil(t, m.LastError)
+}
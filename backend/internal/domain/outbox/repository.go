@@ -0,0 +1,20 @@
+package outbox
+
+import (
+	"context"
+	"time"
+)
+
+// Repository はアウトボックスの永続化層へのインターフェース
+// イベントの書き込みは集約のリポジトリが集約の保存と同じトランザクションで行う
+type Repository interface {
+	// ClaimNext は配信日時を過ぎたイベントを1件取得し、試行回数を1増やす
+	// 同じ散歩により前の配信待ちのイベントがある場合は取得しない（散歩ごとに発生順に配信する）
+	// 他のディスパッチャーが取得中のイベントは読み飛ばし、lease を過ぎたものは取得し直す
+	// 配信できるイベントがない場合は sql.ErrNoRows を返す
+	ClaimNext(ctx context.Context, lease time.Duration) (*Message, error)
+	// MarkDispatched は配信に成功したイベントを削除する
+	MarkDispatched(ctx context.Context, id int64) error
+	// SaveFailure は配信に失敗したイベントの状態（再配信日時・失敗の内容）を保存する
+	SaveFailure(ctx context.Context, m *Message) error
+}
@@ -0,0 +1,66 @@
+package photo
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxSizeBytes はアップロードできる写真の最大サイズ（storage.rules の walk_photos と同じ10MB）
+const MaxSizeBytes = 10 << 20
+
+var (
+	// ErrPhotoNotFound は写真が存在しない、または他の散歩の写真であることを表す
+	ErrPhotoNotFound = errors.New("photo not found")
+)
+
+// contentTypeExtensions は保存できる写真の形式と保存時の拡張子
+var contentTypeExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+}
+
+// IsSupportedContentType は保存できる写真の形式かどうかを返す
+func IsSupportedContentType(contentType string) bool {
+	_, ok := contentTypeExtensions[contentType]
+	return ok
+}
+
+// Photo は散歩に添付された写真
+// 撮影日時と撮影場所は端末から送られた場合のみ保持する
+type Photo struct {
+	ID          uuid.UUID
+	WalkID      uuid.UUID
+	UserID      string
+	StoragePath string // ストレージ上のパス（walk_photos/{userId}/{walkId}/{photoId}.{ext}）
+	URL         string // アップロード時にストレージが返したURL
+	ContentType string
+	CapturedAt  *time.Time
+	Latitude    *float64
+	Longitude   *float64
+	CreatedAt   time.Time
+}
+
+// NewPhoto は新しい写真を生成する
+// 保存先のパスはストレージのルール（walk_photos/{userId}/{walkId}/{fileName}）に合わせて決める
+func NewPhoto(walkID uuid.UUID, userID, contentType string, capturedAt *time.Time, latitude, longitude *float64) *Photo {
+	id := uuid.New()
+	return &Photo{
+		ID:          id,
+		WalkID:      walkID,
+		UserID:      userID,
+		StoragePath: fmt.Sprintf("walk_photos/%s/%s/%s.%s", userID, walkID, id, contentTypeExtensions[contentType]),
+		ContentType: contentType,
+		CapturedAt:  capturedAt,
+		Latitude:    latitude,
+		Longitude:   longitude,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// HasLocation は撮影場所を持つかどうかを返す
+func (p *Photo) HasLocation() bool {
+	return p.Latitude != nil && p.Longitude != nil
+}
@@ -0,0 +1,28 @@
+package photo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPhoto(t *testing.T) {
+	walkID := uuid.New()
+	lat, lng := 35.68, 139.76
+
+	p := NewPhoto(walkID, "user-1", "image/png", nil, &lat, &lng)
+
+	// 期待値: ストレージのルールに合わせたパスに保存する
+	assert.Equal(t, fmt.Sprintf("walk_photos/user-1/%s/%s.png", walkID, p.ID), p.StoragePath)
+	assert.True(t, p.HasLocation())
+	assert.False(t, NewPhoto(walkID, "user-1", "image/jpeg", nil, &lat, nil).HasLocation())
+}
+
+func TestIsSupportedContentType(t *testing.T) {
+	assert.True(t, IsSupportedContentType("image/jpeg"))
+	assert.True(t, IsSupportedContentType("image/png"))
+	assert.False(t, IsSupportedContentType("image/gif"))
+	assert.False(t, IsSupportedContentType("application/pdf"))
+}
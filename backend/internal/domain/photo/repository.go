@@ -0,0 +1,20 @@
+package photo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository は写真の永続化層へのインターフェース
+// 散歩の削除時は写真の行もカスケード削除される（保存済みのファイルは呼び出し側で削除する）
+type Repository interface {
+	// Create は写真を作成する
+	Create(ctx context.Context, p *Photo) error
+	// FindByID はIDで写真を取得する。存在しない場合は sql.ErrNoRows を返す
+	FindByID(ctx context.Context, id uuid.UUID) (*Photo, error)
+	// ListByWalkID は散歩の写真を撮影日時（ない場合は作成日時）の昇順で取得する
+	ListByWalkID(ctx context.Context, walkID uuid.UUID) ([]*Photo, error)
+	// Delete は写真を削除する。存在しない場合は sql.ErrNoRows を返す
+	Delete(ctx context.Context, id uuid.UUID) error
+}
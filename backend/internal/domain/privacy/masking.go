@@ -0,0 +1,125 @@
+package privacy
+
+import (
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/photo"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/polyline"
+)
+
+// Masker は所有者以外に見せる散歩から、プライバシーゾーン内の位置情報を取り除くドメインサービス
+// ゾーン内の点は座標をぼかすのではなく除外する（ぼかした点を集めると中心を推定できるため）
+type Masker struct {
+	zones []*Zone
+}
+
+// NewMasker は散歩の所有者のプライバシーゾーンで Masker を生成する
+func NewMasker(zones []*Zone) *Masker {
+	return &Masker{zones: zones}
+}
+
+// Hides は座標がいずれかのゾーンの範囲内かどうかを返す
+func (m *Masker) Hides(latitude, longitude float64) bool {
+	for _, z := range m.zones {
+		if z.Contains(latitude, longitude) {
+			return true
+		}
+	}
+	return false
+}
+
+// MaskLocations はゾーン外の位置情報のみを順序を保って返す
+func (m *Masker) MaskLocations(locations []*walk.WalkLocation) []*walk.WalkLocation {
+	if len(m.zones) == 0 {
+		return locations
+	}
+
+	masked := make([]*walk.WalkLocation, 0, len(locations))
+	for _, loc := range locations {
+		if !m.Hides(loc.Latitude, loc.Longitude) {
+			masked = append(masked, loc)
+		}
+	}
+	return masked
+}
+
+// MaskPhotos はゾーン内で撮影された写真を除いて返す（撮影場所のない写真は含める）
+func (m *Masker) MaskPhotos(photos []*photo.Photo) []*photo.Photo {
+	if len(m.zones) == 0 {
+		return photos
+	}
+
+	masked := make([]*photo.Photo, 0, len(photos))
+	for _, p := range photos {
+		if p.HasLocation() && m.Hides(*p.Latitude, *p.Longitude) {
+			continue
+		}
+		masked = append(masked, p)
+	}
+	return masked
+}
+
+// crosses は2点を結ぶ区間がいずれかのゾーンを横切るかどうかを返す
+func (m *Masker) crosses(a, b polyline.Point) bool {
+	for _, z := range m.zones {
+		if z.IntersectsSegment(a.Lat, a.Lng, b.Lat, b.Lng) {
+			return true
+		}
+	}
+	return false
+}
+
+// MaskWalk はポリラインからゾーン内の点を除いて再エンコードしたWalkのコピーを返す
+// 両端の点がゾーン外でもゾーンを横切る区間は、実際の経路を描かないよう両端の点も除く
+// 経路がゾーンに触れる場合、元の経路から描かれたサムネイルも含めない
+// 残りが2点未満の場合はポリラインを含めない
+func (m *Masker) MaskWalk(w *walk.Walk) *walk.Walk {
+	masked := *w
+	if len(m.zones) == 0 || w.PolylineData == nil {
+		return &masked
+	}
+
+	points, err := polyline.Decode(*w.PolylineData)
+	if err != nil {
+		// 復号できない経路はゾーン内を含まないことを確認できないため、見せない
+		masked.PolylineData = nil
+		masked.ThumbnailImageURL = nil
+		return &masked
+	}
+
+	removed := make([]bool, len(points))
+	touched := false
+	for i, p := range points {
+		if m.Hides(p.Lat, p.Lng) {
+			removed[i] = true
+			touched = true
+		}
+	}
+	// 簡略化したポリラインの頂点は疎なため、頂点がゾーン外でも区間がゾーンを横切ることがある
+	for i := 1; i < len(points); i++ {
+		if removed[i-1] || removed[i] {
+			continue
+		}
+		if m.crosses(points[i-1], points[i]) {
+			removed[i-1], removed[i] = true, true
+			touched = true
+		}
+	}
+	if !touched {
+		return &masked
+	}
+
+	kept := make([]polyline.Point, 0, len(points))
+	for i, p := range points {
+		if !removed[i] {
+			kept = append(kept, p)
+		}
+	}
+
+	masked.ThumbnailImageURL = nil
+	masked.PolylineData = nil
+	if len(kept) >= 2 {
+		encoded := polyline.Encode(kept)
+		masked.PolylineData = &encoded
+	}
+	return &masked
+}
@@ -0,0 +1,143 @@
+package privacy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/photo"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/polyline"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newEastwardRoute は赤道上を経度0.001度（約111.2m）ずつ東へ進む位置情報を生成する
+func newEastwardRoute(points int) []*walk.WalkLocation {
+	walkID := uuid.New()
+	start := time.Now()
+	locations := make([]*walk.WalkLocation, points)
+	for i := range locations {
+		locations[i] = walk.NewWalkLocationWithOptionals(
+			walkID, 0, float64(i)*0.001, nil, start.Add(time.Duration(i)*10*time.Second), nil, nil, nil, nil, i,
+		)
+	}
+	return locations
+}
+
+// encodeLocations は位置情報をそのままポリラインにエンコードする
+func encodeLocations(locations []*walk.WalkLocation) string {
+	points := make([]polyline.Point, len(locations))
+	for i, loc := range locations {
+		points[i] = polyline.Point{Lat: loc.Latitude, Lng: loc.Longitude}
+	}
+	return polyline.Encode(points)
+}
+
+// homeZone は散歩の出発地点（経度0）を中心とする半径150mのゾーン
+var homeZone = &Zone{Latitude: 0, Longitude: 0, RadiusMeters: 150}
+
+func TestMasker_MaskLocations(t *testing.T) {
+	route := newEastwardRoute(5)
+
+	masked := NewMasker([]*Zone{homeZone}).MaskLocations(route)
+
+	// 期待値: 出発地点から150m以内の2点を除き、残りは順序どおり
+	require.Len(t, masked, 3)
+	assert.Equal(t, []int{2, 3, 4}, []int{masked[0].SequenceNumber, masked[1].SequenceNumber, masked[2].SequenceNumber})
+
+	// 期待値: ゾーンがない場合はそのまま
+	assert.Equal(t, route, NewMasker(nil).MaskLocations(route))
+}
+
+func TestMasker_MaskWalk(t *testing.T) {
+	route := newEastwardRoute(5)
+	encoded := encodeLocations(route)
+	thumbnail := "https://example.com/thumb.png"
+
+	newWalk := func() *walk.Walk {
+		w := walk.NewWalk("owner", "Walk", "")
+		w.PolylineData = &encoded
+		w.ThumbnailImageURL = &thumbnail
+		return w
+	}
+
+	t.Run("trims points inside zones", func(t *testing.T) {
+		w := newWalk()
+
+		masked := NewMasker([]*Zone{homeZone}).MaskWalk(w)
+
+		// 期待値: ゾーン外の点のみでポリラインを再生成し、サムネイルを含めない
+		require.NotNil(t, masked.PolylineData)
+		assert.Equal(t, encodeLocations(route[2:]), *masked.PolylineData)
+		assert.Nil(t, masked.ThumbnailImageURL)
+		// 期待値: 元のWalkは変更しない
+		assert.Equal(t, encoded, *w.PolylineData)
+		assert.Equal(t, &thumbnail, w.ThumbnailImageURL)
+	})
+
+	t.Run("route outside zones is unchanged", func(t *testing.T) {
+		w := newWalk()
+		far := &Zone{Latitude: 10, Longitude: 10, RadiusMeters: 500}
+
+		masked := NewMasker([]*Zone{far}).MaskWalk(w)
+
+		assert.Equal(t, encoded, *masked.PolylineData)
+		assert.Equal(t, &thumbnail, masked.ThumbnailImageURL)
+	})
+
+	t.Run("segment crossing zone without vertices inside", func(t *testing.T) {
+		// 簡略化で頂点が間引かれ、ゾーンの手前と先の頂点だけが残った経路
+		sparse := []*walk.WalkLocation{route[0], route[2], route[3], route[4]}
+		sparseEncoded := encodeLocations(sparse)
+		w := newWalk()
+		w.PolylineData = &sparseEncoded
+		// 区間 route[0]→route[2]（経度0〜0.002）の中間を中心とする半径50mのゾーン（どの頂点も含まない）
+		between := &Zone{Latitude: 0, Longitude: 0.001, RadiusMeters: 50}
+		for _, loc := range sparse {
+			require.False(t, between.Contains(loc.Latitude, loc.Longitude))
+		}
+
+		masked := NewMasker([]*Zone{between}).MaskWalk(w)
+
+		// 期待値: ゾーンを横切る区間の両端を除いて再生成し、サムネイルも含めない
+		require.NotNil(t, masked.PolylineData)
+		assert.Equal(t, encodeLocations(route[3:]), *masked.PolylineData)
+		assert.Nil(t, masked.ThumbnailImageURL)
+	})
+
+	t.Run("route entirely inside zone", func(t *testing.T) {
+		w := newWalk()
+		large := &Zone{Latitude: 0, Longitude: 0.002, RadiusMeters: 1000}
+
+		masked := NewMasker([]*Zone{large}).MaskWalk(w)
+
+		// 期待値: 2点未満しか残らない場合はポリラインを含めない
+		assert.Nil(t, masked.PolylineData)
+		assert.Nil(t, masked.ThumbnailImageURL)
+	})
+
+	t.Run("undecodable polyline", func(t *testing.T) {
+		w := newWalk()
+		broken := "\x7f"
+		w.PolylineData = &broken
+
+		masked := NewMasker([]*Zone{homeZone}).MaskWalk(w)
+
+		// 期待値: ゾーン内を含まないことを確認できない経路は含めない
+		assert.Nil(t, masked.PolylineData)
+	})
+}
+
+func TestMasker_MaskPhotos(t *testing.T) {
+	walkID := uuid.New()
+	home, away := 0.0, 0.005
+	inside := photo.NewPhoto(walkID, "owner", "image/jpeg", nil, &home, &home)
+	outside := photo.NewPhoto(walkID, "owner", "image/jpeg", nil, &home, &away)
+	unlocated := photo.NewPhoto(walkID, "owner", "image/jpeg", nil, nil, nil)
+
+	masked := NewMasker([]*Zone{homeZone}).MaskPhotos([]*photo.Photo{inside, outside, unlocated})
+
+	// 期待値: ゾーン内で撮影された写真のみ除く
+	assert.Equal(t, []*photo.Photo{outside, unlocated}, masked)
+}
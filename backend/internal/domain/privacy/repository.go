@@ -0,0 +1,17 @@
+package privacy
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository はプライバシーゾーンの永続化層へのインターフェース
+type Repository interface {
+	// ListByUserID はユーザーのプライバシーゾーンを作成日時の昇順で取得する
+	ListByUserID(ctx context.Context, userID string) ([]*Zone, error)
+	// Create はプライバシーゾーンを作成する
+	Create(ctx context.Context, z *Zone) error
+	// Delete はユーザーのプライバシーゾーンを削除する。存在しない場合は sql.ErrNoRows を返す
+	Delete(ctx context.Context, id uuid.UUID, userID string) error
+}
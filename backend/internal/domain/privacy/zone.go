@@ -0,0 +1,70 @@
+package privacy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/polyline"
+	"github.com/google/uuid"
+)
+
+const (
+	// MinRadiusMeters はプライバシーゾーンの最小半径（メートル）
+	// 小さすぎると位置の推定を防げないため下限を設ける
+	MinRadiusMeters = 100.0
+	// MaxRadiusMeters はプライバシーゾーンの最大半径（メートル）
+	MaxRadiusMeters = 2000.0
+	// MaxNameLength はゾーン名の最大文字数（privacy_zones.name VARCHAR(50)）
+	MaxNameLength = 50
+	// MaxZonesPerUser はユーザーごとのプライバシーゾーンの上限
+	MaxZonesPerUser = 10
+)
+
+var (
+	// ErrZoneNotFound はプライバシーゾーンが存在しない、または他のユーザーのものであることを表す
+	ErrZoneNotFound = errors.New("privacy zone not found")
+	// ErrTooManyZones はユーザーのプライバシーゾーンが上限に達していることを表す
+	ErrTooManyZones = fmt.Errorf("cannot create more than %d privacy zones", MaxZonesPerUser)
+)
+
+// Zone は他のユーザーに位置情報を見せない円形の範囲（自宅の周辺など）
+type Zone struct {
+	ID           uuid.UUID
+	UserID       string
+	Name         string
+	Latitude     float64
+	Longitude    float64
+	RadiusMeters float64
+	CreatedAt    time.Time
+}
+
+// NewZone は新しいプライバシーゾーンを生成する（名前は前後の空白を除去する）
+// 中心座標と半径の範囲はユースケース層で検証する
+func NewZone(userID, name string, latitude, longitude, radiusMeters float64) *Zone {
+	return &Zone{
+		ID:           uuid.New(),
+		UserID:       userID,
+		Name:         strings.TrimSpace(name),
+		Latitude:     latitude,
+		Longitude:    longitude,
+		RadiusMeters: radiusMeters,
+		CreatedAt:    time.Now(),
+	}
+}
+
+// Contains は座標がゾーンの範囲内（境界を含む）かどうかを返す
+func (z *Zone) Contains(latitude, longitude float64) bool {
+	return walk.HaversineDistance(z.Latitude, z.Longitude, latitude, longitude) <= z.RadiusMeters
+}
+
+// IntersectsSegment は2点を結ぶ線分がゾーンの範囲を通るかどうかを返す
+// 両端の点がゾーン外でも、線分がゾーンを横切る場合はtrueを返す
+func (z *Zone) IntersectsSegment(lat1, lng1, lat2, lng2 float64) bool {
+	center := polyline.Point{Lat: z.Latitude, Lng: z.Longitude}
+	a := polyline.Point{Lat: lat1, Lng: lng1}
+	b := polyline.Point{Lat: lat2, Lng: lng2}
+	return polyline.DistanceToSegment(center, a, b) <= z.RadiusMeters
+}
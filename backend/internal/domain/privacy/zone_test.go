@@ -0,0 +1,38 @@
+package privacy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewZone(t *testing.T) {
+	z := NewZone("user-1", " 自宅 ", 35.68, 139.76, 200)
+
+	// 期待値: 名前は前後の空白を除去する
+	assert.Equal(t, "自宅", z.Name)
+	assert.Equal(t, "user-1", z.UserID)
+	assert.Equal(t, 200.0, z.RadiusMeters)
+}
+
+func TestZone_Contains(t *testing.T) {
+	// 赤道上では経度0.001度が約111.2m
+	z := &Zone{Latitude: 0, Longitude: 0, RadiusMeters: 150}
+
+	assert.True(t, z.Contains(0, 0))
+	assert.True(t, z.Contains(0, 0.001))
+	assert.False(t, z.Contains(0, 0.002))
+}
+
+func TestZone_IntersectsSegment(t *testing.T) {
+	z := &Zone{Latitude: 0, Longitude: 0, RadiusMeters: 150}
+
+	// 期待値: 両端がゾーン外でも、中心を通る線分は交差する
+	assert.True(t, z.IntersectsSegment(0, -0.002, 0, 0.002))
+	// 期待値: 中心から約111m離れた線分は半径150mのゾーンと交差する
+	assert.True(t, z.IntersectsSegment(0.001, -0.002, 0.001, 0.002))
+	// 期待値: 中心から約222m離れた線分は交差しない
+	assert.False(t, z.IntersectsSegment(0.002, -0.002, 0.002, 0.002))
+	// 期待値: ゾーンの手前で終わる線分は交差しない
+	assert.False(t, z.IntersectsSegment(0, -0.004, 0, -0.002))
+}
@@ -0,0 +1,111 @@
+package record
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FastestSplitDistance は最速ペースの記録対象とする区間距離（メートル）
+const FastestSplitDistance = 1000.0
+
+// PersonalRecords はユーザーの連続記録と自己ベストを表す
+// 散歩の完了ごとに差分で更新して永続化し、読み取り時に再集計しない
+type PersonalRecords struct {
+	UserID              string
+	CurrentStreak       int        // LastWalkDate で終わる連続日数
+	LongestStreak       int        // 最長の連続日数
+	LastWalkDate        *time.Time // 最後に散歩した日
+	LongestWalkID       *uuid.UUID // 最長距離の散歩
+	LongestWalkDistance float64    // 最長距離（メートル）
+	FastestKmWalkID     *uuid.UUID // 1km最速ペースの散歩
+	FastestKmPace       *float64   // 1km最速ペース（秒/km）。未記録の場合はnil
+	MostStepsDate       *time.Time // 歩数が最も多かった日
+	MostStepsInDay      int        // 1日の最多歩数
+	UpdatedAt           time.Time
+}
+
+// NewPersonalRecords は記録のないPersonalRecordsを生成する
+func NewPersonalRecords(userID string) *PersonalRecords {
+	return &PersonalRecords{
+		UserID:    userID,
+		UpdatedAt: time.Now(),
+	}
+}
+
+// DayOf は t の loc における暦日を返す
+// 日付の比較を単純にするため、その日のUTCの0時で表す
+func DayOf(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// ApplyWalkDay は散歩した日を連続記録に反映する
+// 最後に散歩した日より前の日は差分では反映できないため、false を返す。その場合は RebuildStreaks で再計算すること
+func (r *PersonalRecords) ApplyWalkDay(day time.Time) bool {
+	switch {
+	case r.LastWalkDate == nil:
+		r.CurrentStreak = 1
+	case day.Equal(*r.LastWalkDate):
+		return true
+	case day.Before(*r.LastWalkDate):
+		return false
+	case day.Equal(r.LastWalkDate.AddDate(0, 0, 1)):
+		r.CurrentStreak++
+	default:
+		r.CurrentStreak = 1
+	}
+
+	r.LastWalkDate = &day
+	if r.CurrentStreak > r.LongestStreak {
+		r.LongestStreak = r.CurrentStreak
+	}
+	r.UpdatedAt = time.Now()
+	return true
+}
+
+// RebuildStreaks は散歩した日の一覧から連続記録を再計算する
+// days は重複のない昇順であること
+func (r *PersonalRecords) RebuildStreaks(days []time.Time) {
+	r.CurrentStreak = 0
+	r.LongestStreak = 0
+	r.LastWalkDate = nil
+	for _, day := range days {
+		r.ApplyWalkDay(day)
+	}
+	r.UpdatedAt = time.Now()
+}
+
+// ApplyWalk は完了した散歩の距離と1km最速ペースを自己ベストと比較して更新する
+// kmPace は1km未満の散歩などで算出できない場合はnil
+func (r *PersonalRecords) ApplyWalk(walkID uuid.UUID, distance float64, kmPace *float64) {
+	if distance > r.LongestWalkDistance {
+		r.LongestWalkID = &walkID
+		r.LongestWalkDistance = distance
+		r.UpdatedAt = time.Now()
+	}
+	if kmPace != nil && (r.FastestKmPace == nil || *kmPace < *r.FastestKmPace) {
+		pace := *kmPace
+		r.FastestKmWalkID = &walkID
+		r.FastestKmPace = &pace
+		r.UpdatedAt = time.Now()
+	}
+}
+
+// ApplyDailySteps はある日の合計歩数を1日の最多歩数と比較して更新する
+func (r *PersonalRecords) ApplyDailySteps(day time.Time, steps int) {
+	if steps > r.MostStepsInDay {
+		r.MostStepsDate = &day
+		r.MostStepsInDay = steps
+		r.UpdatedAt = time.Now()
+	}
+}
+
+// CurrentStreakOn は today 時点で継続中の連続日数を返す
+// 最後に散歩した日が今日または昨日でなければ連続は途切れているため0を返す
+func (r *PersonalRecords) CurrentStreakOn(today time.Time) int {
+	if r.LastWalkDate == nil || r.LastWalkDate.Before(today.AddDate(0, 0, -1)) {
+		return 0
+	}
+	return r.CurrentStreak
+}
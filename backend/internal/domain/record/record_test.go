@@ -0,0 +1,138 @@
+package record
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// TestDayOf は暦日の判定テスト
+func TestDayOf(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	// 期待値: 2025-01-15 20:00 UTC は東京では1月16日
+	got := DayOf(time.Date(2025, 1, 15, 20, 0, 0, 0, time.UTC), tokyo)
+	if want := date(2025, 1, 16); !got.Equal(want) {
+		t.Errorf("DayOf() = %v, want %v", got, want)
+	}
+}
+
+// TestPersonalRecords_ApplyWalkDay は連続記録の差分更新テスト
+func TestPersonalRecords_ApplyWalkDay(t *testing.T) {
+	r := NewPersonalRecords("user-1")
+
+	steps := []struct {
+		day         time.Time
+		wantCurrent int
+		wantLongest int
+	}{
+		// 期待値: 初回は1日
+		{day: date(2025, 1, 1), wantCurrent: 1, wantLongest: 1},
+		// 期待値: 同じ日の2回目は変化なし
+		{day: date(2025, 1, 1), wantCurrent: 1, wantLongest: 1},
+		// 期待値: 翌日なら連続が伸びる
+		{day: date(2025, 1, 2), wantCurrent: 2, wantLongest: 2},
+		{day: date(2025, 1, 3), wantCurrent: 3, wantLongest: 3},
+		// 期待値: 1日以上空くと連続は1に戻り、最長は維持される
+		{day: date(2025, 1, 5), wantCurrent: 1, wantLongest: 3},
+	}
+
+	for _, s := range steps {
+		if !r.ApplyWalkDay(s.day) {
+			t.Fatalf("ApplyWalkDay(%v) = false, want true", s.day)
+		}
+		if r.CurrentStreak != s.wantCurrent || r.LongestStreak != s.wantLongest {
+			t.Errorf("after %v: current = %d, longest = %d, want %d, %d",
+				s.day, r.CurrentStreak, r.LongestStreak, s.wantCurrent, s.wantLongest)
+		}
+	}
+
+	// 期待値: 最後に散歩した日より前の日は差分で反映できない
+	if r.ApplyWalkDay(date(2025, 1, 4)) {
+		t.Error("ApplyWalkDay(past day) = true, want false")
+	}
+}
+
+// TestPersonalRecords_RebuildStreaks は散歩した日の一覧からの再計算テスト
+func TestPersonalRecords_RebuildStreaks(t *testing.T) {
+	r := NewPersonalRecords("user-1")
+	r.ApplyWalkDay(date(2025, 1, 5))
+
+	r.RebuildStreaks([]time.Time{
+		date(2025, 1, 1), date(2025, 1, 2), date(2025, 1, 3), date(2025, 1, 4), date(2025, 1, 5),
+	})
+
+	// 期待値: 過去の日が埋まり、5日連続になる
+	if r.CurrentStreak != 5 || r.LongestStreak != 5 {
+		t.Errorf("current = %d, longest = %d, want 5, 5", r.CurrentStreak, r.LongestStreak)
+	}
+	if !r.LastWalkDate.Equal(date(2025, 1, 5)) {
+		t.Errorf("LastWalkDate = %v, want 2025-01-05", r.LastWalkDate)
+	}
+}
+
+// TestPersonalRecords_CurrentStreakOn は継続中の連続日数のテスト
+func TestPersonalRecords_CurrentStreakOn(t *testing.T) {
+	r := NewPersonalRecords("user-1")
+	r.ApplyWalkDay(date(2025, 1, 1))
+	r.ApplyWalkDay(date(2025, 1, 2))
+
+	tests := []struct {
+		name  string
+		today time.Time
+		want  int
+	}{
+		// 期待値: 今日散歩していれば継続中
+		{name: "same day", today: date(2025, 1, 2), want: 2},
+		// 期待値: 今日まだ散歩していなくても昨日までの連続は継続中
+		{name: "next day", today: date(2025, 1, 3), want: 2},
+		// 期待値: 昨日も散歩していなければ途切れている
+		{name: "broken", today: date(2025, 1, 4), want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.CurrentStreakOn(tt.today); got != tt.want {
+				t.Errorf("CurrentStreakOn() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPersonalRecords_ApplyWalk は距離とペースの自己ベスト更新テスト
+func TestPersonalRecords_ApplyWalk(t *testing.T) {
+	r := NewPersonalRecords("user-1")
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+	pace := func(v float64) *float64 { return &v }
+
+	r.ApplyWalk(first, 3000, pace(600))
+	r.ApplyWalk(second, 5000, pace(650))
+	r.ApplyWalk(third, 800, nil)
+
+	// 期待値: 最長距離は2件目
+	if r.LongestWalkDistance != 5000 || *r.LongestWalkID != second {
+		t.Errorf("longest walk = %v (%v), want 5000 (%v)", r.LongestWalkDistance, r.LongestWalkID, second)
+	}
+	// 期待値: 最速ペースは1件目、ペースなしの散歩では更新されない
+	if *r.FastestKmPace != 600 || *r.FastestKmWalkID != first {
+		t.Errorf("fastest km = %v (%v), want 600 (%v)", *r.FastestKmPace, r.FastestKmWalkID, first)
+	}
+}
+
+// TestPersonalRecords_ApplyDailySteps は1日の最多歩数の更新テスト
+func TestPersonalRecords_ApplyDailySteps(t *testing.T) {
+	r := NewPersonalRecords("user-1")
+
+	r.ApplyDailySteps(date(2025, 1, 1), 8000)
+	r.ApplyDailySteps(date(2025, 1, 2), 6000)
+
+	// 期待値: 歩数の多い日のみ記録される
+	if r.MostStepsInDay != 8000 || !r.MostStepsDate.Equal(date(2025, 1, 1)) {
+		t.Errorf("most steps = %d on %v, want 8000 on 2025-01-01", r.MostStepsInDay, r.MostStepsDate)
+	}
+}
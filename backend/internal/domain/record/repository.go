@@ -0,0 +1,21 @@
+package record
+
+import (
+	"context"
+	"time"
+)
+
+// Repository は連続記録・自己ベストの永続化層へのインターフェース
+type Repository interface {
+	// FindByUserID はユーザーの記録を取得する。記録がない場合は sql.ErrNoRows を返す
+	FindByUserID(ctx context.Context, userID string) (*PersonalRecords, error)
+	// UpdateByUserID はユーザーの記録を行ロックして取得し、update で変更した結果を保存する
+	// 記録がない場合は記録なしの値を渡す。update がエラーを返した場合は保存しない
+	// 同時に完了した散歩による更新が互いに上書きしないよう、取得から保存までを1トランザクションで行う
+	UpdateByUserID(ctx context.Context, userID string, update func(r *PersonalRecords) error) error
+	// FindWalkDays はユーザーが散歩を完了した暦日を昇順・重複なしで返す
+	// 暦日は散歩の開始時刻を loc のタイムゾーンに変換して判定する
+	FindWalkDays(ctx context.Context, userID string, loc *time.Location) ([]time.Time, error)
+	// SumStepsOnDay は loc のタイムゾーンでの暦日 day に開始した完了済みの散歩の合計歩数を返す
+	SumStepsOnDay(ctx context.Context, userID string, day time.Time, loc *time.Location) (int, error)
+}
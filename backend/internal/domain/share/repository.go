@@ -0,0 +1,19 @@
+package share
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository は共有リンクの永続化層へのインターフェース
+type Repository interface {
+	// Create は共有リンクを作成する
+	Create(ctx context.Context, link *Link) error
+	// FindByID はIDで共有リンクを取得する。存在しない場合は sql.ErrNoRows を返す
+	FindByID(ctx context.Context, id uuid.UUID) (*Link, error)
+	// ListByWalkID は散歩の共有リンクを作成日時の降順で取得する
+	ListByWalkID(ctx context.Context, walkID uuid.UUID) ([]*Link, error)
+	// Delete は散歩の共有リンクを削除する。存在しない場合は sql.ErrNoRows を返す
+	Delete(ctx context.Context, walkID, id uuid.UUID) error
+}
@@ -0,0 +1,47 @@
+package share
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrLinkNotFound は共有リンクが存在しない、または期限切れ・無効であることを表す
+	// トークンの有効性を推測されないよう、すべて同じエラーとして扱う
+	ErrLinkNotFound = errors.New("share link not found")
+	// ErrInvalidExpiry は有効期限が現在時刻より前であることを表す
+	ErrInvalidExpiry = errors.New("expires_at must be in the future")
+)
+
+// Link は散歩の共有リンク
+// リンクを知っている人は認証なしで散歩を閲覧できる。トークンはIDから署名付きで発行し、保存しない
+type Link struct {
+	ID        uuid.UUID
+	WalkID    uuid.UUID
+	UserID    string
+	ExpiresAt *time.Time // nilの場合は無期限
+	CreatedAt time.Time
+}
+
+// NewLink は新しい共有リンクを生成する
+// 有効期限を指定する場合は現在時刻より後でなければならない
+func NewLink(walkID uuid.UUID, userID string, expiresAt *time.Time) (*Link, error) {
+	now := time.Now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, ErrInvalidExpiry
+	}
+	return &Link{
+		ID:        uuid.New(),
+		WalkID:    walkID,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}, nil
+}
+
+// IsExpired は指定時刻の時点で有効期限が切れているかどうかを返す
+func (l *Link) IsExpired(at time.Time) bool {
+	return l.ExpiresAt != nil && !at.Before(*l.ExpiresAt)
+}
@@ -0,0 +1,53 @@
+package share
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLink(t *testing.T) {
+	walkID := uuid.New()
+
+	t.Run("without expiry", func(t *testing.T) {
+		link, err := NewLink(walkID, "user-1", nil)
+
+		require.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, link.ID)
+		assert.Equal(t, walkID, link.WalkID)
+		assert.Equal(t, "user-1", link.UserID)
+		assert.Nil(t, link.ExpiresAt)
+	})
+
+	t.Run("future expiry", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour)
+
+		link, err := NewLink(walkID, "user-1", &expiresAt)
+
+		require.NoError(t, err)
+		assert.Equal(t, &expiresAt, link.ExpiresAt)
+	})
+
+	t.Run("past expiry", func(t *testing.T) {
+		expiresAt := time.Now().Add(-time.Minute)
+
+		// 期待値: 作成時点で期限切れのリンクは作成できない
+		_, err := NewLink(walkID, "user-1", &expiresAt)
+		assert.ErrorIs(t, err, ErrInvalidExpiry)
+	})
+}
+
+func TestLink_IsExpired(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+	link := &Link{ExpiresAt: &expiresAt}
+
+	assert.False(t, link.IsExpired(now))
+	// 期待値: 有効期限の時刻ちょうどで期限切れ
+	assert.True(t, link.IsExpired(expiresAt))
+	assert.True(t, link.IsExpired(expiresAt.Add(time.Second)))
+	assert.False(t, (&Link{}).IsExpired(now.AddDate(100, 0, 0)))
+}
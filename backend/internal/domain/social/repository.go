@@ -0,0 +1,38 @@
+package social
+
+import (
+	"context"
+)
+
+// Repository はフォロー・ブロック関係の永続化層へのインターフェース
+type Repository interface {
+	// FindFollow はフォロー関係を取得する。存在しない場合は sql.ErrNoRows を返す
+	FindFollow(ctx context.Context, followerID, followeeID string) (*Follow, error)
+	// SaveFollow はフォロー関係を作成または更新する
+	SaveFollow(ctx context.Context, f *Follow) error
+	// DeleteFollows は2人の間のフォロー関係を両方向とも削除し、削除した件数を返す
+	DeleteFollows(ctx context.Context, userID, otherID string) (int, error)
+	// DeleteFollow はフォロー関係を削除する。存在しない場合は sql.ErrNoRows を返す
+	DeleteFollow(ctx context.Context, followerID, followeeID string) error
+
+	// ListFriends は相互に承認済みのフォロー関係にあるユーザーを返す
+	ListFriends(ctx context.Context, userID string) ([]*Connection, error)
+	// AreFriends は2人が相互に承認済みのフォロー関係にあるかどうかを返す
+	AreFriends(ctx context.Context, userID, otherID string) (bool, error)
+	// ListMutualFriends は2人の共通の友達を返す
+	ListMutualFriends(ctx context.Context, userID, otherID string) ([]*Connection, error)
+	// ListIncomingRequests はユーザー宛ての承認待ちのフォローリクエストの送信者を返す
+	ListIncomingRequests(ctx context.Context, userID string) ([]*Connection, error)
+	// ListOutgoingRequests はユーザーが送信した承認待ちのフォローリクエストの宛先を返す
+	ListOutgoingRequests(ctx context.Context, userID string) ([]*Connection, error)
+
+	// IsBlocked は2人のどちらかがもう一方をブロックしているかどうかを返す
+	IsBlocked(ctx context.Context, userID, otherID string) (bool, error)
+	// Block はブロックを作成し、2人の間のフォロー関係を両方向とも削除する
+	// ブロック済みの場合は何もしない
+	Block(ctx context.Context, b *Block) error
+	// Unblock はブロックを解除する。存在しない場合は sql.ErrNoRows を返す
+	Unblock(ctx context.Context, blockerID, blockedID string) error
+	// ListBlocked はユーザーがブロックしているユーザーを返す
+	ListBlocked(ctx context.Context, blockerID string) ([]*Connection, error)
+}
@@ -0,0 +1,98 @@
+package social
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrUserNotFound は相手のユーザーが存在しない、またはブロック関係にあることを表す
+	// ブロックの有無を相手に知られないよう、存在しない場合と区別しない
+	ErrUserNotFound = errors.New("user not found")
+	// ErrSelfRelation は自分自身へのフォローやブロックを表す
+	ErrSelfRelation = errors.New("cannot follow or block yourself")
+	// ErrAlreadyFollowing はフォロー済み、またはフォローリクエスト送信済みであることを表す
+	ErrAlreadyFollowing = errors.New("follow request already exists")
+	// ErrRequestNotFound は承認待ちのフォローリクエストが存在しないことを表す
+	ErrRequestNotFound = errors.New("follow request not found")
+	// ErrRelationNotFound は解除するフォロー・ブロックが存在しないことを表す
+	ErrRelationNotFound = errors.New("relation not found")
+)
+
+// FollowStatus はフォローの状態を表す
+type FollowStatus string
+
+const (
+	// FollowPending はフォローリクエストが承認待ちの状態
+	FollowPending FollowStatus = "pending"
+	// FollowAccepted はフォローリクエストが承認された状態
+	FollowAccepted FollowStatus = "accepted"
+)
+
+// Follow はフォロー関係（FollowerID が FolloweeID をフォローする）
+// フォローは相手の承認が必要で、承認されるまでは承認待ちのリクエストとして扱う
+type Follow struct {
+	FollowerID string
+	FolloweeID string
+	Status     FollowStatus
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// NewFollowRequest は承認待ちのフォローリクエストを生成する
+func NewFollowRequest(followerID, followeeID string) (*Follow, error) {
+	if followerID == followeeID {
+		return nil, ErrSelfRelation
+	}
+	now := time.Now()
+	return &Follow{
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+		Status:     FollowPending,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// Accept はフォローリクエストを承認する
+// 承認待ちでない場合は ErrRequestNotFound を返す
+func (f *Follow) Accept() error {
+	if f.Status != FollowPending {
+		return ErrRequestNotFound
+	}
+	f.Status = FollowAccepted
+	f.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsPending は承認待ちかどうかを返す
+func (f *Follow) IsPending() bool {
+	return f.Status == FollowPending
+}
+
+// Block はブロック関係（BlockerID が BlockedID をブロックする）
+// ブロック関係にあるユーザー同士は、どちらの方向でも互いの情報を参照できない
+type Block struct {
+	BlockerID string
+	BlockedID string
+	CreatedAt time.Time
+}
+
+// NewBlock はブロックを生成する
+func NewBlock(blockerID, blockedID string) (*Block, error) {
+	if blockerID == blockedID {
+		return nil, ErrSelfRelation
+	}
+	return &Block{
+		BlockerID: blockerID,
+		BlockedID: blockedID,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// Connection は一覧表示用の相手ユーザーとの関係
+type Connection struct {
+	UserID      string
+	DisplayName string
+	Since       time.Time // 関係が成立した日時（友達は相互フォローの成立、リクエストは送信、ブロックはブロックした日時）
+}
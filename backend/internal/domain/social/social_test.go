@@ -0,0 +1,54 @@
+package social
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestNewFollowRequest はフォローリクエスト生成のテスト
+func TestNewFollowRequest(t *testing.T) {
+	f, err := NewFollowRequest("alice", "bob")
+	if err != nil {
+		t.Fatalf("NewFollowRequest() error = %v", err)
+	}
+
+	// 期待値: 承認待ちで生成される
+	if !f.IsPending() {
+		t.Errorf("Status = %v, want %v", f.Status, FollowPending)
+	}
+
+	// 期待値: 自分自身はフォローできない
+	if _, err := NewFollowRequest("alice", "alice"); !errors.Is(err, ErrSelfRelation) {
+		t.Errorf("NewFollowRequest(self) error = %v, want %v", err, ErrSelfRelation)
+	}
+}
+
+// TestFollow_Accept はフォローリクエスト承認のテスト
+func TestFollow_Accept(t *testing.T) {
+	f, _ := NewFollowRequest("alice", "bob")
+
+	// 期待値: 承認待ちのリクエストは承認できる
+	if err := f.Accept(); err != nil {
+		t.Fatalf("Accept() error = %v", err)
+	}
+	if f.Status != FollowAccepted {
+		t.Errorf("Status = %v, want %v", f.Status, FollowAccepted)
+	}
+
+	// 期待値: 承認済みのリクエストは再度承認できない
+	if err := f.Accept(); !errors.Is(err, ErrRequestNotFound) {
+		t.Errorf("Accept() twice error = %v, want %v", err, ErrRequestNotFound)
+	}
+}
+
+// TestNewBlock はブロック生成のテスト
+func TestNewBlock(t *testing.T) {
+	if _, err := NewBlock("alice", "bob"); err != nil {
+		t.Fatalf("NewBlock() error = %v", err)
+	}
+
+	// 期待値: 自分自身はブロックできない
+	if _, err := NewBlock("alice", "alice"); !errors.Is(err, ErrSelfRelation) {
+		t.Errorf("NewBlock(self) error = %v, want %v", err, ErrSelfRelation)
+	}
+}
@@ -0,0 +1,16 @@
+package stats
+
+import (
+	"context"
+	"time"
+)
+
+// Repository は散歩の集計を行う永続化層へのインターフェース
+type Repository interface {
+	// AggregateWalks はユーザーの完了済みの散歩を開始時刻で期間ごとに集計する
+	// 期間の区切りは loc のタイムゾーンで判定し、開始時刻が [from, to) の散歩を対象とする
+	// 散歩のない期間は含めず、PeriodStart の昇順で返す
+	AggregateWalks(ctx context.Context, userID string, g Granularity, from, to time.Time, loc *time.Location) ([]Bucket, error)
+	// SummarizeWalks はユーザーの完了済みの全散歩の合計値を返す（PeriodStart はゼロ値）
+	SummarizeWalks(ctx context.Context, userID string) (Bucket, error)
+}
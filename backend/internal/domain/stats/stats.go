@@ -0,0 +1,108 @@
+package stats
+
+import (
+	"time"
+)
+
+// Granularity は集計期間の単位
+type Granularity string
+
+const (
+	// GranularityDay は日単位
+	GranularityDay Granularity = "day"
+	// GranularityWeek は週単位（月曜始まり）
+	GranularityWeek Granularity = "week"
+	// GranularityMonth は月単位
+	GranularityMonth Granularity = "month"
+)
+
+// IsValid は定義済みの集計単位かどうかを返す
+func (g Granularity) IsValid() bool {
+	switch g {
+	case GranularityDay, GranularityWeek, GranularityMonth:
+		return true
+	}
+	return false
+}
+
+// Truncate は t を含む期間の開始時刻（t のタイムゾーンでの0時）を返す
+func (g Granularity) Truncate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	switch g {
+	case GranularityWeek:
+		// 月曜日を週の始まりとする（PostgreSQLのdate_trunc('week')と同じ）
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(y, m, d-offset, 0, 0, 0, 0, t.Location())
+	case GranularityMonth:
+		return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	}
+}
+
+// Next は期間の開始時刻 t の次の期間の開始時刻を返す
+func (g Granularity) Next(t time.Time) time.Time {
+	switch g {
+	case GranularityWeek:
+		return t.AddDate(0, 0, 7)
+	case GranularityMonth:
+		return t.AddDate(0, 1, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
+
+// Bucket は1期間分の集計値
+type Bucket struct {
+	PeriodStart   time.Time // 期間の開始時刻（ユーザーのタイムゾーンでの0時）
+	WalkCount     int       // 完了した散歩の数
+	TotalDistance float64   // 総距離（メートル）
+	TotalSteps    int       // 総歩数
+	MovingTime    float64   // 一時停止を除く移動時間の合計（秒）
+	AveragePace   float64   // 平均ペース（秒/km）。距離0の場合は0
+}
+
+// Add は他の集計値を加算し、平均ペースを再計算する
+func (b *Bucket) Add(other Bucket) {
+	b.WalkCount += other.WalkCount
+	b.TotalDistance += other.TotalDistance
+	b.TotalSteps += other.TotalSteps
+	b.MovingTime += other.MovingTime
+	b.CalculatePace()
+}
+
+// CalculatePace は合計値から平均ペースを算出する
+// 散歩ごとの平均ペースの平均ではなく、合計移動時間 / 合計距離 で求める
+func (b *Bucket) CalculatePace() {
+	b.AveragePace = 0
+	if b.TotalDistance > 0 {
+		b.AveragePace = b.MovingTime / (b.TotalDistance / 1000)
+	}
+}
+
+// FillBuckets は [from, to) の全期間について集計値を並べる
+// 散歩のない期間は0件の集計値で埋める。buckets は PeriodStart の昇順であること
+func FillBuckets(g Granularity, from, to time.Time, buckets []Bucket) []Bucket {
+	filled := make([]Bucket, 0, len(buckets))
+	i := 0
+	for start := g.Truncate(from); start.Before(to); start = g.Next(start) {
+		b := Bucket{PeriodStart: start}
+		for i < len(buckets) && !buckets[i].PeriodStart.After(start) {
+			if buckets[i].PeriodStart.Equal(start) {
+				b.Add(buckets[i])
+			}
+			i++
+		}
+		filled = append(filled, b)
+	}
+	return filled
+}
+
+// Summarize は全期間の合計値を返す
+func Summarize(buckets []Bucket) Bucket {
+	var total Bucket
+	for _, b := range buckets {
+		total.Add(b)
+	}
+	return total
+}
@@ -0,0 +1,82 @@
+package stats
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestGranularity_Truncate は期間の開始時刻の算出テスト
+func TestGranularity_Truncate(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	// 2025-01-15（水）10:30 JST
+	ts := time.Date(2025, 1, 15, 10, 30, 0, 0, tokyo)
+
+	tests := []struct {
+		g    Granularity
+		want time.Time
+	}{
+		// 期待値: 日単位は当日0時
+		{g: GranularityDay, want: time.Date(2025, 1, 15, 0, 0, 0, 0, tokyo)},
+		// 期待値: 週単位は直前の月曜日0時
+		{g: GranularityWeek, want: time.Date(2025, 1, 13, 0, 0, 0, 0, tokyo)},
+		// 期待値: 月単位は1日0時
+		{g: GranularityMonth, want: time.Date(2025, 1, 1, 0, 0, 0, 0, tokyo)},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.g), func(t *testing.T) {
+			if got := tt.g.Truncate(ts); !got.Equal(tt.want) {
+				t.Errorf("Truncate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// 期待値: 日曜日は前の週（月曜始まり）に含まれる
+	sunday := time.Date(2025, 1, 19, 23, 0, 0, 0, tokyo)
+	if got := GranularityWeek.Truncate(sunday); !got.Equal(time.Date(2025, 1, 13, 0, 0, 0, 0, tokyo)) {
+		t.Errorf("Truncate(sunday) = %v", got)
+	}
+}
+
+// TestFillBuckets は空期間の補完テスト
+func TestFillBuckets(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC)
+
+	buckets := []Bucket{
+		{PeriodStart: from, WalkCount: 1, TotalDistance: 1000, TotalSteps: 1300, MovingTime: 600},
+		{PeriodStart: from.AddDate(0, 0, 2), WalkCount: 2, TotalDistance: 3000, TotalSteps: 4000, MovingTime: 1500},
+	}
+
+	got := FillBuckets(GranularityDay, from, to, buckets)
+
+	// 期待値: 3日分が並び、散歩のない2日目は0件
+	if len(got) != 3 {
+		t.Fatalf("len(FillBuckets()) = %d, want 3", len(got))
+	}
+	if got[1].WalkCount != 0 || !got[1].PeriodStart.Equal(from.AddDate(0, 0, 1)) {
+		t.Errorf("got[1] = %+v, want empty bucket for 2025-01-02", got[1])
+	}
+
+	// 期待値: 平均ペースは合計移動時間 / 合計距離(km)
+	if got[2].AveragePace != 500 {
+		t.Errorf("got[2].AveragePace = %v, want 500", got[2].AveragePace)
+	}
+}
+
+// TestSummarize は全期間の合計テスト
+func TestSummarize(t *testing.T) {
+	total := Summarize([]Bucket{
+		{WalkCount: 1, TotalDistance: 1000, TotalSteps: 1300, MovingTime: 600},
+		{WalkCount: 2, TotalDistance: 3000, TotalSteps: 4000, MovingTime: 1500},
+	})
+
+	// 期待値: 各値の合計と、合計値から算出した平均ペース
+	if total.WalkCount != 3 || total.TotalDistance != 4000 || total.TotalSteps != 5300 || total.MovingTime != 2100 {
+		t.Errorf("Summarize() = %+v", total)
+	}
+	if math.Abs(total.AveragePace-525) > 1e-9 {
+		t.Errorf("AveragePace = %v, want 525", total.AveragePace)
+	}
+}
@@ -0,0 +1,22 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository はタグの永続化層へのインターフェース
+// 散歩へのタグ付けは walk.Repository.ReplaceTags で行う
+type Repository interface {
+	// ListByUserID はユーザーのタグを名前順に、散歩の数とあわせて取得する
+	ListByUserID(ctx context.Context, userID string) ([]*Tag, error)
+	// FindByID はIDでタグを取得する。存在しない場合は sql.ErrNoRows を返す
+	FindByID(ctx context.Context, id uuid.UUID) (*Tag, error)
+	// Create はタグを作成する。同じ名前のタグが存在する場合は ErrDuplicateName を返す
+	Create(ctx context.Context, t *Tag) error
+	// Update はタグ名を更新する。同じ名前のタグが存在する場合は ErrDuplicateName を返す
+	Update(ctx context.Context, t *Tag) error
+	// Delete はタグを削除し、散歩からも外す。存在しない場合は sql.ErrNoRows を返す
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,100 @@
+package tag
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxNameLength はタグ名の最大文字数（tags.name VARCHAR(50)）
+	MaxNameLength = 50
+	// MaxTagsPerWalk は1件の散歩に付けられるタグの最大数
+	MaxTagsPerWalk = 20
+)
+
+var (
+	// ErrTagNotFound はタグが存在しない、または他のユーザーのタグであることを表す
+	ErrTagNotFound = errors.New("tag not found")
+	// ErrDuplicateName は同じ名前のタグが既に存在することを表す
+	ErrDuplicateName = errors.New("tag name already exists")
+)
+
+// Tag は散歩を分類するためのユーザーごとのタグ
+// 名前は大文字小文字を区別せずユーザー内で一意とする
+type Tag struct {
+	ID        uuid.UUID
+	UserID    string
+	Name      string
+	WalkCount int // タグが付いている散歩の数（一覧取得時のみ設定）
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewTag は新しいタグを生成する
+// 名前は前後の空白を除去して検証する
+func NewTag(userID, name string) (*Tag, error) {
+	normalized, err := NormalizeName(name)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &Tag{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      normalized,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// Rename はタグ名を変更する
+func (t *Tag) Rename(name string) error {
+	normalized, err := NormalizeName(name)
+	if err != nil {
+		return err
+	}
+	t.Name = normalized
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// NormalizeName は前後の空白を除去したタグ名を返す
+// 空の場合や最大文字数を超える場合はエラーを返す
+func NormalizeName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errors.New("name is required")
+	}
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return "", fmt.Errorf("name must be at most %d characters", MaxNameLength)
+	}
+	return name, nil
+}
+
+// NormalizeNames は散歩に付けるタグ名の一覧を正規化する
+// 大文字小文字のみが異なる重複は最初の名前を残して取り除き、指定順を保つ
+func NormalizeNames(names []string) ([]string, error) {
+	normalized := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		n, err := NormalizeName(name)
+		if err != nil {
+			return nil, err
+		}
+		key := strings.ToLower(n)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, n)
+	}
+	if len(normalized) > MaxTagsPerWalk {
+		return nil, fmt.Errorf("at most %d tags can be set", MaxTagsPerWalk)
+	}
+	return normalized, nil
+}
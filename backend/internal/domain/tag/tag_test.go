@@ -0,0 +1,67 @@
+package tag
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestNewTag はタグ生成のテスト
+func TestNewTag(t *testing.T) {
+	tg, err := NewTag("alice", "  朝の散歩 ")
+	if err != nil {
+		t.Fatalf("NewTag() error = %v", err)
+	}
+
+	// 期待値: 前後の空白が除去される
+	if tg.Name != "朝の散歩" {
+		t.Errorf("Name = %q, want %q", tg.Name, "朝の散歩")
+	}
+
+	// 期待値: 空白のみの名前は作成できない
+	if _, err := NewTag("alice", "   "); err == nil {
+		t.Error("NewTag(blank) error = nil, want error")
+	}
+
+	// 期待値: 最大文字数はバイト数ではなく文字数で数える
+	if _, err := NewTag("alice", strings.Repeat("犬", MaxNameLength)); err != nil {
+		t.Errorf("NewTag(%d runes) error = %v", MaxNameLength, err)
+	}
+	if _, err := NewTag("alice", strings.Repeat("犬", MaxNameLength+1)); err == nil {
+		t.Errorf("NewTag(%d runes) error = nil, want error", MaxNameLength+1)
+	}
+}
+
+// TestNormalizeNames はタグ名一覧の正規化のテスト
+func TestNormalizeNames(t *testing.T) {
+	// 期待値: 大文字小文字のみが異なる重複は最初の名前を残し、指定順を保つ
+	got, err := NormalizeNames([]string{"Kyoto", " dog ", "kyoto", "Dog", "morning"})
+	if err != nil {
+		t.Fatalf("NormalizeNames() error = %v", err)
+	}
+	want := []string{"Kyoto", "dog", "morning"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NormalizeNames() = %v, want %v", got, want)
+	}
+
+	// 期待値: 空の一覧はタグをすべて外す指定として空スライスを返す
+	got, err = NormalizeNames([]string{})
+	if err != nil || got == nil || len(got) != 0 {
+		t.Errorf("NormalizeNames(empty) = %v, %v, want empty slice", got, err)
+	}
+
+	// 期待値: 不正な名前を含む場合はエラー
+	if _, err := NormalizeNames([]string{"dog", ""}); err == nil {
+		t.Error("NormalizeNames(blank) error = nil, want error")
+	}
+
+	// 期待値: 重複を除いた数が上限を超える場合はエラー
+	names := make([]string, MaxTagsPerWalk+1)
+	for i := range names {
+		names[i] = fmt.Sprintf("tag%d", i)
+	}
+	if _, err := NormalizeNames(names); err == nil {
+		t.Errorf("NormalizeNames(%d tags) error = nil, want error", len(names))
+	}
+}
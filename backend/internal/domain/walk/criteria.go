@@ -0,0 +1,82 @@
+package walk
+
+import "time"
+
+// SortField は散歩一覧の並び替え項目
+type SortField string
+
+const (
+	// SortByCreatedAt は作成日時順（デフォルト）
+	SortByCreatedAt SortField = "created_at"
+	// SortByStartTime は開始時刻順（未開始の散歩は常に末尾）
+	SortByStartTime SortField = "start_time"
+	// SortByDistance は総距離順
+	SortByDistance SortField = "distance"
+	// SortByDuration は移動時間順
+	SortByDuration SortField = "duration"
+)
+
+// IsValid は定義済みの並び替え項目かどうかを返す
+func (f SortField) IsValid() bool {
+	switch f {
+	case SortByCreatedAt, SortByStartTime, SortByDistance, SortByDuration:
+		return true
+	}
+	return false
+}
+
+// SortOrder は並び順
+type SortOrder string
+
+const (
+	// SortDesc は降順（デフォルト）
+	SortDesc SortOrder = "desc"
+	// SortAsc は昇順
+	SortAsc SortOrder = "asc"
+)
+
+// IsValid は定義済みの並び順かどうかを返す
+func (o SortOrder) IsValid() bool {
+	return o == SortDesc || o == SortAsc
+}
+
+// SortOption は散歩一覧の並び替え条件
+// ゼロ値は作成日時の降順
+type SortOption struct {
+	Field SortField
+	Order SortOrder
+}
+
+// Normalize は未指定の項目をデフォルト値で補った並び替え条件を返す
+func (s SortOption) Normalize() SortOption {
+	if s.Field == "" {
+		s.Field = SortByCreatedAt
+	}
+	if s.Order == "" {
+		s.Order = SortDesc
+	}
+	return s
+}
+
+// WalkFilter は散歩一覧の絞り込み条件
+// 未指定（nil・空）の条件は適用しない
+type WalkFilter struct {
+	Statuses    []WalkStatus // いずれかのステータスに一致
+	StartFrom   *time.Time   // 開始時刻がこの時刻以降
+	StartTo     *time.Time   // 開始時刻がこの時刻より前
+	MinDistance *float64     // 総距離（メートル）がこの値以上
+	MaxDistance *float64     // 総距離（メートル）がこの値以下
+	Query       string       // タイトル・説明の部分一致（大文字小文字を区別しない）
+	Tag         string       // 指定した名前のタグが付いている（大文字小文字を区別しない）
+}
+
+// ListCriteria は散歩一覧の検索条件
+// After を指定した場合は Offset を無視し、カーソルより後ろから取得する（作成日時順のみ）
+type ListCriteria struct {
+	UserID string
+	Filter WalkFilter
+	Sort   SortOption
+	Limit  int
+	Offset int
+	After  *ListCursor
+}
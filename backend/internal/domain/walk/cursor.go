@@ -0,0 +1,19 @@
+package walk
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListCursor は散歩一覧のキーセットページネーション用カーソル
+// (created_at, id) の並び順で、このカーソルより後ろの散歩を取得する
+type ListCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// CursorOf は散歩の位置を表すカーソルを返す
+func CursorOf(w *Walk) ListCursor {
+	return ListCursor{CreatedAt: w.CreatedAt, ID: w.ID}
+}
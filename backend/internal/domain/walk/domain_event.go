@@ -0,0 +1,163 @@
+package walk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrUnknownEvent はドメインイベントの種類が定義されていないことを表す
+var ErrUnknownEvent = errors.New("unknown domain event")
+
+// ドメインイベントの種類（アウトボックスに保存する名前）
+const (
+	EventWalkCreated       = "walk.created"
+	EventWalkStarted       = "walk.started"
+	EventWalkCompleted     = "walk.completed"
+	EventWalkDeleted       = "walk.deleted"
+	EventLocationsAppended = "walk.locations_appended"
+)
+
+// DomainEvent は散歩の状態変化を表すドメインイベント
+// Walkの操作で発生し、Walkの保存と同じトランザクションでアウトボックスに書き込まれる
+type DomainEvent interface {
+	// EventName はイベントの種類を返す
+	EventName() string
+	// Metadata はイベントが発生した散歩と日時を返す
+	Metadata() EventMetadata
+}
+
+// EventMetadata は全てのドメインイベントに共通する項目
+type EventMetadata struct {
+	WalkID     uuid.UUID `json:"walk_id"`
+	UserID     string    `json:"user_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// Metadata はイベントが発生した散歩と日時を返す
+func (m EventMetadata) Metadata() EventMetadata {
+	return m
+}
+
+// WalkCreated は散歩が作成されたことを表す
+type WalkCreated struct {
+	EventMetadata
+}
+
+// EventName はイベントの種類を返す
+func (WalkCreated) EventName() string { return EventWalkCreated }
+
+// WalkStarted は散歩が開始されたことを表す
+type WalkStarted struct {
+	EventMetadata
+	StartTime *time.Time `json:"start_time"`
+}
+
+// EventName はイベントの種類を返す
+func (WalkStarted) EventName() string { return EventWalkStarted }
+
+// WalkCompleted は散歩が完了したことを表す
+type WalkCompleted struct {
+	EventMetadata
+	EndTime *time.Time `json:"end_time"`
+}
+
+// EventName はイベントの種類を返す
+func (WalkCompleted) EventName() string { return EventWalkCompleted }
+
+// WalkDeleted は散歩が削除されたことを表す
+type WalkDeleted struct {
+	EventMetadata
+}
+
+// EventName はイベントの種類を返す
+func (WalkDeleted) EventName() string { return EventWalkDeleted }
+
+// LocationsAppended は散歩に位置情報が追加されたことを表す
+type LocationsAppended struct {
+	EventMetadata
+	Count int `json:"count"` // 追加した位置情報の件数（既存の位置情報の更新を含む）
+}
+
+// EventName はイベントの種類を返す
+func (LocationsAppended) EventName() string { return EventLocationsAppended }
+
+// DecodeEvent はアウトボックスに保存したイベントを復元する
+// 定義されていない種類の場合は ErrUnknownEvent を返す
+func DecodeEvent(name string, payload []byte) (DomainEvent, error) {
+	var e DomainEvent
+	switch name {
+	case EventWalkCreated:
+		e = &WalkCreated{}
+	case EventWalkStarted:
+		e = &WalkStarted{}
+	case EventWalkCompleted:
+		e = &WalkCompleted{}
+	case EventWalkDeleted:
+		e = &WalkDeleted{}
+	case EventLocationsAppended:
+		e = &LocationsAppended{}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEvent, name)
+	}
+
+	if err := json.Unmarshal(payload, e); err != nil {
+		return nil, fmt.Errorf("failed to decode %s event: %w", name, err)
+	}
+	return e, nil
+}
+
+// PendingEvents は保存されていないドメインイベントを発生順に返す
+func (w *Walk) PendingEvents() []DomainEvent {
+	return w.events
+}
+
+// ClearEvents は保存したドメインイベントを取り除く
+// リポジトリがアウトボックスへの書き込みをコミットした後に呼び出す
+func (w *Walk) ClearEvents() {
+	w.events = nil
+}
+
+// ChangeStatus はステータスを遷移の制約なしに変更する
+// クライアントとの同期やインポートで使い、開始・完了になった場合はイベントを発生させる
+func (w *Walk) ChangeStatus(status WalkStatus) {
+	if w.Status == status {
+		return
+	}
+	previous := w.Status
+	w.Status = status
+
+	switch {
+	case status == StatusInProgress && previous == StatusNotStarted:
+		w.recordEvent(&WalkStarted{EventMetadata: w.eventMetadata(), StartTime: w.StartTime})
+	case status == StatusCompleted:
+		w.recordEvent(&WalkCompleted{EventMetadata: w.eventMetadata(), EndTime: w.EndTime})
+	}
+}
+
+// MarkDeleted は散歩の削除を記録する
+// リポジトリの Delete に渡すことで、削除と同じトランザクションでイベントが保存される
+func (w *Walk) MarkDeleted() {
+	w.recordEvent(&WalkDeleted{EventMetadata: w.eventMetadata()})
+}
+
+// RecordLocationsAppended は位置情報の追加を記録する
+func (w *Walk) RecordLocationsAppended(count int) {
+	if count == 0 {
+		return
+	}
+	w.recordEvent(&LocationsAppended{EventMetadata: w.eventMetadata(), Count: count})
+}
+
+// recordEvent はドメインイベントを保存待ちに追加する
+func (w *Walk) recordEvent(e DomainEvent) {
+	w.events = append(w.events, e)
+}
+
+// eventMetadata は現在時刻で発生したイベントの共通項目を返す
+func (w *Walk) eventMetadata() EventMetadata {
+	return EventMetadata{WalkID: w.ID, UserID: w.UserID, OccurredAt: time.Now()}
+}
@@ -0,0 +1,117 @@
+package walk
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// eventNames はイベントの種類を発生順に返す
+func eventNames(events []DomainEvent) []string {
+	names := make([]string, len(events))
+	for i, e := range events {
+		names[i] = e.EventName()
+	}
+	return names
+}
+
+func TestWalk_DomainEvents(t *testing.T) {
+	t.Run("作成・開始・完了でイベントが発生する", func(t *testing.T) {
+		w := NewWalk("user-1", "Walk", "")
+		require.NoError(t, w.Start())
+		require.NoError(t, w.Pause())
+		require.NoError(t, w.Resume())
+		require.NoError(t, w.Complete())
+
+		// 期待値: 一時停止・再開ではイベントは発生しない
+		assert.Equal(t, []string{EventWalkCreated, EventWalkStarted, EventWalkCompleted}, eventNames(w.PendingEvents()))
+
+		meta := w.PendingEvents()[2].Metadata()
+		assert.Equal(t, w.ID, meta.WalkID)
+		assert.Equal(t, "user-1", meta.UserID)
+		completed := w.PendingEvents()[2].(*WalkCompleted)
+		assert.Equal(t, w.EndTime, completed.EndTime)
+	})
+
+	t.Run("遷移できない操作ではイベントは発生しない", func(t *testing.T) {
+		w := NewWalk("user-1", "Walk", "")
+		w.ClearEvents()
+
+		assert.Error(t, w.Complete())
+		assert.Empty(t, w.PendingEvents())
+	})
+
+	t.Run("ClearEventsで保存待ちのイベントを取り除く", func(t *testing.T) {
+		w := NewWalk("user-1", "Walk", "")
+		w.ClearEvents()
+		assert.Empty(t, w.PendingEvents())
+	})
+
+	t.Run("削除と位置情報の追加", func(t *testing.T) {
+		w := NewWalk("user-1", "Walk", "")
+		w.ClearEvents()
+
+		w.RecordLocationsAppended(0)
+		w.RecordLocationsAppended(3)
+		w.MarkDeleted()
+
+		// 期待値: 0件の追加ではイベントは発生しない
+		assert.Equal(t, []string{EventLocationsAppended, EventWalkDeleted}, eventNames(w.PendingEvents()))
+		assert.Equal(t, 3, w.PendingEvents()[0].(*LocationsAppended).Count)
+	})
+}
+
+func TestWalk_ChangeStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		from WalkStatus
+		to   WalkStatus
+		want []string
+	}{
+		{name: "未開始から進行中は開始", from: StatusNotStarted, to: StatusInProgress, want: []string{EventWalkStarted}},
+		{name: "未開始から完了は完了のみ", from: StatusNotStarted, to: StatusCompleted, want: []string{EventWalkCompleted}},
+		{name: "一時停止から完了", from: StatusPaused, to: StatusCompleted, want: []string{EventWalkCompleted}},
+		{name: "一時停止から進行中（再開）", from: StatusPaused, to: StatusInProgress, want: []string{}},
+		{name: "同じステータス", from: StatusCompleted, to: StatusCompleted, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWalk("user-1", "Walk", "")
+			w.Status = tt.from
+			w.ClearEvents()
+
+			w.ChangeStatus(tt.to)
+
+			assert.Equal(t, tt.to, w.Status)
+			assert.Equal(t, tt.want, eventNames(w.PendingEvents()))
+		})
+	}
+}
+
+func TestDecodeEvent(t *testing.T) {
+	w := NewWalk("user-1", "Walk", "")
+	w.RecordLocationsAppended(5)
+
+	for _, e := range w.PendingEvents() {
+		payload, err := json.Marshal(e)
+		require.NoError(t, err)
+
+		// 期待値: 保存した内容から同じイベントを復元できる
+		decoded, err := DecodeEvent(e.EventName(), payload)
+		require.NoError(t, err)
+		assert.Equal(t, e.EventName(), decoded.EventName())
+		assert.Equal(t, e.Metadata().WalkID, decoded.Metadata().WalkID)
+		assert.True(t, e.Metadata().OccurredAt.Equal(decoded.Metadata().OccurredAt))
+	}
+
+	appended, err := DecodeEvent(EventLocationsAppended, []byte(`{"walk_id":"`+w.ID.String()+`","count":5}`))
+	require.NoError(t, err)
+	assert.Equal(t, 5, appended.(*LocationsAppended).Count)
+
+	// 期待値: 定義されていない種類はエラー
+	_, err = DecodeEvent("walk.unknown", []byte(`{}`))
+	assert.ErrorIs(t, err, ErrUnknownEvent)
+}
@@ -14,6 +14,11 @@ type LocationRepository interface {
 	// FindByWalkID はWalkIDで位置情報を取得する（sequence_number順）
 	FindByWalkID(ctx context.Context, walkID uuid.UUID) ([]*WalkLocation, error)
 
+	// StreamByWalkID はWalkIDの位置情報を1件ずつコールバックに渡す（sequence_number順）
+	// 全件をメモリに載せずに処理するため、長い散歩のエクスポートなどで使用する
+	// コールバックがエラーを返した場合は走査を中断し、そのエラーを返す
+	StreamByWalkID(ctx context.Context, walkID uuid.UUID, fn func(*WalkLocation) error) error
+
 	// DeleteByWalkID はWalkIDに紐づく全ての位置情報を削除する
 	DeleteByWalkID(ctx context.Context, walkID uuid.UUID) error
 }
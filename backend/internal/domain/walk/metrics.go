@@ -0,0 +1,132 @@
+package walk
+
+import (
+	"math"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/geo"
+)
+
+const (
+	// MaxHorizontalAccuracy は距離計算に採用する位置情報の水平精度の上限（メートル）
+	// これより精度の悪い点はGPSの跳びとして除外する
+	MaxHorizontalAccuracy = 50.0
+)
+
+// RouteMetrics は位置情報から算出した散歩の計測値
+type RouteMetrics struct {
+	Distance       float64 // 総距離（メートル）
+	MovingTime     float64 // 一時停止時間を除いた移動時間（秒）
+	AveragePace    float64 // 平均ペース（秒/km）。距離0の場合は0
+	MaxSpeed       float64 // 最高速度（m/s）
+	AcceptedPoints int     // 計算に採用した位置情報の数
+}
+
+// HaversineDistance は2点間の大円距離（メートル）を返す
+func HaversineDistance(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1 := lat1 * math.Pi / 180
+	phi2 := lat2 * math.Pi / 180
+	dPhi := (lat2 - lat1) * math.Pi / 180
+	dLambda := (lon2 - lon1) * math.Pi / 180
+
+	a := math.Sin(dPhi/2)*math.Sin(dPhi/2) +
+		math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+
+	return geo.EarthRadiusMeters * c
+}
+
+// FilterByAccuracy は水平精度が不明、または上限以内の位置情報のみを返す
+// iOSでは負の精度は無効値を意味するため除外する
+func FilterByAccuracy(locations []*WalkLocation, maxAccuracy float64) []*WalkLocation {
+	accepted := make([]*WalkLocation, 0, len(locations))
+	for _, loc := range locations {
+		if loc.HorizontalAccuracy != nil {
+			acc := *loc.HorizontalAccuracy
+			if acc < 0 || acc > maxAccuracy {
+				continue
+			}
+		}
+		accepted = append(accepted, loc)
+	}
+	return accepted
+}
+
+// CalculateRouteMetrics はsequence_number順の位置情報から計測値を算出する
+// 移動時間は開始〜終了時刻（未設定の場合は最初と最後の位置情報の時刻）から一時停止時間を引いて求める
+func CalculateRouteMetrics(locations []*WalkLocation, startTime, endTime *time.Time, pausedDuration float64) RouteMetrics {
+	accepted := FilterByAccuracy(locations, MaxHorizontalAccuracy)
+
+	m := RouteMetrics{AcceptedPoints: len(accepted)}
+	if len(accepted) == 0 {
+		return m
+	}
+
+	for i := 1; i < len(accepted); i++ {
+		prev, cur := accepted[i-1], accepted[i]
+		segment := HaversineDistance(prev.Latitude, prev.Longitude, cur.Latitude, cur.Longitude)
+		m.Distance += segment
+
+		if dt := cur.Timestamp.Sub(prev.Timestamp).Seconds(); dt > 0 {
+			m.MaxSpeed = math.Max(m.MaxSpeed, segment/dt)
+		}
+	}
+
+	from := accepted[0].Timestamp
+	if startTime != nil {
+		from = *startTime
+	}
+	to := accepted[len(accepted)-1].Timestamp
+	if endTime != nil {
+		to = *endTime
+	}
+	m.MovingTime = math.Max(to.Sub(from).Seconds()-pausedDuration, 0)
+
+	if m.Distance > 0 {
+		m.AveragePace = m.MovingTime / (m.Distance / 1000)
+	}
+
+	return m
+}
+
+// FastestSplitPace は連続する区間のうち距離が splitDistance（メートル）以上となる最速の区間のペース（秒/km）を返す
+// 区間の端は位置情報の点に揃えるため、splitDistance をわずかに超える区間の所要時間を距離で割って求める
+// 総距離が splitDistance に満たない場合は false を返す
+func FastestSplitPace(locations []*WalkLocation, splitDistance float64) (float64, bool) {
+	accepted := FilterByAccuracy(locations, MaxHorizontalAccuracy)
+	if len(accepted) < 2 || splitDistance <= 0 {
+		return 0, false
+	}
+
+	// 先頭からの累積距離
+	cumulative := make([]float64, len(accepted))
+	for i := 1; i < len(accepted); i++ {
+		prev, cur := accepted[i-1], accepted[i]
+		cumulative[i] = cumulative[i-1] + HaversineDistance(prev.Latitude, prev.Longitude, cur.Latitude, cur.Longitude)
+	}
+
+	best, found := 0.0, false
+	head := 0
+	for tail := 1; tail < len(accepted); tail++ {
+		// 区間距離が splitDistance 以上を保てる限り始点を進め、最短の区間にする
+		for head+1 < tail && cumulative[tail]-cumulative[head+1] >= splitDistance {
+			head++
+		}
+
+		distance := cumulative[tail] - cumulative[head]
+		if distance < splitDistance {
+			continue
+		}
+		elapsed := accepted[tail].Timestamp.Sub(accepted[head].Timestamp).Seconds()
+		if elapsed <= 0 {
+			continue
+		}
+
+		pace := elapsed / (distance / 1000)
+		if !found || pace < best {
+			best, found = pace, true
+		}
+	}
+
+	return best, found
+}
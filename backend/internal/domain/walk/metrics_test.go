@@ -0,0 +1,224 @@
+package walk
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// newTestLocation はテスト用の位置情報を生成する
+func newTestLocation(seq int, lat, lon float64, ts time.Time, accuracy *float64) *WalkLocation {
+	return NewWalkLocationWithOptionals(uuid.Nil, lat, lon, nil, ts, accuracy, nil, nil, nil, seq)
+}
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+// TestHaversineDistance は大円距離計算のテスト
+func TestHaversineDistance(t *testing.T) {
+	tests := []struct {
+		name                   string
+		lat1, lon1, lat2, lon2 float64
+		want                   float64
+		tolerance              float64
+	}{
+		{
+			// 期待値: 同一地点は0メートル
+			name: "同一地点",
+			lat1: 35.6812, lon1: 139.7671, lat2: 35.6812, lon2: 139.7671,
+			want: 0, tolerance: 0.001,
+		},
+		{
+			// 期待値: 赤道上の経度1度は約111.2km
+			name: "赤道上の経度1度",
+			lat1: 0, lon1: 0, lat2: 0, lon2: 1,
+			want: 111195, tolerance: 1,
+		},
+		{
+			// 期待値: 東京駅〜新宿駅は約6.1km
+			name: "東京駅から新宿駅",
+			lat1: 35.6812, lon1: 139.7671, lat2: 35.6896, lon2: 139.7006,
+			want: 6080, tolerance: 50,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := HaversineDistance(tt.lat1, tt.lon1, tt.lat2, tt.lon2)
+			if math.Abs(got-tt.want) > tt.tolerance {
+				t.Errorf("HaversineDistance() = %v, want %v ± %v", got, tt.want, tt.tolerance)
+			}
+		})
+	}
+}
+
+// TestFilterByAccuracy は精度による位置情報の除外テスト
+func TestFilterByAccuracy(t *testing.T) {
+	now := time.Now()
+	locations := []*WalkLocation{
+		newTestLocation(0, 35.0, 139.0, now, nil),              // 精度不明: 採用
+		newTestLocation(1, 35.0, 139.0, now, floatPtr(10)),     // 高精度: 採用
+		newTestLocation(2, 35.0, 139.0, now, floatPtr(50)),     // 境界値: 採用
+		newTestLocation(3, 35.0, 139.0, now, floatPtr(50.1)),   // 低精度: 除外
+		newTestLocation(4, 35.0, 139.0, now, floatPtr(-1)),     // 無効値: 除外
+		newTestLocation(5, 35.0, 139.0, now, floatPtr(1000.0)), // 低精度: 除外
+	}
+
+	got := FilterByAccuracy(locations, MaxHorizontalAccuracy)
+
+	wantSeq := []int{0, 1, 2}
+	if len(got) != len(wantSeq) {
+		t.Fatalf("len(FilterByAccuracy()) = %d, want %d", len(got), len(wantSeq))
+	}
+	for i, loc := range got {
+		if loc.SequenceNumber != wantSeq[i] {
+			t.Errorf("got[%d].SequenceNumber = %d, want %d", i, loc.SequenceNumber, wantSeq[i])
+		}
+	}
+}
+
+// TestCalculateRouteMetrics は計測値算出のテスト
+func TestCalculateRouteMetrics(t *testing.T) {
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	// 赤道上を経度0.001度（約111.2m）ずつ60秒間隔で東へ進む
+	// 途中にGPSの跳び（低精度）を1点含む
+	locations := []*WalkLocation{
+		newTestLocation(0, 0, 0.000, start, floatPtr(5)),
+		newTestLocation(1, 0, 0.001, start.Add(60*time.Second), floatPtr(5)),
+		newTestLocation(2, 1, 1.000, start.Add(90*time.Second), floatPtr(200)), // 除外される
+		newTestLocation(3, 0, 0.002, start.Add(120*time.Second), floatPtr(5)),
+		newTestLocation(4, 0, 0.003, start.Add(150*time.Second), nil),
+	}
+	end := start.Add(10 * time.Minute)
+
+	m := CalculateRouteMetrics(locations, &start, &end, 120)
+
+	// 期待値: 低精度点を除いた3区間分の距離（約333.6m）
+	segment := HaversineDistance(0, 0, 0, 0.001)
+	if math.Abs(m.Distance-3*segment) > 0.01 {
+		t.Errorf("Distance = %v, want %v", m.Distance, 3*segment)
+	}
+	if m.AcceptedPoints != 4 {
+		t.Errorf("AcceptedPoints = %d, want 4", m.AcceptedPoints)
+	}
+
+	// 期待値: 開始〜終了の600秒から一時停止120秒を除いた480秒
+	if m.MovingTime != 480 {
+		t.Errorf("MovingTime = %v, want 480", m.MovingTime)
+	}
+
+	// 期待値: 平均ペースは移動時間 / 距離(km)
+	wantPace := 480 / (3 * segment / 1000)
+	if math.Abs(m.AveragePace-wantPace) > 0.01 {
+		t.Errorf("AveragePace = %v, want %v", m.AveragePace, wantPace)
+	}
+
+	// 期待値: 最高速度は30秒区間の約3.7m/s
+	wantMaxSpeed := segment / 30
+	if math.Abs(m.MaxSpeed-wantMaxSpeed) > 0.001 {
+		t.Errorf("MaxSpeed = %v, want %v", m.MaxSpeed, wantMaxSpeed)
+	}
+}
+
+// TestCalculateRouteMetrics_WithoutWalkTimes は開始・終了時刻が未設定の場合のテスト
+func TestCalculateRouteMetrics_WithoutWalkTimes(t *testing.T) {
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	locations := []*WalkLocation{
+		newTestLocation(0, 0, 0.000, start, nil),
+		newTestLocation(1, 0, 0.001, start.Add(5*time.Minute), nil),
+	}
+
+	m := CalculateRouteMetrics(locations, nil, nil, 0)
+
+	// 期待値: 最初と最後の位置情報の時刻差が移動時間になる
+	if m.MovingTime != 300 {
+		t.Errorf("MovingTime = %v, want 300", m.MovingTime)
+	}
+}
+
+// TestCalculateRouteMetrics_Empty は位置情報がない場合のテスト
+func TestCalculateRouteMetrics_Empty(t *testing.T) {
+	m := CalculateRouteMetrics(nil, nil, nil, 0)
+
+	// 期待値: すべて0
+	if m != (RouteMetrics{}) {
+		t.Errorf("CalculateRouteMetrics(nil) = %+v, want zero value", m)
+	}
+}
+
+// TestWalk_ApplyRouteMetrics は計測値の反映テスト
+func TestWalk_ApplyRouteMetrics(t *testing.T) {
+	t.Run("2点以上で反映される", func(t *testing.T) {
+		w := NewWalk("user-123", "テスト散歩", "")
+		w.TotalDistance = 9999 // クライアント報告値
+
+		w.ApplyRouteMetrics(RouteMetrics{
+			Distance: 1200, MovingTime: 900, AveragePace: 750, MaxSpeed: 2.5, AcceptedPoints: 10,
+		})
+
+		// 期待値: サーバー算出値で上書きされる
+		if w.TotalDistance != 1200 || w.MovingTime != 900 || w.AveragePace != 750 || w.MaxSpeed != 2.5 {
+			t.Errorf("metrics not applied: %+v", w)
+		}
+	})
+
+	t.Run("2点未満では反映されない", func(t *testing.T) {
+		w := NewWalk("user-123", "テスト散歩", "")
+		w.TotalDistance = 9999
+
+		w.ApplyRouteMetrics(RouteMetrics{AcceptedPoints: 1})
+
+		// 期待値: クライアント報告値が維持される
+		if w.TotalDistance != 9999 {
+			t.Errorf("TotalDistance = %v, want 9999", w.TotalDistance)
+		}
+	})
+}
+
+// TestFastestSplitPace は最速区間ペースの算出テスト
+func TestFastestSplitPace(t *testing.T) {
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	segment := HaversineDistance(0, 0, 0, 0.001)
+
+	// 赤道上を経度0.001度（約111.2m）ずつ東へ進む
+	// 前半10区間は60秒間隔、後半10区間は30秒間隔
+	locations := make([]*WalkLocation, 0, 21)
+	ts := start
+	for i := 0; i <= 20; i++ {
+		locations = append(locations, newTestLocation(i, 0, float64(i)*0.001, ts, nil))
+		if i < 10 {
+			ts = ts.Add(60 * time.Second)
+		} else {
+			ts = ts.Add(30 * time.Second)
+		}
+	}
+
+	pace, ok := FastestSplitPace(locations, 1000)
+
+	// 期待値: 1km以上となる最短の区間は9区間で、後半の30秒間隔の区間が最速
+	if !ok {
+		t.Fatal("FastestSplitPace() ok = false, want true")
+	}
+	wantPace := 9 * 30 / (9 * segment / 1000)
+	if math.Abs(pace-wantPace) > 0.01 {
+		t.Errorf("FastestSplitPace() = %v, want %v", pace, wantPace)
+	}
+}
+
+// TestFastestSplitPace_TooShort は総距離が区間距離に満たない場合のテスト
+func TestFastestSplitPace_TooShort(t *testing.T) {
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	locations := []*WalkLocation{
+		newTestLocation(0, 0, 0.000, start, nil),
+		newTestLocation(1, 0, 0.005, start.Add(5*time.Minute), nil),
+	}
+
+	// 期待値: 約556mしか歩いていないため記録なし
+	if _, ok := FastestSplitPace(locations, 1000); ok {
+		t.Error("FastestSplitPace() ok = true, want false")
+	}
+}
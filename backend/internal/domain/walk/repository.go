@@ -8,6 +8,10 @@ import (
 
 // Repository はWalkの永続化層へのインターフェース（依存性逆転の原則）
 // Infrastructure層でこのインターフェースを実装する
+// Walkを受け取る書き込み（Create・Update・Upsert・Delete）は、保存されていないドメインイベントを
+// 同じトランザクションでアウトボックスに書き込み、書き込み後にWalkから取り除く
+// Update・Upsert・Delete は w.Version が保存済みの版数と一致する場合のみ書き込み（compare-and-swap）、
+// 一致しない場合は ErrVersionMismatch を返す。書き込んだ場合は新しい版数を w.Version に反映する
 type Repository interface {
 	// Create は新しいWalkを作成する
 	Create(ctx context.Context, walk *Walk) error
@@ -18,15 +22,30 @@ type Repository interface {
 	// FindByUserID はユーザーIDでWalkの一覧を取得する
 	FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*Walk, error)
 
+	// FindByCriteria は検索条件に一致するWalkの一覧を取得する
+	FindByCriteria(ctx context.Context, criteria ListCriteria) ([]*Walk, error)
+
 	// Update はWalkを更新する
 	Update(ctx context.Context, walk *Walk) error
 
 	// Upsert はWalkを作成または更新する（存在しなければ作成、存在すれば更新）
 	Upsert(ctx context.Context, walk *Walk) error
 
-	// Delete はWalkを削除する
-	Delete(ctx context.Context, id uuid.UUID) error
+	// UpdateThumbnailURL はWalkのサムネイル画像のURLのみを更新する
+	// 版数の確認はせず、版数を1増やす
+	UpdateThumbnailURL(ctx context.Context, id uuid.UUID, url string) error
+
+	// ReplaceTags はWalkのタグを w.Tags の名前で置き換える
+	// 存在しない名前のタグはWalkの所有者のタグとして作成し、保存後のタグ名を w.Tags に反映する
+	ReplaceTags(ctx context.Context, walk *Walk) error
+
+	// Delete はWalkを削除する。存在しない場合は sql.ErrNoRows を返す
+	// 削除のイベントは事前に w.MarkDeleted で記録しておく
+	Delete(ctx context.Context, walk *Walk) error
 
 	// Count はユーザーのWalk総数を取得する
 	Count(ctx context.Context, userID string) (int, error)
+
+	// CountByCriteria は絞り込み条件に一致するユーザーのWalk総数を取得する
+	CountByCriteria(ctx context.Context, userID string, filter WalkFilter) (int, error)
 }
@@ -0,0 +1,84 @@
+package walk
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidTransition は許可されていない状態遷移を表すセンチネルエラー
+// errors.Is で判定し、遷移元・遷移先は InvalidTransitionError から取得する
+var ErrInvalidTransition = errors.New("invalid walk status transition")
+
+// InvalidTransitionError は状態遷移エラーの詳細（遷移元・遷移先）を保持する
+type InvalidTransitionError struct {
+	From WalkStatus
+	To   WalkStatus
+}
+
+// Error は error インターフェースの実装
+func (e *InvalidTransitionError) Error() string {
+	return fmt.Sprintf("cannot transition walk from %s to %s", e.From, e.To)
+}
+
+// Is は errors.Is で ErrInvalidTransition と一致させる
+func (e *InvalidTransitionError) Is(target error) bool {
+	return target == ErrInvalidTransition
+}
+
+// transitions は散歩ステータスの遷移表
+// キーが遷移元、値が遷移可能なステータスの一覧
+//
+//	not_started -> in_progress
+//	in_progress -> paused, completed
+//	paused      -> in_progress, completed
+//	completed   -> （終端）
+var transitions = map[WalkStatus][]WalkStatus{
+	StatusNotStarted: {StatusInProgress},
+	StatusInProgress: {StatusPaused, StatusCompleted},
+	StatusPaused:     {StatusInProgress, StatusCompleted},
+	StatusCompleted:  {},
+}
+
+// event は散歩の状態を変化させる操作
+type event string
+
+const (
+	eventStart    event = "start"
+	eventPause    event = "pause"
+	eventResume   event = "resume"
+	eventComplete event = "complete"
+)
+
+// eventTransitions は操作ごとの遷移元と遷移先
+// Start と Resume はどちらも in_progress へ遷移するため、遷移元を操作単位で限定する
+var eventTransitions = map[event]struct {
+	from []WalkStatus
+	to   WalkStatus
+}{
+	eventStart:    {from: []WalkStatus{StatusNotStarted}, to: StatusInProgress},
+	eventPause:    {from: []WalkStatus{StatusInProgress}, to: StatusPaused},
+	eventResume:   {from: []WalkStatus{StatusPaused}, to: StatusInProgress},
+	eventComplete: {from: []WalkStatus{StatusInProgress, StatusPaused}, to: StatusCompleted},
+}
+
+// CanTransitionTo は現在のステータスから指定ステータスへ遷移可能かどうかを返す
+func (s WalkStatus) CanTransitionTo(to WalkStatus) bool {
+	for _, allowed := range transitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// checkTransition は操作が現在のステータスから実行可能かを検証する
+// 実行不可の場合は InvalidTransitionError を返す
+func (w *Walk) checkTransition(e event) error {
+	t := eventTransitions[e]
+	for _, from := range t.from {
+		if w.Status == from && w.Status.CanTransitionTo(t.to) {
+			return nil
+		}
+	}
+	return &InvalidTransitionError{From: w.Status, To: t.to}
+}
@@ -0,0 +1,186 @@
+package walk
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// newWalkInStatus は指定ステータスのWalkをテスト用に生成する
+func newWalkInStatus(status WalkStatus) *Walk {
+	w := NewWalk("user-123", "状態遷移テスト", "")
+	now := time.Now()
+	switch status {
+	case StatusInProgress:
+		start := now.Add(-30 * time.Minute)
+		w.StartTime = &start
+	case StatusPaused:
+		start := now.Add(-30 * time.Minute)
+		pausedAt := now.Add(-5 * time.Minute)
+		w.StartTime = &start
+		w.PausedAt = &pausedAt
+		w.TotalPausedDuration = 60
+	case StatusCompleted:
+		start := now.Add(-30 * time.Minute)
+		end := now.Add(-1 * time.Minute)
+		w.StartTime = &start
+		w.EndTime = &end
+		w.TotalPausedDuration = 60
+	}
+	w.Status = status
+	return w
+}
+
+// TestWalk_TransitionTable は全ステータス×全操作の遷移表を網羅的に検証する
+func TestWalk_TransitionTable(t *testing.T) {
+	actions := map[string]struct {
+		target WalkStatus
+		apply  func(*Walk) error
+	}{
+		"Start":    {target: StatusInProgress, apply: (*Walk).Start},
+		"Pause":    {target: StatusPaused, apply: (*Walk).Pause},
+		"Resume":   {target: StatusInProgress, apply: (*Walk).Resume},
+		"Complete": {target: StatusCompleted, apply: (*Walk).Complete},
+	}
+
+	tests := []struct {
+		from    WalkStatus
+		action  string
+		wantErr bool
+	}{
+		// not_started からは開始のみ可能
+		{from: StatusNotStarted, action: "Start", wantErr: false},
+		{from: StatusNotStarted, action: "Pause", wantErr: true},
+		{from: StatusNotStarted, action: "Resume", wantErr: true},
+		{from: StatusNotStarted, action: "Complete", wantErr: true},
+
+		// in_progress からは一時停止・完了が可能
+		{from: StatusInProgress, action: "Start", wantErr: true},
+		{from: StatusInProgress, action: "Pause", wantErr: false},
+		{from: StatusInProgress, action: "Resume", wantErr: true},
+		{from: StatusInProgress, action: "Complete", wantErr: false},
+
+		// paused からは再開・完了が可能
+		{from: StatusPaused, action: "Start", wantErr: true},
+		{from: StatusPaused, action: "Pause", wantErr: true},
+		{from: StatusPaused, action: "Resume", wantErr: false},
+		{from: StatusPaused, action: "Complete", wantErr: false},
+
+		// completed は終端状態
+		{from: StatusCompleted, action: "Start", wantErr: true},
+		{from: StatusCompleted, action: "Pause", wantErr: true},
+		{from: StatusCompleted, action: "Resume", wantErr: true},
+		{from: StatusCompleted, action: "Complete", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"_"+tt.action, func(t *testing.T) {
+			action := actions[tt.action]
+			w := newWalkInStatus(tt.from)
+			before := *w
+
+			err := action.apply(w)
+
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("%s() error = %v, want nil", tt.action, err)
+				}
+				if w.Status != action.target {
+					t.Errorf("Status = %v, want %v", w.Status, action.target)
+				}
+				return
+			}
+
+			// 期待値: ErrInvalidTransition として判定でき、遷移元・遷移先を保持する
+			if !errors.Is(err, ErrInvalidTransition) {
+				t.Fatalf("%s() error = %v, want ErrInvalidTransition", tt.action, err)
+			}
+			var transitionErr *InvalidTransitionError
+			if !errors.As(err, &transitionErr) {
+				t.Fatalf("%s() error type = %T, want *InvalidTransitionError", tt.action, err)
+			}
+			if transitionErr.From != tt.from || transitionErr.To != action.target {
+				t.Errorf("InvalidTransitionError = %v -> %v, want %v -> %v",
+					transitionErr.From, transitionErr.To, tt.from, action.target)
+			}
+
+			// 期待値: 不正な遷移ではエンティティが一切変更されない
+			if w.Status != before.Status ||
+				w.StartTime != before.StartTime ||
+				w.EndTime != before.EndTime ||
+				w.PausedAt != before.PausedAt ||
+				w.TotalPausedDuration != before.TotalPausedDuration ||
+				!w.UpdatedAt.Equal(before.UpdatedAt) {
+				t.Errorf("Walk was modified by rejected %s(): got %+v, want %+v", tt.action, *w, before)
+			}
+		})
+	}
+}
+
+// TestWalkStatus_CanTransitionTo は遷移表の判定メソッドのテスト
+func TestWalkStatus_CanTransitionTo(t *testing.T) {
+	statuses := []WalkStatus{StatusNotStarted, StatusInProgress, StatusPaused, StatusCompleted}
+	allowed := map[WalkStatus]map[WalkStatus]bool{
+		StatusNotStarted: {StatusInProgress: true},
+		StatusInProgress: {StatusPaused: true, StatusCompleted: true},
+		StatusPaused:     {StatusInProgress: true, StatusCompleted: true},
+		StatusCompleted:  {},
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			want := allowed[from][to]
+			if got := from.CanTransitionTo(to); got != want {
+				t.Errorf("%s.CanTransitionTo(%s) = %v, want %v", from, to, got, want)
+			}
+		}
+	}
+}
+
+// TestWalk_Complete_FromPaused は一時停止中に完了した場合の一時停止時間の加算を検証する
+func TestWalk_Complete_FromPaused(t *testing.T) {
+	w := newWalkInStatus(StatusPaused)
+	initialPausedDuration := w.TotalPausedDuration
+
+	if err := w.Complete(); err != nil {
+		t.Fatalf("Complete() error = %v, want nil", err)
+	}
+
+	// 期待値: 一時停止開始から完了までの約5分が加算され、PausedAtはクリアされる
+	added := w.TotalPausedDuration - initialPausedDuration
+	if added < 299 || added > 301 {
+		t.Errorf("added paused duration = %v, want about 300 seconds", added)
+	}
+	if w.PausedAt != nil {
+		t.Error("PausedAt should be nil after Complete()")
+	}
+	if w.EndTime == nil {
+		t.Error("EndTime should not be nil after Complete()")
+	}
+}
+
+// TestWalk_OutOfOrderEvents は再接続後の順不同イベントで値が壊れないことを検証する
+func TestWalk_OutOfOrderEvents(t *testing.T) {
+	w := newWalkInStatus(StatusCompleted)
+	endTime := w.EndTime
+	pausedDuration := w.TotalPausedDuration
+
+	// 完了後に遅れて届いた pause / resume / complete はすべて拒否される
+	for name, apply := range map[string]func() error{
+		"Pause":    w.Pause,
+		"Resume":   w.Resume,
+		"Complete": w.Complete,
+		"Start":    w.Start,
+	} {
+		if err := apply(); !errors.Is(err, ErrInvalidTransition) {
+			t.Errorf("%s() error = %v, want ErrInvalidTransition", name, err)
+		}
+	}
+
+	if w.EndTime != endTime {
+		t.Errorf("EndTime changed: got %v, want %v", w.EndTime, endTime)
+	}
+	if w.TotalPausedDuration != pausedDuration {
+		t.Errorf("TotalPausedDuration changed: got %v, want %v", w.TotalPausedDuration, pausedDuration)
+	}
+}
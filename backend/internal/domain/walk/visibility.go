@@ -0,0 +1,46 @@
+package walk
+
+// Visibility は散歩の公開範囲を表す
+type Visibility string
+
+const (
+	// VisibilityPrivate は本人のみ閲覧できる公開範囲（既定値）
+	VisibilityPrivate Visibility = "private"
+	// VisibilityFriends は本人と友達（相互フォロー）が閲覧できる公開範囲
+	VisibilityFriends Visibility = "friends"
+	// VisibilityPublicLink は散歩のIDを知っている全ユーザーが閲覧できる公開範囲
+	VisibilityPublicLink Visibility = "public_link"
+)
+
+// IsValid は定義済みの公開範囲かどうかを返す
+func (v Visibility) IsValid() bool {
+	switch v {
+	case VisibilityPrivate, VisibilityFriends, VisibilityPublicLink:
+		return true
+	}
+	return false
+}
+
+// ViewerRelation は閲覧者と散歩の所有者の関係
+type ViewerRelation struct {
+	IsFriend  bool // 相互に承認済みのフォロー関係にある
+	IsBlocked bool // どちらかがもう一方をブロックしている
+}
+
+// IsVisibleTo は閲覧者が散歩を閲覧できるかどうかを返す
+// 本人は公開範囲によらず閲覧でき、ブロック関係にあるユーザーは公開範囲によらず閲覧できない
+func (w *Walk) IsVisibleTo(viewerID string, rel ViewerRelation) bool {
+	if w.UserID == viewerID {
+		return true
+	}
+	if rel.IsBlocked {
+		return false
+	}
+	switch w.Visibility {
+	case VisibilityPublicLink:
+		return true
+	case VisibilityFriends:
+		return rel.IsFriend
+	}
+	return false
+}
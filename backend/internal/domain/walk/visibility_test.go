@@ -0,0 +1,53 @@
+package walk
+
+import "testing"
+
+func TestVisibility_IsValid(t *testing.T) {
+	tests := []struct {
+		visibility Visibility
+		want       bool
+	}{
+		{VisibilityPrivate, true},
+		{VisibilityFriends, true},
+		{VisibilityPublicLink, true},
+		{Visibility(""), false},
+		{Visibility("public"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.visibility), func(t *testing.T) {
+			if got := tt.visibility.IsValid(); got != tt.want {
+				t.Errorf("IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWalk_IsVisibleTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility Visibility
+		viewerID   string
+		rel        ViewerRelation
+		want       bool
+	}{
+		{name: "本人は非公開でも閲覧できる", visibility: VisibilityPrivate, viewerID: "owner", want: true},
+		{name: "非公開は友達でも閲覧できない", visibility: VisibilityPrivate, viewerID: "other", rel: ViewerRelation{IsFriend: true}, want: false},
+		{name: "友達限定は友達が閲覧できる", visibility: VisibilityFriends, viewerID: "other", rel: ViewerRelation{IsFriend: true}, want: true},
+		{name: "友達限定は友達以外は閲覧できない", visibility: VisibilityFriends, viewerID: "other", want: false},
+		{name: "リンク公開は誰でも閲覧できる", visibility: VisibilityPublicLink, viewerID: "other", want: true},
+		{name: "リンク公開でもブロック関係にあれば閲覧できない", visibility: VisibilityPublicLink, viewerID: "other", rel: ViewerRelation{IsBlocked: true}, want: false},
+		{name: "友達限定でもブロック関係にあれば閲覧できない", visibility: VisibilityFriends, viewerID: "other", rel: ViewerRelation{IsFriend: true, IsBlocked: true}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWalk("owner", "公開範囲テスト", "")
+			w.Visibility = tt.visibility
+
+			if got := w.IsVisibleTo(tt.viewerID, tt.rel); got != tt.want {
+				t.Errorf("IsVisibleTo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,11 +1,20 @@
 package walk
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// ErrNotOwner は他のユーザーの散歩を操作しようとしたことを表す
+// 散歩の存在を他のユーザーに知られないよう、APIでは存在しない場合と同じ扱いにする
+var ErrNotOwner = errors.New("walk belongs to another user")
+
+// ErrVersionMismatch は散歩の版数が期待した値と一致しないことを表す
+// 取得した後に他の更新が保存された場合や、If-Match で指定された版数が古い場合に返す
+var ErrVersionMismatch = errors.New("walk version mismatch")
+
 // WalkStatus は散歩のステータスを表す
 type WalkStatus string
 
@@ -20,6 +29,15 @@ const (
 	StatusCompleted WalkStatus = "completed"
 )
 
+// IsValid は定義済みのステータスかどうかを返す
+func (s WalkStatus) IsValid() bool {
+	switch s {
+	case StatusNotStarted, StatusInProgress, StatusPaused, StatusCompleted:
+		return true
+	}
+	return false
+}
+
 // Walk は散歩のドメインエンティティ
 type Walk struct {
 	ID                  uuid.UUID  `json:"id"`
@@ -35,43 +53,58 @@ type Walk struct {
 	Status              WalkStatus `json:"status"`
 	PausedAt            *time.Time `json:"paused_at"`
 	TotalPausedDuration float64    `json:"total_paused_duration"` // 秒
+	MovingTime          float64    `json:"moving_time"`           // 一時停止を除く移動時間（秒）
+	AveragePace         float64    `json:"average_pace"`          // 平均ペース（秒/km）
+	MaxSpeed            float64    `json:"max_speed"`             // 最高速度（m/s）
+	Visibility          Visibility `json:"visibility"`            // 公開範囲
+	Tags                []string   `json:"tags"`                  // タグ名（名前順）
+	Version             int        `json:"version"`               // 楽観的排他制御の版数（保存のたびに1増える。未保存の場合は0）
 	CreatedAt           time.Time  `json:"created_at"`
 	UpdatedAt           time.Time  `json:"updated_at"`
+
+	events []DomainEvent // 保存されていないドメインイベント
 }
 
 // NewWalk は新しいWalkエンティティを生成する
 func NewWalk(userID, title, description string) *Walk {
 	now := time.Now()
-	return &Walk{
+	w := &Walk{
 		ID:                  uuid.New(),
 		UserID:              userID,
 		Title:               title,
 		Description:         description,
 		Status:              StatusNotStarted,
+		Visibility:          VisibilityPrivate,
 		TotalDistance:       0,
 		TotalSteps:          0,
 		TotalPausedDuration: 0,
 		CreatedAt:           now,
 		UpdatedAt:           now,
 	}
+	w.recordEvent(&WalkCreated{EventMetadata: w.eventMetadata()})
+	return w
 }
 
 // Start は散歩を開始する
+// 未開始の散歩のみ開始でき、それ以外は InvalidTransitionError を返す
 func (w *Walk) Start() error {
-	// TODO: バリデーション実装
-	// - 既に開始済みの場合はエラー
-	// - 完了済みの場合はエラー
+	if err := w.checkTransition(eventStart); err != nil {
+		return err
+	}
 	now := time.Now()
 	w.StartTime = &now
 	w.Status = StatusInProgress
 	w.UpdatedAt = now
+	w.recordEvent(&WalkStarted{EventMetadata: w.eventMetadata(), StartTime: w.StartTime})
 	return nil
 }
 
 // Pause は散歩を一時停止する
+// 進行中の散歩のみ一時停止でき、それ以外は InvalidTransitionError を返す
 func (w *Walk) Pause() error {
-	// TODO: バリデーション実装
-	// - 進行中でない場合はエラー
+	if err := w.checkTransition(eventPause); err != nil {
+		return err
+	}
 	now := time.Now()
 	w.PausedAt = &now
 	w.Status = StatusPaused
@@ -80,31 +113,42 @@ func (w *Walk) Pause() error {
 }
 
 // Resume は散歩を再開する
+// 一時停止中の散歩のみ再開でき、それ以外は InvalidTransitionError を返す
 func (w *Walk) Resume() error {
-	// TODO: バリデーション実装
-	// - 一時停止中でない場合はエラー
-	if w.PausedAt != nil {
-		pausedDuration := time.Since(*w.PausedAt).Seconds()
-		w.TotalPausedDuration += pausedDuration
+	if err := w.checkTransition(eventResume); err != nil {
+		return err
 	}
-	w.PausedAt = nil
+	now := time.Now()
+	w.accumulatePausedDuration(now)
 	w.Status = StatusInProgress
-	w.UpdatedAt = time.Now()
+	w.UpdatedAt = now
 	return nil
 }
 
 // Complete は散歩を完了する
+// 進行中または一時停止中の散歩のみ完了でき、それ以外は InvalidTransitionError を返す
+// 一時停止中に完了した場合は、完了時点までを一時停止時間として加算する
 func (w *Walk) Complete() error {
-	// TODO: バリデーション実装
-	// - 開始されていない場合はエラー
-	// - 既に完了済みの場合はエラー
+	if err := w.checkTransition(eventComplete); err != nil {
+		return err
+	}
 	now := time.Now()
+	w.accumulatePausedDuration(now)
 	w.EndTime = &now
 	w.Status = StatusCompleted
 	w.UpdatedAt = now
+	w.recordEvent(&WalkCompleted{EventMetadata: w.eventMetadata(), EndTime: w.EndTime})
 	return nil
 }
 
+// accumulatePausedDuration は一時停止開始から指定時刻までの経過秒をTotalPausedDurationに加算する
+func (w *Walk) accumulatePausedDuration(now time.Time) {
+	if w.PausedAt != nil {
+		w.TotalPausedDuration += now.Sub(*w.PausedAt).Seconds()
+	}
+	w.PausedAt = nil
+}
+
 // UpdateDistance は総距離を更新する
 func (w *Walk) UpdateDistance(distance float64) {
 	w.TotalDistance = distance
@@ -117,6 +161,19 @@ func (w *Walk) UpdateSteps(steps int) {
 	w.UpdatedAt = time.Now()
 }
 
+// ApplyRouteMetrics は位置情報から算出した計測値を反映する
+// 採用できる位置情報が2点未満の場合は、クライアント報告値を維持するため何もしない
+func (w *Walk) ApplyRouteMetrics(m RouteMetrics) {
+	if m.AcceptedPoints < 2 {
+		return
+	}
+	w.TotalDistance = m.Distance
+	w.MovingTime = m.MovingTime
+	w.AveragePace = m.AveragePace
+	w.MaxSpeed = m.MaxSpeed
+	w.UpdatedAt = time.Now()
+}
+
 // IsInProgress は散歩が進行中かどうかを返す
 func (w *Walk) IsInProgress() bool {
 	return w.Status == StatusInProgress
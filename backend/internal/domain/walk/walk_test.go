@@ -66,6 +66,10 @@ func TestNewWalk(t *testing.T) {
 				t.Errorf("Status = %v, want %v", walk.Status, StatusNotStarted)
 			}
 
+			if walk.Visibility != VisibilityPrivate {
+				t.Errorf("Visibility = %v, want %v", walk.Visibility, VisibilityPrivate)
+			}
+
 			if walk.TotalDistance != 0 {
 				t.Errorf("TotalDistance = %v, want 0", walk.TotalDistance)
 			}
@@ -1,9 +1,14 @@
 package config
 
 import (
+	"crypto/rand"
 	"fmt"
 	"os"
 	"strconv"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/job"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/polyline"
 )
 
 // Config はアプリケーション設定
@@ -13,6 +18,11 @@ type Config struct {
 	Database    DatabaseConfig
 	Firebase    FirebaseConfig
 	Log         LogConfig
+	Route       RouteConfig
+	Record      RecordConfig
+	Share       ShareConfig
+	Storage     StorageConfig
+	Job         JobConfig
 }
 
 // DatabaseConfig はデータベース設定
@@ -38,6 +48,45 @@ type LogConfig struct {
 	Format string // json or text
 }
 
+// RouteConfig は散歩ルート処理の設定
+type RouteConfig struct {
+	PolylineTolerance float64 // ポリライン簡略化の許容誤差（メートル）
+}
+
+// RecordConfig は自己ベスト・連続記録の設定
+type RecordConfig struct {
+	TimeZone *time.Location // 連続日数や1日の歩数を判定する暦日のタイムゾーン
+}
+
+// ShareConfig は散歩の共有リンクの設定
+type ShareConfig struct {
+	SigningKey []byte // 共有トークンの署名鍵
+}
+
+// ストレージの種類（STORAGE_BACKEND）
+const (
+	StorageBackendCloud = "gcs"   // Cloud Storage
+	StorageBackendLocal = "local" // ローカルのディレクトリ（開発用）
+)
+
+// StorageConfig は写真などのファイルを保存するストレージの設定
+type StorageConfig struct {
+	Backend      string // StorageBackendCloud または StorageBackendLocal
+	BucketName   string // Cloud Storageのバケット名
+	LocalDir     string // ローカルストレージの保存先ディレクトリ
+	LocalBaseURL string // ローカルストレージのファイルURLの起点（GET /files/*path を提供するAPIのURL）
+}
+
+// JobConfig はバックグラウンドジョブの設定
+type JobConfig struct {
+	Workers      int           // APIサーバー内で起動するワーカー数（0の場合は起動しない。イベントの配信は起動する）
+	PollInterval time.Duration // 実行できるジョブがない場合に再度取得するまでの間隔
+	MaxAttempts  int           // ジョブの試行回数の上限（超えた場合はデッドレターにする）
+}
+
+// shareSigningKeySize は署名鍵を生成する場合の長さ（バイト）
+const shareSigningKeySize = 32
+
 // Load は環境変数から設定を読み込む
 func Load() (*Config, error) {
 	dbPort, err := strconv.Atoi(getEnv("DB_PORT", "5432"))
@@ -45,9 +94,47 @@ func Load() (*Config, error) {
 		dbPort = 5432 // デフォルト値を使用
 	}
 
+	polylineTolerance, err := strconv.ParseFloat(getEnv("POLYLINE_TOLERANCE_METERS", ""), 64)
+	if err != nil || polylineTolerance < 0 {
+		polylineTolerance = polyline.DefaultTolerance // デフォルト値を使用
+	}
+
+	recordTimeZone, err := time.LoadLocation(getEnv("RECORD_TIME_ZONE", "Asia/Tokyo"))
+	if err != nil {
+		recordTimeZone = time.UTC // デフォルト値を使用
+	}
+
+	jobWorkers, err := strconv.Atoi(getEnv("JOB_WORKERS", "2"))
+	if err != nil || jobWorkers < 0 {
+		jobWorkers = 2 // デフォルト値を使用
+	}
+
+	jobPollInterval, err := time.ParseDuration(getEnv("JOB_POLL_INTERVAL", "1s"))
+	if err != nil || jobPollInterval <= 0 {
+		jobPollInterval = time.Second // デフォルト値を使用
+	}
+
+	jobMaxAttempts, err := strconv.Atoi(getEnv("JOB_MAX_ATTEMPTS", ""))
+	if err != nil || jobMaxAttempts < 1 {
+		jobMaxAttempts = job.DefaultMaxAttempts // デフォルト値を使用
+	}
+
+	environment := getEnv("ENVIRONMENT", "development")
+	port := getEnv("PORT", "8080")
+
+	shareSigningKey, err := loadShareSigningKey(environment)
+	if err != nil {
+		return nil, err
+	}
+
+	storageBackend, err := loadStorageBackend(environment)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Config{
-		Environment: getEnv("ENVIRONMENT", "development"),
-		Port:        getEnv("PORT", "8080"),
+		Environment: environment,
+		Port:        port,
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
 			Port:     dbPort,
@@ -65,9 +152,71 @@ func Load() (*Config, error) {
 			Level:  getEnv("LOG_LEVEL", "info"),
 			Format: getEnv("LOG_FORMAT", "json"),
 		},
+		Route: RouteConfig{
+			PolylineTolerance: polylineTolerance,
+		},
+		Record: RecordConfig{
+			TimeZone: recordTimeZone,
+		},
+		Share: ShareConfig{
+			SigningKey: shareSigningKey,
+		},
+		Storage: StorageConfig{
+			Backend:      storageBackend,
+			BucketName:   getEnv("STORAGE_BUCKET", ""),
+			LocalDir:     getEnv("STORAGE_LOCAL_DIR", "./data/storage"),
+			LocalBaseURL: getEnv("STORAGE_LOCAL_BASE_URL", "http://localhost:"+port),
+		},
+		Job: JobConfig{
+			Workers:      jobWorkers,
+			PollInterval: jobPollInterval,
+			MaxAttempts:  jobMaxAttempts,
+		},
 	}, nil
 }
 
+// loadShareSigningKey は共有トークンの署名鍵を読み込む
+// 本番環境では必須。それ以外では未設定の場合に起動ごとの鍵を生成する（再起動で既存のリンクは無効になる）
+func loadShareSigningKey(environment string) ([]byte, error) {
+	if key := os.Getenv("SHARE_SIGNING_KEY"); key != "" {
+		return []byte(key), nil
+	}
+	if environment == "production" {
+		return nil, fmt.Errorf("SHARE_SIGNING_KEY is required in production")
+	}
+
+	key := make([]byte, shareSigningKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate share signing key: %w", err)
+	}
+	return key, nil
+}
+
+// loadStorageBackend はストレージの種類を読み込む
+// 未設定の場合は本番環境で Cloud Storage、それ以外でローカルストレージを使う
+// ローカルストレージのファイルは開発環境でのみ配信するため、本番環境では使えない
+func loadStorageBackend(environment string) (string, error) {
+	backend := os.Getenv("STORAGE_BACKEND")
+	if backend == "" {
+		if environment == "production" {
+			return StorageBackendCloud, nil
+		}
+		return StorageBackendLocal, nil
+	}
+
+	switch backend {
+	case StorageBackendCloud:
+		return backend, nil
+	case StorageBackendLocal:
+		if environment == "production" {
+			return "", fmt.Errorf("STORAGE_BACKEND=%s is not allowed in production", StorageBackendLocal)
+		}
+		return backend, nil
+	default:
+		return "", fmt.Errorf("unknown STORAGE_BACKEND: %q (must be %q or %q)", backend, StorageBackendCloud, StorageBackendLocal)
+	}
+}
+
 // getEnv は環境変数を取得する。存在しない場合はデフォルト値を返す
 func getEnv(key, defaultValue string) string {
 	value := os.Getenv(key)
@@ -101,3 +101,61 @@ func TestIsProduction(t *testing.T) {
 		assert.False(t, cfg.IsProduction())
 	})
 }
+
+func TestLoadShareSigningKey(t *testing.T) {
+	t.Run("環境変数が設定されている場合はそれを使う", func(t *testing.T) {
+		t.Setenv("SHARE_SIGNING_KEY", "secret")
+
+		key, err := loadShareSigningKey("production")
+
+		require.NoError(t, err)
+		assert.Equal(t, []byte("secret"), key)
+	})
+
+	t.Run("本番環境で未設定の場合はエラー", func(t *testing.T) {
+		t.Setenv("SHARE_SIGNING_KEY", "")
+
+		_, err := loadShareSigningKey("production")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("開発環境で未設定の場合は鍵を生成する", func(t *testing.T) {
+		t.Setenv("SHARE_SIGNING_KEY", "")
+
+		key, err := loadShareSigningKey("development")
+
+		require.NoError(t, err)
+		assert.Len(t, key, shareSigningKeySize)
+	})
+}
+
+func TestLoadStorageBackend(t *testing.T) {
+	t.Run("未設定の場合は環境に応じて選ぶ", func(t *testing.T) {
+		t.Setenv("STORAGE_BACKEND", "")
+
+		backend, err := loadStorageBackend("production")
+		require.NoError(t, err)
+		assert.Equal(t, StorageBackendCloud, backend)
+
+		backend, err = loadStorageBackend("development")
+		require.NoError(t, err)
+		assert.Equal(t, StorageBackendLocal, backend)
+	})
+
+	t.Run("本番環境ではローカルストレージを使えない", func(t *testing.T) {
+		t.Setenv("STORAGE_BACKEND", StorageBackendLocal)
+
+		_, err := loadStorageBackend("production")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("不明な種類はエラー", func(t *testing.T) {
+		t.Setenv("STORAGE_BACKEND", "s3")
+
+		_, err := loadStorageBackend("development")
+
+		assert.Error(t, err)
+	})
+}
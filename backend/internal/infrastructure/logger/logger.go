@@ -76,6 +76,11 @@ func NewLogger(level, format string) (Logger, error) {
 	return &zapLogger{logger: logger}, nil
 }
 
+// NewNopLogger は何も出力しないロガーを作成する（テスト用）
+func NewNopLogger() Logger {
+	return &zapLogger{logger: zap.NewNop()}
+}
+
 // Debug はデバッグログを出力する
 func (l *zapLogger) Debug(msg string, fields ...zap.Field) {
 	l.logger.Debug(msg, fields...)
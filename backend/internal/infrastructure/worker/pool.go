@@ -0,0 +1,72 @@
+// Package worker はバックグラウンドジョブを処理するワーカーを提供する
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/logger"
+	"go.uber.org/zap"
+)
+
+// Processor はジョブを1件ずつ処理するインターフェース
+// jobusecase.Usecase が実装する
+type Processor interface {
+	// ProcessNext は実行できるジョブを1件処理する。処理したジョブがあった場合は true を返す
+	ProcessNext(ctx context.Context) (bool, error)
+}
+
+// Pool は複数のワーカーでジョブを並行して処理する
+type Pool struct {
+	processor    Processor
+	workers      int
+	pollInterval time.Duration
+	logger       logger.Logger
+}
+
+// NewPool は新しいワーカープールを生成する
+// 各ワーカーは実行できるジョブがなくなると pollInterval だけ待ってから再度取得する
+func NewPool(processor Processor, workers int, pollInterval time.Duration, log logger.Logger) *Pool {
+	return &Pool{
+		processor:    processor,
+		workers:      workers,
+		pollInterval: pollInterval,
+		logger:       log,
+	}
+}
+
+// Run はワーカーを起動し、ctx がキャンセルされるまでジョブを処理する
+// キャンセル後は新しいジョブを取得せず、処理中のジョブが終わるのを待ってから返る
+func (p *Pool) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for n := 0; n < p.workers; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.work(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+// work は1つのワーカーの処理ループ
+// 処理中のジョブはシャットダウンで中断しないよう、キャンセルされないコンテキストで処理する
+func (p *Pool) work(ctx context.Context) {
+	jobCtx := context.WithoutCancel(ctx)
+	for ctx.Err() == nil {
+		processed, err := p.processor.ProcessNext(jobCtx)
+		if err != nil {
+			p.logger.Error("Failed to process job", zap.Error(err))
+		}
+		if processed && err == nil {
+			continue
+		}
+
+		// ジョブがない、またはキューにアクセスできない場合は待ってから再度取得する
+		select {
+		case <-ctx.Done():
+		case <-time.After(p.pollInterval):
+		}
+	}
+}
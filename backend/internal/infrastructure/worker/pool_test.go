@@ -0,0 +1,101 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeProcessor は remaining 件のジョブを処理するProcessor
+type fakeProcessor struct {
+	remaining atomic.Int32
+	processed atomic.Int32
+	calls     atomic.Int32
+	err       error
+	block     chan struct{} // nil以外の場合、閉じられるまで処理を終えない
+}
+
+func (f *fakeProcessor) ProcessNext(ctx context.Context) (bool, error) {
+	f.calls.Add(1)
+	if f.err != nil {
+		return false, f.err
+	}
+	if f.remaining.Add(-1) < 0 {
+		return false, nil
+	}
+	if f.block != nil {
+		<-f.block
+	}
+	f.processed.Add(1)
+	return true, nil
+}
+
+func TestPool_Run(t *testing.T) {
+	t.Run("キューが空になるまで処理し、キャンセルで停止する", func(t *testing.T) {
+		p := &fakeProcessor{}
+		p.remaining.Store(10)
+		pool := NewPool(p, 3, 10*time.Millisecond, logger.NewNopLogger())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			pool.Run(ctx)
+			close(done)
+		}()
+
+		assert.Eventually(t, func() bool { return p.processed.Load() == 10 }, time.Second, 5*time.Millisecond)
+		cancel()
+
+		// 期待値: キャンセル後に停止する
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("pool did not stop after cancel")
+		}
+	})
+
+	t.Run("処理中のジョブが終わるまで待ってから停止する", func(t *testing.T) {
+		p := &fakeProcessor{block: make(chan struct{})}
+		p.remaining.Store(1)
+		pool := NewPool(p, 1, 10*time.Millisecond, logger.NewNopLogger())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var wg sync.WaitGroup
+		wg.Add(1)
+		stopped := atomic.Bool{}
+		go func() {
+			defer wg.Done()
+			pool.Run(ctx)
+			stopped.Store(true)
+		}()
+
+		assert.Eventually(t, func() bool { return p.calls.Load() == 1 }, time.Second, 5*time.Millisecond)
+		cancel()
+		time.Sleep(20 * time.Millisecond)
+
+		// 期待値: 処理中のジョブがある間は停止しない
+		assert.False(t, stopped.Load())
+
+		close(p.block)
+		wg.Wait()
+		assert.Equal(t, int32(1), p.processed.Load())
+	})
+
+	t.Run("エラーの場合は待ってから再度取得する", func(t *testing.T) {
+		p := &fakeProcessor{err: errors.New("db error")}
+		pool := NewPool(p, 1, 50*time.Millisecond, logger.NewNopLogger())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 120*time.Millisecond)
+		defer cancel()
+		pool.Run(ctx)
+
+		// 期待値: 待たずに取得を繰り返さない
+		assert.LessOrEqual(t, p.calls.Load(), int32(3))
+	})
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/api/presenter"
+	achievementusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/achievement"
+	"github.com/gin-gonic/gin"
+)
+
+// AchievementHandler は実績APIのハンドラー
+type AchievementHandler struct {
+	achievementUsecase achievementusecase.Usecase
+}
+
+// NewAchievementHandler は新しいAchievementHandlerを生成する
+func NewAchievementHandler(container *di.Container) *AchievementHandler {
+	return &AchievementHandler{
+		achievementUsecase: container.AchievementUsecase,
+	}
+}
+
+// GetMyAchievements は認証ユーザーが獲得した実績を取得する
+// GET /v1/users/me/achievements
+func (h *AchievementHandler) GetMyAchievements(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// Usecase呼び出し
+	awarded, err := h.achievementUsecase.ListAchievements(ctx, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.ToAchievementListResponse(awarded))
+}
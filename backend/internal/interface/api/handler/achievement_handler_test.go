@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/achievement"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	achievementusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/achievement"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAchievementUsecase はAchievementUsecaseのモック
+type MockAchievementUsecase struct {
+	mock.Mock
+}
+
+func (m *MockAchievementUsecase) ListAchievements(ctx context.Context, userID string) ([]achievementusecase.AwardedAchievement, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]achievementusecase.AwardedAchievement), args.Error(1)
+}
+
+func (m *MockAchievementUsecase) RecordCompletedWalk(ctx context.Context, w *walk.Walk, locations []*walk.WalkLocation) error {
+	args := m.Called(ctx, w, locations)
+	return args.Error(0)
+}
+
+func setupAchievementTestHandler() (*AchievementHandler, *MockAchievementUsecase) {
+	gin.SetMode(gin.TestMode)
+	mockUsecase := new(MockAchievementUsecase)
+	container := &di.Container{
+		AchievementUsecase: mockUsecase,
+	}
+	return NewAchievementHandler(container), mockUsecase
+}
+
+func TestAchievementHandler_GetMyAchievements_Success(t *testing.T) {
+	// 期待値: 獲得した実績を定義のタイトル・説明とともに200 OKで返す
+	handler, mockUsecase := setupAchievementTestHandler()
+
+	walkID := uuid.New()
+	rule, _ := achievement.FindRule(achievement.CodeFirst10km)
+	mockUsecase.On("ListAchievements", mock.Anything, "test-user").Return([]achievementusecase.AwardedAchievement{
+		{Achievement: achievement.NewAchievement("test-user", achievement.CodeFirst10km, walkID), Rule: rule},
+	}, nil)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/users/me/achievements", nil)
+
+	handler.GetMyAchievements(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	achievements := response["achievements"].([]interface{})
+	require.Len(t, achievements, 1)
+	first := achievements[0].(map[string]interface{})
+	assert.Equal(t, "first_10km", first["code"])
+	assert.Equal(t, "First 10 km", first["title"])
+	assert.Equal(t, walkID.String(), first["walk_id"])
+	assert.NotEmpty(t, first["awarded_at"])
+
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestAchievementHandler_GetMyAchievements_Empty(t *testing.T) {
+	// 期待値: 獲得した実績がない場合は空配列を返す
+	handler, mockUsecase := setupAchievementTestHandler()
+
+	mockUsecase.On("ListAchievements", mock.Anything, "test-user").
+		Return([]achievementusecase.AwardedAchievement{}, nil)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/users/me/achievements", nil)
+
+	handler.GetMyAchievements(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"achievements":[]}`, w.Body.String())
+}
+
+func TestAchievementHandler_GetMyAchievements_Error(t *testing.T) {
+	// 期待値: Usecaseのエラーは500
+	handler, mockUsecase := setupAchievementTestHandler()
+
+	mockUsecase.On("ListAchievements", mock.Anything, "test-user").Return(nil, errors.New("db error"))
+
+	c, w := setupTestContext(http.MethodGet, "/v1/users/me/achievements", nil)
+
+	handler.GetMyAchievements(c)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,239 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/api/presenter"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/errors"
+	collectionusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/collection"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// CollectionHandler はコレクションAPIのハンドラー
+type CollectionHandler struct {
+	collectionUsecase collectionusecase.Usecase
+}
+
+// NewCollectionHandler は新しいCollectionHandlerを生成する
+func NewCollectionHandler(container *di.Container) *CollectionHandler {
+	return &CollectionHandler{
+		collectionUsecase: container.CollectionUsecase,
+	}
+}
+
+// CreateCollectionRequest はコレクション作成のリクエスト
+type CreateCollectionRequest struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	WalkIDs     []uuid.UUID `json:"walk_ids,omitempty"`
+}
+
+// UpdateCollectionRequest はコレクション更新のリクエスト
+// walk_ids を指定した場合は含まれる散歩と並び順を置き換える
+type UpdateCollectionRequest struct {
+	Name        *string      `json:"name,omitempty"`
+	Description *string      `json:"description,omitempty"`
+	WalkIDs     *[]uuid.UUID `json:"walk_ids,omitempty"`
+}
+
+// AddCollectionWalkRequest はコレクションへの散歩追加のリクエスト
+type AddCollectionWalkRequest struct {
+	WalkID uuid.UUID `json:"walk_id" binding:"required"`
+}
+
+// ListCollections はコレクションの一覧を取得する
+// GET /v1/collections
+func (h *CollectionHandler) ListCollections(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// Usecase呼び出し
+	collections, err := h.collectionUsecase.ListCollections(ctx, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.ToCollectionListResponse(collections))
+}
+
+// GetCollection はコレクションを取得する
+// GET /v1/collections/:id
+func (h *CollectionHandler) GetCollection(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// IDパラメータ取得
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid collection ID"))
+		return
+	}
+
+	// Usecase呼び出し
+	col, err := h.collectionUsecase.GetCollection(ctx, id, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.ToCollectionResponse(col))
+}
+
+// CreateCollection はコレクションを作成する
+// POST /v1/collections
+func (h *CollectionHandler) CreateCollection(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	var req CreateCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	// Usecase呼び出し
+	input := collectionusecase.CreateCollectionInput{
+		UserID:      userID,
+		Name:        req.Name,
+		Description: req.Description,
+		WalkIDs:     req.WalkIDs,
+	}
+	col, err := h.collectionUsecase.CreateCollection(ctx, input)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusCreated, presenter.ToCollectionResponse(col))
+}
+
+// UpdateCollection はコレクションの名前・説明・散歩の並び順を更新する
+// PUT /v1/collections/:id
+func (h *CollectionHandler) UpdateCollection(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// IDパラメータ取得
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid collection ID"))
+		return
+	}
+
+	var req UpdateCollectionRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	// Usecase呼び出し
+	input := collectionusecase.UpdateCollectionInput{
+		ID:          id,
+		Name:        req.Name,
+		Description: req.Description,
+		WalkIDs:     req.WalkIDs,
+	}
+	col, err := h.collectionUsecase.UpdateCollection(ctx, input, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.ToCollectionResponse(col))
+}
+
+// DeleteCollection はコレクションを削除する（含まれる散歩は削除しない）
+// DELETE /v1/collections/:id
+func (h *CollectionHandler) DeleteCollection(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// IDパラメータ取得
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid collection ID"))
+		return
+	}
+
+	// Usecase呼び出し
+	if err := h.collectionUsecase.DeleteCollection(ctx, id, userID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.Status(http.StatusNoContent)
+}
+
+// AddWalk は散歩をコレクションの末尾に追加する
+// POST /v1/collections/:id/walks
+func (h *CollectionHandler) AddWalk(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// IDパラメータ取得
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid collection ID"))
+		return
+	}
+
+	var req AddCollectionWalkRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	// Usecase呼び出し
+	col, err := h.collectionUsecase.AddWalk(ctx, id, req.WalkID, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.ToCollectionResponse(col))
+}
+
+// RemoveWalk は散歩をコレクションから外す
+// DELETE /v1/collections/:id/walks/:walkId
+func (h *CollectionHandler) RemoveWalk(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// IDパラメータ取得
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid collection ID"))
+		return
+	}
+	walkID, err := uuid.Parse(c.Param("walkId"))
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid walk ID"))
+		return
+	}
+
+	// Usecase呼び出し
+	col, err := h.collectionUsecase.RemoveWalk(ctx, id, walkID, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.ToCollectionResponse(col))
+}
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/collection"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	collectionusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/collection"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockCollectionUsecase はCollectionUsecaseのモック
+type MockCollectionUsecase struct {
+	mock.Mock
+}
+
+func (m *MockCollectionUsecase) ListCollections(ctx context.Context, userID string) ([]*collection.Collection, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*collection.Collection), args.Error(1)
+}
+
+func (m *MockCollectionUsecase) GetCollection(ctx context.Context, id uuid.UUID, userID string) (*collection.Collection, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*collection.Collection), args.Error(1)
+}
+
+func (m *MockCollectionUsecase) CreateCollection(ctx context.Context, input collectionusecase.CreateCollectionInput) (*collection.Collection, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*collection.Collection), args.Error(1)
+}
+
+func (m *MockCollectionUsecase) UpdateCollection(ctx context.Context, input collectionusecase.UpdateCollectionInput, userID string) (*collection.Collection, error) {
+	args := m.Called(ctx, input, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*collection.Collection), args.Error(1)
+}
+
+func (m *MockCollectionUsecase) DeleteCollection(ctx context.Context, id uuid.UUID, userID string) error {
+	return m.Called(ctx, id, userID).Error(0)
+}
+
+func (m *MockCollectionUsecase) AddWalk(ctx context.Context, id, walkID uuid.UUID, userID string) (*collection.Collection, error) {
+	args := m.Called(ctx, id, walkID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*collection.Collection), args.Error(1)
+}
+
+func (m *MockCollectionUsecase) RemoveWalk(ctx context.Context, id, walkID uuid.UUID, userID string) (*collection.Collection, error) {
+	args := m.Called(ctx, id, walkID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*collection.Collection), args.Error(1)
+}
+
+func setupCollectionTestHandler() (*CollectionHandler, *MockCollectionUsecase) {
+	gin.SetMode(gin.TestMode)
+	mockUsecase := new(MockCollectionUsecase)
+	container := &di.Container{
+		CollectionUsecase: mockUsecase,
+	}
+	return NewCollectionHandler(container), mockUsecase
+}
+
+func TestCollectionHandler_CreateCollection(t *testing.T) {
+	walkID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		// 期待値: 作成したコレクションを並び順のwalk_idsとあわせて201 Createdで返す
+		handler, mockUsecase := setupCollectionTestHandler()
+
+		created := collection.NewCollection("test-user", "京都旅行", "")
+		created.SetWalks([]uuid.UUID{walkID})
+		mockUsecase.On("CreateCollection", mock.Anything, collectionusecase.CreateCollectionInput{
+			UserID:  "test-user",
+			Name:    "京都旅行",
+			WalkIDs: []uuid.UUID{walkID},
+		}).Return(created, nil)
+
+		c, w := setupTestContext(http.MethodPost, "/v1/collections", CreateCollectionRequest{
+			Name:    "京都旅行",
+			WalkIDs: []uuid.UUID{walkID},
+		})
+
+		handler.CreateCollection(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, []interface{}{walkID.String()}, response["walk_ids"])
+	})
+
+	t.Run("other user's walk", func(t *testing.T) {
+		// 期待値: 所有していない散歩を含める場合は404（散歩の存在を知られない）
+		handler, mockUsecase := setupCollectionTestHandler()
+
+		mockUsecase.On("CreateCollection", mock.Anything, mock.Anything).Return(nil, walk.ErrNotOwner)
+
+		c, w := setupTestContext(http.MethodPost, "/v1/collections", CreateCollectionRequest{
+			Name:    "京都旅行",
+			WalkIDs: []uuid.UUID{walkID},
+		})
+
+		handler.CreateCollection(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "Walk not found")
+	})
+}
+
+func TestCollectionHandler_GetCollection_NotFound(t *testing.T) {
+	// 期待値: 存在しない（他のユーザーの）コレクションは404
+	handler, mockUsecase := setupCollectionTestHandler()
+
+	id := uuid.New()
+	mockUsecase.On("GetCollection", mock.Anything, id, "test-user").Return(nil, collection.ErrCollectionNotFound)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/collections/"+id.String(), nil)
+	c.Params = gin.Params{{Key: "id", Value: id.String()}}
+
+	handler.GetCollection(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "Collection not found")
+}
+
+func TestCollectionHandler_RemoveWalk_InvalidWalkID(t *testing.T) {
+	// 期待値: 散歩IDが不正な場合は400
+	handler, mockUsecase := setupCollectionTestHandler()
+
+	id := uuid.New()
+	c, w := setupTestContext(http.MethodDelete, "/v1/collections/"+id.String()+"/walks/invalid", nil)
+	c.Params = gin.Params{{Key: "id", Value: id.String()}, {Key: "walkId", Value: "invalid"}}
+
+	handler.RemoveWalk(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockUsecase.AssertNotCalled(t, "RemoveWalk", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
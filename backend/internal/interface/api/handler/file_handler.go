@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/persistence/storage"
+	"github.com/gin-gonic/gin"
+)
+
+// FileHandler はローカルストレージのファイルを配信するハンドラー（開発用）
+type FileHandler struct {
+	storage *storage.LocalStorage
+}
+
+// NewFileHandler は新しいFileHandlerを生成する
+func NewFileHandler(container *di.Container) *FileHandler {
+	return &FileHandler{
+		storage: container.LocalStorage,
+	}
+}
+
+// ServeFile はローカルストレージのファイルを保存時のContent-Typeで返す
+// GET /files/*path
+func (h *FileHandler) ServeFile(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	path := strings.TrimPrefix(c.Param("path"), "/")
+
+	contentType, err := h.storage.ContentType(ctx, path)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	file, err := h.storage.Download(ctx, path)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	defer file.Close()
+
+	// レスポンス返却（アップロードされた内容をブラウザに別の形式として解釈させない）
+	c.DataFromReader(http.StatusOK, -1, contentType, file, map[string]string{
+		"X-Content-Type-Options": "nosniff",
+	})
+}
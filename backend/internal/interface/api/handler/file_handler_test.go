@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/persistence/storage"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupFileTestHandler(t *testing.T) (*FileHandler, *storage.LocalStorage) {
+	gin.SetMode(gin.TestMode)
+	s, err := storage.NewLocalStorage(t.TempDir(), "http://localhost:8080")
+	require.NoError(t, err)
+	container := &di.Container{
+		LocalStorage: s,
+	}
+	return NewFileHandler(container), s
+}
+
+func TestFileHandler_ServeFile(t *testing.T) {
+	handler, s := setupFileTestHandler(t)
+	_, err := s.Upload(context.Background(), "walk_photos/u/w/p.png", strings.NewReader("png-bytes"), "image/png")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedStatus int
+	}{
+		{name: "existing file", path: "/walk_photos/u/w/p.png", expectedStatus: http.StatusOK},
+		{name: "missing file", path: "/walk_photos/u/w/missing.png", expectedStatus: http.StatusNotFound},
+		{name: "path traversal", path: "/walk_photos/../../etc/passwd", expectedStatus: http.StatusBadRequest},
+		{name: "metadata sidecar", path: "/walk_photos/u/w/p.png.meta.json", expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, w := setupTestContext(http.MethodGet, "/files"+tt.path, nil)
+			c.Params = gin.Params{{Key: "path", Value: tt.path}}
+
+			handler.ServeFile(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				// 期待値: 保存時のContent-Typeで内容を返す
+				assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+				assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+				assert.Equal(t, "png-bytes", w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/photo"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/api/presenter"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/errors"
+	photousecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/photo"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// maxPhotoRequestSize は写真アップロードのリクエスト全体の最大サイズ（バイト）
+// 写真の上限にマルチパートのヘッダーとフォーム項目の分を加える
+const maxPhotoRequestSize = photo.MaxSizeBytes + 1<<20
+
+// PhotoHandler は散歩の写真APIのハンドラー
+type PhotoHandler struct {
+	photoUsecase photousecase.Usecase
+}
+
+// NewPhotoHandler は新しいPhotoHandlerを生成する
+func NewPhotoHandler(container *di.Container) *PhotoHandler {
+	return &PhotoHandler{
+		photoUsecase: container.PhotoUsecase,
+	}
+}
+
+// UploadPhoto は散歩に写真をアップロードする
+// POST /v1/walks/:id/photos（multipart/form-data）
+func (h *PhotoHandler) UploadPhoto(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// IDパラメータ取得
+	walkID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid walk ID"))
+		return
+	}
+
+	// アップロードファイル取得（サイズ上限を超える場合は読み込みを打ち切る）
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxPhotoRequestSize)
+	fileHeader, err := c.FormFile("photo")
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("photo is required"))
+		return
+	}
+
+	input := photousecase.UploadPhotoInput{
+		WalkID: walkID,
+		UserID: userID,
+		Size:   fileHeader.Size,
+	}
+	if v := c.PostForm("captured_at"); v != "" {
+		capturedAt, parseErr := time.Parse(time.RFC3339, v)
+		if parseErr != nil {
+			respondError(c, errors.NewInvalidRequestError("Invalid captured_at: must be RFC3339"))
+			return
+		}
+		input.CapturedAt = &capturedAt
+	}
+	if input.Latitude, err = parseOptionalFloat(c.PostForm("latitude")); err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid latitude"))
+		return
+	}
+	if input.Longitude, err = parseOptionalFloat(c.PostForm("longitude")); err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid longitude"))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid upload file"))
+		return
+	}
+	defer file.Close()
+	input.Content = file
+
+	// Usecase呼び出し
+	p, err := h.photoUsecase.UploadPhoto(ctx, input)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusCreated, presenter.ToPhotoResponse(p))
+}
+
+// DeletePhoto は散歩の写真を削除する
+// DELETE /v1/walks/:id/photos/:photoId
+func (h *PhotoHandler) DeletePhoto(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// IDパラメータ取得
+	walkID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid walk ID"))
+		return
+	}
+	photoID, err := uuid.Parse(c.Param("photoId"))
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid photo ID"))
+		return
+	}
+
+	// Usecase呼び出し
+	if err := h.photoUsecase.DeletePhoto(ctx, walkID, photoID, userID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.Status(http.StatusNoContent)
+}
+
+// parseOptionalFloat は省略可能な数値のフォーム項目を読み取る（空の場合はnil）
+func parseOptionalFloat(v string) (*float64, error) {
+	if v == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
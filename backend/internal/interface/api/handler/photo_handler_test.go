@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/photo"
+	photousecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/photo"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockPhotoUsecase はPhotoUsecaseのモック
+type MockPhotoUsecase struct {
+	mock.Mock
+}
+
+func (m *MockPhotoUsecase) UploadPhoto(ctx context.Context, input photousecase.UploadPhotoInput) (*photo.Photo, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*photo.Photo), args.Error(1)
+}
+
+func (m *MockPhotoUsecase) DeletePhoto(ctx context.Context, walkID, id uuid.UUID, userID string) error {
+	return m.Called(ctx, walkID, id, userID).Error(0)
+}
+
+func (m *MockPhotoUsecase) ListWalkPhotos(ctx context.Context, walkID uuid.UUID) ([]*photo.Photo, error) {
+	args := m.Called(ctx, walkID)
+	return args.Get(0).([]*photo.Photo), args.Error(1)
+}
+
+func (m *MockPhotoUsecase) RemoveObjects(ctx context.Context, photos []*photo.Photo) {
+	m.Called(ctx, photos)
+}
+
+func setupPhotoTestHandler() (*PhotoHandler, *MockPhotoUsecase) {
+	gin.SetMode(gin.TestMode)
+	mockUsecase := new(MockPhotoUsecase)
+	container := &di.Container{
+		PhotoUsecase: mockUsecase,
+	}
+	return NewPhotoHandler(container), mockUsecase
+}
+
+func TestPhotoHandler_UploadPhoto_Success(t *testing.T) {
+	// 期待値: フォーム項目を入力に変換し、保存した写真を201 Createdで返す
+	handler, mockUsecase := setupPhotoTestHandler()
+
+	walkID := uuid.New()
+	lat, lng := 35.68, 139.76
+	uploaded := photo.NewPhoto(walkID, "test-user", "image/jpeg", nil, &lat, &lng)
+	uploaded.URL = "https://example.com/photo.jpg"
+	mockUsecase.On("UploadPhoto", mock.Anything, mock.MatchedBy(func(input photousecase.UploadPhotoInput) bool {
+		content, _ := io.ReadAll(input.Content)
+		return input.WalkID == walkID && input.UserID == "test-user" &&
+			string(content) == "jpeg-bytes" && input.Size == int64(len("jpeg-bytes")) &&
+			input.CapturedAt != nil && input.CapturedAt.Year() == 2026 &&
+			*input.Latitude == lat && *input.Longitude == lng
+	})).Return(uploaded, nil)
+
+	c, w := setupMultipartContext("/v1/walks/"+walkID.String()+"/photos", "photo", "photo.jpg", "jpeg-bytes", map[string]string{
+		"captured_at": "2026-05-01T09:30:00+09:00",
+		"latitude":    "35.68",
+		"longitude":   "139.76",
+	})
+	c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+	handler.UploadPhoto(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"url":"https://example.com/photo.jpg"`)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestPhotoHandler_UploadPhoto_InvalidRequest(t *testing.T) {
+	walkID := uuid.New()
+
+	tests := []struct {
+		name     string
+		filename string
+		fields   map[string]string
+	}{
+		{name: "missing photo"},
+		{name: "invalid captured_at", filename: "photo.jpg", fields: map[string]string{"captured_at": "yesterday"}},
+		{name: "invalid latitude", filename: "photo.jpg", fields: map[string]string{"latitude": "north"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 期待値: 写真やフォーム項目が不正な場合は400
+			handler, mockUsecase := setupPhotoTestHandler()
+
+			c, w := setupMultipartContext("/v1/walks/"+walkID.String()+"/photos", "photo", tt.filename, "jpeg-bytes", tt.fields)
+			c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+			handler.UploadPhoto(c)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockUsecase.AssertNotCalled(t, "UploadPhoto", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestPhotoHandler_DeletePhoto_NotFound(t *testing.T) {
+	// 期待値: 散歩に添付されていない写真は404
+	handler, mockUsecase := setupPhotoTestHandler()
+
+	walkID, photoID := uuid.New(), uuid.New()
+	mockUsecase.On("DeletePhoto", mock.Anything, walkID, photoID, "test-user").Return(photo.ErrPhotoNotFound)
+
+	c, w := setupTestContext(http.MethodDelete, "/v1/walks/"+walkID.String()+"/photos/"+photoID.String(), nil)
+	c.Params = gin.Params{{Key: "id", Value: walkID.String()}, {Key: "photoId", Value: photoID.String()}}
+
+	handler.DeletePhoto(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "Photo not found")
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/api/presenter"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/errors"
+	privacyusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/privacy"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// PrivacyHandler はプライバシーゾーンAPIのハンドラー
+type PrivacyHandler struct {
+	privacyUsecase privacyusecase.Usecase
+}
+
+// NewPrivacyHandler は新しいPrivacyHandlerを生成する
+func NewPrivacyHandler(container *di.Container) *PrivacyHandler {
+	return &PrivacyHandler{
+		privacyUsecase: container.PrivacyUsecase,
+	}
+}
+
+// CreatePrivacyZoneRequest はプライバシーゾーン作成のリクエスト
+// 緯度・経度の0を未指定と区別するためポインタで受け取る
+type CreatePrivacyZoneRequest struct {
+	Name         string   `json:"name"`
+	Latitude     *float64 `json:"latitude" binding:"required"`
+	Longitude    *float64 `json:"longitude" binding:"required"`
+	RadiusMeters float64  `json:"radius_meters" binding:"required"`
+}
+
+// ListPrivacyZones はプライバシーゾーンの一覧を取得する
+// GET /v1/users/me/privacy-zones
+func (h *PrivacyHandler) ListPrivacyZones(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// Usecase呼び出し
+	zones, err := h.privacyUsecase.ListZones(ctx, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.ToPrivacyZoneListResponse(zones))
+}
+
+// CreatePrivacyZone はプライバシーゾーンを作成する
+// POST /v1/users/me/privacy-zones
+func (h *PrivacyHandler) CreatePrivacyZone(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	var req CreatePrivacyZoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	// Usecase呼び出し
+	z, err := h.privacyUsecase.CreateZone(ctx, privacyusecase.CreateZoneInput{
+		UserID:       userID,
+		Name:         req.Name,
+		Latitude:     *req.Latitude,
+		Longitude:    *req.Longitude,
+		RadiusMeters: req.RadiusMeters,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusCreated, presenter.ToPrivacyZoneResponse(z))
+}
+
+// DeletePrivacyZone はプライバシーゾーンを削除する
+// DELETE /v1/users/me/privacy-zones/:id
+func (h *PrivacyHandler) DeletePrivacyZone(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// IDパラメータ取得
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid privacy zone ID"))
+		return
+	}
+
+	// Usecase呼び出し
+	if err := h.privacyUsecase.DeleteZone(ctx, id, userID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.Status(http.StatusNoContent)
+}
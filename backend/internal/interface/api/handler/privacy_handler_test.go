@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/privacy"
+	privacyusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/privacy"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockPrivacyUsecase はPrivacyUsecaseのモック
+type MockPrivacyUsecase struct {
+	mock.Mock
+}
+
+func (m *MockPrivacyUsecase) ListZones(ctx context.Context, userID string) ([]*privacy.Zone, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*privacy.Zone), args.Error(1)
+}
+
+func (m *MockPrivacyUsecase) CreateZone(ctx context.Context, input privacyusecase.CreateZoneInput) (*privacy.Zone, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*privacy.Zone), args.Error(1)
+}
+
+func (m *MockPrivacyUsecase) DeleteZone(ctx context.Context, id uuid.UUID, userID string) error {
+	return m.Called(ctx, id, userID).Error(0)
+}
+
+func setupPrivacyTestHandler() (*PrivacyHandler, *MockPrivacyUsecase) {
+	gin.SetMode(gin.TestMode)
+	mockUsecase := new(MockPrivacyUsecase)
+	container := &di.Container{
+		PrivacyUsecase: mockUsecase,
+	}
+	return NewPrivacyHandler(container), mockUsecase
+}
+
+func TestPrivacyHandler_ListPrivacyZones_Success(t *testing.T) {
+	// 期待値: プライバシーゾーンの一覧を200 OKで返す
+	handler, mockUsecase := setupPrivacyTestHandler()
+
+	home := privacy.NewZone("test-user", "自宅", 35.68, 139.76, 200)
+	mockUsecase.On("ListZones", mock.Anything, "test-user").Return([]*privacy.Zone{home}, nil)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/users/me/privacy-zones", nil)
+
+	handler.ListPrivacyZones(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response map[string][]map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response["zones"], 1)
+	assert.Equal(t, "自宅", response["zones"][0]["name"])
+	assert.Equal(t, float64(200), response["zones"][0]["radius_meters"])
+}
+
+func TestPrivacyHandler_CreatePrivacyZone(t *testing.T) {
+	lat, lng := 35.68, 139.76
+
+	t.Run("success", func(t *testing.T) {
+		// 期待値: 作成したゾーンを201 Createdで返す
+		handler, mockUsecase := setupPrivacyTestHandler()
+
+		input := privacyusecase.CreateZoneInput{
+			UserID: "test-user", Name: "自宅", Latitude: lat, Longitude: lng, RadiusMeters: 200,
+		}
+		created := privacy.NewZone("test-user", "自宅", lat, lng, 200)
+		mockUsecase.On("CreateZone", mock.Anything, input).Return(created, nil)
+
+		c, w := setupTestContext(http.MethodPost, "/v1/users/me/privacy-zones", CreatePrivacyZoneRequest{
+			Name: "自宅", Latitude: &lat, Longitude: &lng, RadiusMeters: 200,
+		})
+
+		handler.CreatePrivacyZone(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"radius_meters":200`)
+	})
+
+	t.Run("missing center", func(t *testing.T) {
+		// 期待値: 中心座標がない場合は400
+		handler, mockUsecase := setupPrivacyTestHandler()
+
+		c, w := setupTestContext(http.MethodPost, "/v1/users/me/privacy-zones", CreatePrivacyZoneRequest{
+			Name: "自宅", RadiusMeters: 200,
+		})
+
+		handler.CreatePrivacyZone(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockUsecase.AssertNotCalled(t, "CreateZone", mock.Anything, mock.Anything)
+	})
+
+	t.Run("too many zones", func(t *testing.T) {
+		// 期待値: 上限に達している場合は409
+		handler, mockUsecase := setupPrivacyTestHandler()
+
+		mockUsecase.On("CreateZone", mock.Anything, mock.Anything).Return(nil, privacy.ErrTooManyZones)
+
+		c, w := setupTestContext(http.MethodPost, "/v1/users/me/privacy-zones", CreatePrivacyZoneRequest{
+			Name: "自宅", Latitude: &lat, Longitude: &lng, RadiusMeters: 200,
+		})
+
+		handler.CreatePrivacyZone(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestPrivacyHandler_DeletePrivacyZone_NotFound(t *testing.T) {
+	// 期待値: 存在しない（他のユーザーの）ゾーンは404
+	handler, mockUsecase := setupPrivacyTestHandler()
+
+	id := uuid.New()
+	mockUsecase.On("DeleteZone", mock.Anything, id, "test-user").Return(privacy.ErrZoneNotFound)
+
+	c, w := setupTestContext(http.MethodDelete, "/v1/users/me/privacy-zones/"+id.String(), nil)
+	c.Params = gin.Params{{Key: "id", Value: id.String()}}
+
+	handler.DeletePrivacyZone(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "Privacy zone not found")
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/api/presenter"
+	recordusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/record"
+	"github.com/gin-gonic/gin"
+)
+
+// RecordHandler は連続記録・自己ベストAPIのハンドラー
+type RecordHandler struct {
+	recordUsecase recordusecase.Usecase
+}
+
+// NewRecordHandler は新しいRecordHandlerを生成する
+func NewRecordHandler(container *di.Container) *RecordHandler {
+	return &RecordHandler{
+		recordUsecase: container.RecordUsecase,
+	}
+}
+
+// GetMyRecords は認証ユーザーの連続記録・自己ベストを取得する
+// GET /v1/users/me/records
+func (h *RecordHandler) GetMyRecords(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// Usecase呼び出し
+	result, err := h.recordUsecase.GetRecords(ctx, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.ToRecordsResponse(result))
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/record"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	recordusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/record"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRecordUsecase はRecordUsecaseのモック
+type MockRecordUsecase struct {
+	mock.Mock
+}
+
+func (m *MockRecordUsecase) GetRecords(ctx context.Context, userID string) (*recordusecase.RecordsResult, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*recordusecase.RecordsResult), args.Error(1)
+}
+
+func (m *MockRecordUsecase) RecordCompletedWalk(ctx context.Context, w *walk.Walk, locations []*walk.WalkLocation) error {
+	args := m.Called(ctx, w, locations)
+	return args.Error(0)
+}
+
+func setupRecordTestHandler() (*RecordHandler, *MockRecordUsecase) {
+	gin.SetMode(gin.TestMode)
+	mockUsecase := new(MockRecordUsecase)
+	container := &di.Container{
+		RecordUsecase: mockUsecase,
+	}
+	return NewRecordHandler(container), mockUsecase
+}
+
+func TestRecordHandler_GetMyRecords_Success(t *testing.T) {
+	// 期待値: 連続記録と自己ベストを200 OKで返す
+	handler, mockUsecase := setupRecordTestHandler()
+
+	walkID := uuid.New()
+	day := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	pace := 540.0
+	pr := &record.PersonalRecords{
+		UserID:              "test-user",
+		CurrentStreak:       3,
+		LongestStreak:       7,
+		LastWalkDate:        &day,
+		LongestWalkID:       &walkID,
+		LongestWalkDistance: 5200,
+		FastestKmPace:       &pace,
+		MostStepsDate:       &day,
+		MostStepsInDay:      9000,
+	}
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	mockUsecase.On("GetRecords", mock.Anything, "test-user").
+		Return(&recordusecase.RecordsResult{Records: pr, CurrentStreak: 3, TimeZone: tokyo}, nil)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/users/me/records", nil)
+
+	handler.GetMyRecords(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "Asia/Tokyo", response["time_zone"])
+	assert.Equal(t, float64(3), response["current_streak"])
+	assert.Equal(t, float64(7), response["longest_streak"])
+	assert.Equal(t, "2025-01-15", response["last_walk_date"])
+
+	longest := response["longest_walk"].(map[string]interface{})
+	assert.Equal(t, walkID.String(), longest["walk_id"])
+	assert.Equal(t, float64(5200), longest["distance"])
+
+	// 期待値: 散歩が削除された記録はwalk_idがnull
+	fastest := response["fastest_km"].(map[string]interface{})
+	assert.Nil(t, fastest["walk_id"])
+	assert.Equal(t, float64(540), fastest["pace"])
+
+	steps := response["most_steps_in_day"].(map[string]interface{})
+	assert.Equal(t, "2025-01-15", steps["date"])
+	assert.Equal(t, float64(9000), steps["steps"])
+
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestRecordHandler_GetMyRecords_Empty(t *testing.T) {
+	// 期待値: 記録がない場合は自己ベストをnullで返す
+	handler, mockUsecase := setupRecordTestHandler()
+
+	mockUsecase.On("GetRecords", mock.Anything, "test-user").Return(&recordusecase.RecordsResult{
+		Records:  record.NewPersonalRecords("test-user"),
+		TimeZone: time.UTC,
+	}, nil)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/users/me/records", nil)
+
+	handler.GetMyRecords(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, float64(0), response["current_streak"])
+	assert.Nil(t, response["last_walk_date"])
+	assert.Nil(t, response["longest_walk"])
+	assert.Nil(t, response["fastest_km"])
+	assert.Nil(t, response["most_steps_in_day"])
+}
+
+func TestRecordHandler_GetMyRecords_Error(t *testing.T) {
+	// 期待値: Usecaseのエラーは500
+	handler, mockUsecase := setupRecordTestHandler()
+
+	mockUsecase.On("GetRecords", mock.Anything, "test-user").Return(nil, errors.New("db error"))
+
+	c, w := setupTestContext(http.MethodGet, "/v1/users/me/records", nil)
+
+	handler.GetMyRecords(c)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
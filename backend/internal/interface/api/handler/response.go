@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/collection"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/photo"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/privacy"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/share"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/social"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/tag"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/api/middleware"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/persistence/storage"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/errors"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+	"github.com/gin-gonic/gin"
+)
+
+// getUserID は現在のユーザーIDを取得する
+// 認証ミドルウェアで設定されたユーザーIDを取得する
+// エラーが発生する場合は認証設定に問題があるため、panicで早期検知する
+func getUserID(c *gin.Context) string {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		panic(fmt.Sprintf("authentication misconfiguration: %v", err))
+	}
+	return userID
+}
+
+// respondError はエラーレスポンスを返す
+func respondError(c *gin.Context, err error) {
+	appErr := errors.GetAppError(err)
+	if appErr == nil {
+		appErr = toAppError(err)
+	}
+
+	status := http.StatusInternalServerError
+	switch appErr.Code {
+	case errors.CodeInvalidRequest:
+		status = http.StatusBadRequest
+	case errors.CodeUnauthorized:
+		status = http.StatusUnauthorized
+	case errors.CodeForbidden:
+		status = http.StatusForbidden
+	case errors.CodeNotFound:
+		status = http.StatusNotFound
+	case errors.CodeConflict:
+		status = http.StatusConflict
+	case errors.CodePreconditionFailed:
+		status = http.StatusPreconditionFailed
+	}
+
+	body := gin.H{
+		"code":    appErr.Code,
+		"message": appErr.Message,
+	}
+	if appErr.Details != nil {
+		body["details"] = appErr.Details
+	}
+
+	c.JSON(status, gin.H{
+		"error": body,
+	})
+}
+
+// toAppError はドメインエラーをAppErrorに変換する
+// 対応するドメインエラーがない場合は内部エラーとして扱う
+func toAppError(err error) *errors.AppError {
+	var transitionErr *walk.InvalidTransitionError
+	if stderrors.As(err, &transitionErr) {
+		return errors.NewAppError(errors.CodeConflict, transitionErr.Error(), err)
+	}
+	var validationErrs validator.ValidationErrors
+	if stderrors.As(err, &validationErrs) {
+		return errors.NewValidationError("Validation failed", validationErrs, err)
+	}
+	switch {
+	case stderrors.Is(err, social.ErrUserNotFound):
+		return errors.NewAppError(errors.CodeNotFound, "User not found", err)
+	case stderrors.Is(err, social.ErrRequestNotFound):
+		return errors.NewAppError(errors.CodeNotFound, "Follow request not found", err)
+	case stderrors.Is(err, social.ErrRelationNotFound):
+		return errors.NewAppError(errors.CodeNotFound, "Relation not found", err)
+	case stderrors.Is(err, social.ErrSelfRelation):
+		return errors.NewAppError(errors.CodeInvalidRequest, "Cannot follow or block yourself", err)
+	case stderrors.Is(err, social.ErrAlreadyFollowing):
+		return errors.NewAppError(errors.CodeConflict, "Follow request already exists", err)
+	case stderrors.Is(err, walk.ErrVersionMismatch):
+		return errors.NewAppError(errors.CodePreconditionFailed, "Walk has been modified", err)
+	case stderrors.Is(err, tag.ErrTagNotFound):
+		return errors.NewAppError(errors.CodeNotFound, "Tag not found", err)
+	case stderrors.Is(err, tag.ErrDuplicateName):
+		return errors.NewAppError(errors.CodeConflict, "Tag name already exists", err)
+	case stderrors.Is(err, collection.ErrCollectionNotFound):
+		return errors.NewAppError(errors.CodeNotFound, "Collection not found", err)
+	case stderrors.Is(err, collection.ErrWalkNotInCollection):
+		return errors.NewAppError(errors.CodeNotFound, "Walk not in collection", err)
+	case stderrors.Is(err, share.ErrLinkNotFound):
+		return errors.NewAppError(errors.CodeNotFound, "Share link not found", err)
+	case stderrors.Is(err, photo.ErrPhotoNotFound):
+		return errors.NewAppError(errors.CodeNotFound, "Photo not found", err)
+	case stderrors.Is(err, storage.ErrObjectNotFound):
+		return errors.NewAppError(errors.CodeNotFound, "File not found", err)
+	case stderrors.Is(err, storage.ErrInvalidPath):
+		return errors.NewAppError(errors.CodeInvalidRequest, "Invalid file path", err)
+	case stderrors.Is(err, privacy.ErrZoneNotFound):
+		return errors.NewAppError(errors.CodeNotFound, "Privacy zone not found", err)
+	case stderrors.Is(err, privacy.ErrTooManyZones):
+		return errors.NewAppError(errors.CodeConflict, privacy.ErrTooManyZones.Error(), err)
+	}
+	// 他のユーザーの散歩は存在しない場合と区別しない
+	if stderrors.Is(err, sql.ErrNoRows) || stderrors.Is(err, walk.ErrNotOwner) {
+		return errors.NewAppError(errors.CodeNotFound, "Walk not found", err)
+	}
+	return errors.NewInternalError("Internal server error", err)
+}
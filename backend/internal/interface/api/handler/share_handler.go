@@ -0,0 +1,138 @@
+package handler
+
+import (
+	stderrors "errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/api/presenter"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/errors"
+	shareusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/share"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ShareHandler は散歩の共有リンクAPIのハンドラー
+type ShareHandler struct {
+	shareUsecase shareusecase.Usecase
+}
+
+// NewShareHandler は新しいShareHandlerを生成する
+func NewShareHandler(container *di.Container) *ShareHandler {
+	return &ShareHandler{
+		shareUsecase: container.ShareUsecase,
+	}
+}
+
+// CreateShareLinkRequest は共有リンク作成のリクエスト（ボディは省略可能）
+type CreateShareLinkRequest struct {
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// CreateShareLink は散歩の共有リンクを作成する
+// POST /v1/walks/:id/share
+func (h *ShareHandler) CreateShareLink(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// IDパラメータ取得
+	walkID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid walk ID"))
+		return
+	}
+
+	// ボディなしの場合は無期限のリンクを作成する
+	var req CreateShareLinkRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil && !stderrors.Is(bindErr, io.EOF) {
+		respondError(c, errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	// Usecase呼び出し
+	link, err := h.shareUsecase.CreateLink(ctx, shareusecase.CreateLinkInput{
+		WalkID:    walkID,
+		UserID:    userID,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusCreated, presenter.ToShareLinkResponse(link))
+}
+
+// ListShareLinks は散歩の共有リンクの一覧を取得する
+// GET /v1/walks/:id/share
+func (h *ShareHandler) ListShareLinks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// IDパラメータ取得
+	walkID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid walk ID"))
+		return
+	}
+
+	// Usecase呼び出し
+	links, err := h.shareUsecase.ListLinks(ctx, walkID, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.ToShareLinkListResponse(links))
+}
+
+// RevokeShareLink は散歩の共有リンクを無効にする
+// DELETE /v1/walks/:id/share/:linkId
+func (h *ShareHandler) RevokeShareLink(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// IDパラメータ取得
+	walkID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid walk ID"))
+		return
+	}
+	linkID, err := uuid.Parse(c.Param("linkId"))
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid share link ID"))
+		return
+	}
+
+	// Usecase呼び出し
+	if err := h.shareUsecase.RevokeLink(ctx, walkID, linkID, userID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.Status(http.StatusNoContent)
+}
+
+// GetSharedWalk は共有リンクのトークンで散歩の詳細を取得する（認証不要）
+// GET /v1/shared/:token
+func (h *ShareHandler) GetSharedWalk(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// Usecase呼び出し
+	result, err := h.shareUsecase.GetSharedWalk(ctx, c.Param("token"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.ToSharedWalkDetailResponse(result.Walk, result.Locations))
+}
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/share"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	shareusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/share"
+	walkusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/walk"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockShareUsecase はShareUsecaseのモック
+type MockShareUsecase struct {
+	mock.Mock
+}
+
+func (m *MockShareUsecase) CreateLink(ctx context.Context, input shareusecase.CreateLinkInput) (*shareusecase.SharedLink, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*shareusecase.SharedLink), args.Error(1)
+}
+
+func (m *MockShareUsecase) ListLinks(ctx context.Context, walkID uuid.UUID, userID string) ([]*shareusecase.SharedLink, error) {
+	args := m.Called(ctx, walkID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*shareusecase.SharedLink), args.Error(1)
+}
+
+func (m *MockShareUsecase) RevokeLink(ctx context.Context, walkID, linkID uuid.UUID, userID string) error {
+	return m.Called(ctx, walkID, linkID, userID).Error(0)
+}
+
+func (m *MockShareUsecase) GetSharedWalk(ctx context.Context, token string) (*walkusecase.WalkWithLocations, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walkusecase.WalkWithLocations), args.Error(1)
+}
+
+func setupShareTestHandler() (*ShareHandler, *MockShareUsecase) {
+	gin.SetMode(gin.TestMode)
+	mockUsecase := new(MockShareUsecase)
+	container := &di.Container{
+		ShareUsecase: mockUsecase,
+	}
+	return NewShareHandler(container), mockUsecase
+}
+
+func TestShareHandler_CreateShareLink(t *testing.T) {
+	walkID := uuid.New()
+
+	t.Run("without body", func(t *testing.T) {
+		// 期待値: ボディなしでは無期限のリンクを作成し、トークンを201 Createdで返す
+		handler, mockUsecase := setupShareTestHandler()
+
+		link, _ := share.NewLink(walkID, "test-user", nil)
+		mockUsecase.On("CreateLink", mock.Anything, shareusecase.CreateLinkInput{WalkID: walkID, UserID: "test-user"}).
+			Return(&shareusecase.SharedLink{Link: link, Token: "signed-token"}, nil)
+
+		c, w := setupTestContext(http.MethodPost, "/v1/walks/"+walkID.String()+"/share", nil)
+		c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+		handler.CreateShareLink(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"token":"signed-token"`)
+		assert.Contains(t, w.Body.String(), `"expires_at":null`)
+	})
+
+	t.Run("with expiry", func(t *testing.T) {
+		// 期待値: 有効期限をUsecaseへ渡す
+		handler, mockUsecase := setupShareTestHandler()
+
+		expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+		link, _ := share.NewLink(walkID, "test-user", &expiresAt)
+		mockUsecase.On("CreateLink", mock.Anything, mock.MatchedBy(func(input shareusecase.CreateLinkInput) bool {
+			return input.ExpiresAt != nil && input.ExpiresAt.Equal(expiresAt)
+		})).Return(&shareusecase.SharedLink{Link: link, Token: "signed-token"}, nil)
+
+		c, w := setupTestContext(http.MethodPost, "/v1/walks/"+walkID.String()+"/share", CreateShareLinkRequest{ExpiresAt: &expiresAt})
+		c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+		handler.CreateShareLink(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+}
+
+func TestShareHandler_RevokeShareLink_NotFound(t *testing.T) {
+	// 期待値: 存在しないリンクは404
+	handler, mockUsecase := setupShareTestHandler()
+
+	walkID := uuid.New()
+	linkID := uuid.New()
+	mockUsecase.On("RevokeLink", mock.Anything, walkID, linkID, "test-user").Return(share.ErrLinkNotFound)
+
+	c, w := setupTestContext(http.MethodDelete, "/v1/walks/"+walkID.String()+"/share/"+linkID.String(), nil)
+	c.Params = gin.Params{{Key: "id", Value: walkID.String()}, {Key: "linkId", Value: linkID.String()}}
+
+	handler.RevokeShareLink(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestShareHandler_GetSharedWalk(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// 期待値: 所有者の情報を除いた散歩の詳細を返す
+		handler, mockUsecase := setupShareTestHandler()
+
+		w := walk.NewWalk("owner", "Shared walk", "")
+		w.Visibility = walk.VisibilityFriends
+		w.Tags = []string{"private-tag"}
+		mockUsecase.On("GetSharedWalk", mock.Anything, "signed-token").
+			Return(&walkusecase.WalkWithLocations{Walk: w, Locations: []*walk.WalkLocation{}}, nil)
+
+		c, rec := setupTestContext(http.MethodGet, "/v1/shared/signed-token", nil)
+		c.Params = gin.Params{{Key: "token", Value: "signed-token"}}
+
+		handler.GetSharedWalk(c)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "Shared walk", response["title"])
+		assert.NotContains(t, response, "user_id")
+		assert.NotContains(t, response, "visibility")
+		assert.Equal(t, []interface{}{}, response["tags"])
+		assert.Equal(t, []interface{}{}, response["locations"])
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		// 期待値: 無効なトークンは404
+		handler, mockUsecase := setupShareTestHandler()
+
+		mockUsecase.On("GetSharedWalk", mock.Anything, "forged").Return(nil, share.ErrLinkNotFound)
+
+		c, rec := setupTestContext(http.MethodGet, "/v1/shared/forged", nil)
+		c.Params = gin.Params{{Key: "token", Value: "forged"}}
+
+		handler.GetSharedWalk(c)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
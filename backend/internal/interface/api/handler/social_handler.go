@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/api/presenter"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/errors"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+	socialusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/social"
+	"github.com/gin-gonic/gin"
+)
+
+// SocialHandler はフォロー・友達・ブロックAPIのハンドラー
+type SocialHandler struct {
+	socialUsecase socialusecase.Usecase
+}
+
+// NewSocialHandler は新しいSocialHandlerを生成する
+func NewSocialHandler(container *di.Container) *SocialHandler {
+	return &SocialHandler{
+		socialUsecase: container.SocialUsecase,
+	}
+}
+
+// UserTargetRequest は操作対象のユーザーを指定するリクエスト
+type UserTargetRequest struct {
+	UserID string `json:"user_id" binding:"required"`
+}
+
+// ListFriends は友達（相互フォロー）の一覧を取得する
+// GET /v1/friends
+func (h *SocialHandler) ListFriends(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// Usecase呼び出し
+	friends, err := h.socialUsecase.ListFriends(ctx, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.FriendListResponse{Friends: presenter.ToConnectionResponses(friends)})
+}
+
+// ListMutualFriends は相手との共通の友達の一覧を取得する
+// GET /v1/friends/:userId/mutual
+func (h *SocialHandler) ListMutualFriends(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// Usecase呼び出し
+	friends, err := h.socialUsecase.ListMutualFriends(ctx, userID, c.Param("userId"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.FriendListResponse{Friends: presenter.ToConnectionResponses(friends)})
+}
+
+// RemoveFriend は相手との間のフォロー関係を両方向とも解除する
+// DELETE /v1/friends/:userId
+func (h *SocialHandler) RemoveFriend(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// Usecase呼び出し
+	if err := h.socialUsecase.RemoveFriend(ctx, userID, c.Param("userId")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.Status(http.StatusNoContent)
+}
+
+// ListFollowRequests は承認待ちのフォローリクエストの一覧を取得する
+// GET /v1/friends/requests?direction=incoming|outgoing
+func (h *SocialHandler) ListFollowRequests(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	direction := socialusecase.RequestDirection(c.DefaultQuery("direction", string(socialusecase.RequestIncoming)))
+	if !direction.IsValid() {
+		var errs validator.ValidationErrors
+		errs.AddField("direction", fmt.Sprintf("direction must be one of %s, %s",
+			socialusecase.RequestIncoming, socialusecase.RequestOutgoing))
+		respondError(c, errs.Err())
+		return
+	}
+
+	// Usecase呼び出し
+	requests, err := h.socialUsecase.ListFollowRequests(ctx, userID, direction)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.FollowRequestListResponse{
+		Direction: string(direction),
+		Requests:  presenter.ToConnectionResponses(requests),
+	})
+}
+
+// SendFollowRequest はフォローリクエストを送信する
+// POST /v1/friends/requests
+func (h *SocialHandler) SendFollowRequest(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	var req UserTargetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	// Usecase呼び出し
+	f, err := h.socialUsecase.SendFollowRequest(ctx, userID, req.UserID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusCreated, presenter.ToFollowResponse(f))
+}
+
+// AcceptFollowRequest は相手からのフォローリクエストを承認する
+// POST /v1/friends/requests/:userId/accept
+func (h *SocialHandler) AcceptFollowRequest(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// Usecase呼び出し
+	f, err := h.socialUsecase.AcceptFollowRequest(ctx, userID, c.Param("userId"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.ToFollowResponse(f))
+}
+
+// RejectFollowRequest は相手からのフォローリクエストを拒否する
+// POST /v1/friends/requests/:userId/reject
+func (h *SocialHandler) RejectFollowRequest(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// Usecase呼び出し
+	if err := h.socialUsecase.RejectFollowRequest(ctx, userID, c.Param("userId")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.Status(http.StatusNoContent)
+}
+
+// ListBlockedUsers はブロックしているユーザーの一覧を取得する
+// GET /v1/friends/blocks
+func (h *SocialHandler) ListBlockedUsers(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// Usecase呼び出し
+	blocked, err := h.socialUsecase.ListBlockedUsers(ctx, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.BlockListResponse{Blocks: presenter.ToConnectionResponses(blocked)})
+}
+
+// BlockUser はユーザーをブロックする
+// POST /v1/friends/blocks
+func (h *SocialHandler) BlockUser(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	var req UserTargetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	// Usecase呼び出し
+	if err := h.socialUsecase.BlockUser(ctx, userID, req.UserID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.Status(http.StatusNoContent)
+}
+
+// UnblockUser はユーザーのブロックを解除する
+// DELETE /v1/friends/blocks/:userId
+func (h *SocialHandler) UnblockUser(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// Usecase呼び出し
+	if err := h.socialUsecase.UnblockUser(ctx, userID, c.Param("userId")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.Status(http.StatusNoContent)
+}
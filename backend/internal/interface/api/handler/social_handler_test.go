@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/social"
+	socialusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/social"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSocialUsecase はSocialUsecaseのモック
+type MockSocialUsecase struct {
+	mock.Mock
+}
+
+func (m *MockSocialUsecase) ListFriends(ctx context.Context, userID string) ([]*social.Connection, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*social.Connection), args.Error(1)
+}
+
+func (m *MockSocialUsecase) ListMutualFriends(ctx context.Context, userID, otherID string) ([]*social.Connection, error) {
+	args := m.Called(ctx, userID, otherID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*social.Connection), args.Error(1)
+}
+
+func (m *MockSocialUsecase) ListFollowRequests(ctx context.Context, userID string, direction socialusecase.RequestDirection) ([]*social.Connection, error) {
+	args := m.Called(ctx, userID, direction)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*social.Connection), args.Error(1)
+}
+
+func (m *MockSocialUsecase) SendFollowRequest(ctx context.Context, userID, targetID string) (*social.Follow, error) {
+	args := m.Called(ctx, userID, targetID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*social.Follow), args.Error(1)
+}
+
+func (m *MockSocialUsecase) AcceptFollowRequest(ctx context.Context, userID, requesterID string) (*social.Follow, error) {
+	args := m.Called(ctx, userID, requesterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*social.Follow), args.Error(1)
+}
+
+func (m *MockSocialUsecase) RejectFollowRequest(ctx context.Context, userID, requesterID string) error {
+	return m.Called(ctx, userID, requesterID).Error(0)
+}
+
+func (m *MockSocialUsecase) RemoveFriend(ctx context.Context, userID, otherID string) error {
+	return m.Called(ctx, userID, otherID).Error(0)
+}
+
+func (m *MockSocialUsecase) BlockUser(ctx context.Context, userID, targetID string) error {
+	return m.Called(ctx, userID, targetID).Error(0)
+}
+
+func (m *MockSocialUsecase) UnblockUser(ctx context.Context, userID, targetID string) error {
+	return m.Called(ctx, userID, targetID).Error(0)
+}
+
+func (m *MockSocialUsecase) ListBlockedUsers(ctx context.Context, userID string) ([]*social.Connection, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*social.Connection), args.Error(1)
+}
+
+func setupSocialTestHandler() (*SocialHandler, *MockSocialUsecase) {
+	gin.SetMode(gin.TestMode)
+	mockUsecase := new(MockSocialUsecase)
+	container := &di.Container{
+		SocialUsecase: mockUsecase,
+	}
+	return NewSocialHandler(container), mockUsecase
+}
+
+func TestSocialHandler_ListFriends_Success(t *testing.T) {
+	// 期待値: 友達の一覧を200 OKで返す
+	handler, mockUsecase := setupSocialTestHandler()
+
+	since := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+	mockUsecase.On("ListFriends", mock.Anything, "test-user").Return([]*social.Connection{
+		{UserID: "bob", DisplayName: "Bob", Since: since},
+	}, nil)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/friends", nil)
+
+	handler.ListFriends(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"friends":[{"user_id":"bob","display_name":"Bob","since":"2025-01-15T09:00:00Z"}]}`, w.Body.String())
+}
+
+func TestSocialHandler_ListMutualFriends_Blocked(t *testing.T) {
+	// 期待値: ブロック関係にある相手は404（存在しないユーザーと区別しない）
+	handler, mockUsecase := setupSocialTestHandler()
+
+	mockUsecase.On("ListMutualFriends", mock.Anything, "test-user", "bob").Return(nil, social.ErrUserNotFound)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/friends/bob/mutual", nil)
+	c.Params = gin.Params{{Key: "userId", Value: "bob"}}
+
+	handler.ListMutualFriends(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "User not found")
+}
+
+func TestSocialHandler_ListFollowRequests(t *testing.T) {
+	t.Run("outgoing", func(t *testing.T) {
+		// 期待値: directionがUsecaseに渡される
+		handler, mockUsecase := setupSocialTestHandler()
+
+		mockUsecase.On("ListFollowRequests", mock.Anything, "test-user", socialusecase.RequestOutgoing).
+			Return([]*social.Connection{}, nil)
+
+		c, w := setupTestContext(http.MethodGet, "/v1/friends/requests?direction=outgoing", nil)
+
+		handler.ListFollowRequests(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"direction":"outgoing","requests":[]}`, w.Body.String())
+	})
+
+	t.Run("invalid direction", func(t *testing.T) {
+		// 期待値: 未定義のdirectionは400
+		handler, mockUsecase := setupSocialTestHandler()
+
+		c, w := setupTestContext(http.MethodGet, "/v1/friends/requests?direction=sideways", nil)
+
+		handler.ListFollowRequests(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `"direction"`)
+		mockUsecase.AssertNotCalled(t, "ListFollowRequests", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestSocialHandler_SendFollowRequest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// 期待値: 承認待ちのフォローを201 Createdで返す
+		handler, mockUsecase := setupSocialTestHandler()
+
+		f, err := social.NewFollowRequest("test-user", "bob")
+		require.NoError(t, err)
+		mockUsecase.On("SendFollowRequest", mock.Anything, "test-user", "bob").Return(f, nil)
+
+		c, w := setupTestContext(http.MethodPost, "/v1/friends/requests", UserTargetRequest{UserID: "bob"})
+
+		handler.SendFollowRequest(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "bob", response["followee_id"])
+		assert.Equal(t, "pending", response["status"])
+	})
+
+	t.Run("already requested", func(t *testing.T) {
+		// 期待値: 送信済みの場合は409
+		handler, mockUsecase := setupSocialTestHandler()
+
+		mockUsecase.On("SendFollowRequest", mock.Anything, "test-user", "bob").Return(nil, social.ErrAlreadyFollowing)
+
+		c, w := setupTestContext(http.MethodPost, "/v1/friends/requests", UserTargetRequest{UserID: "bob"})
+
+		handler.SendFollowRequest(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("missing user_id", func(t *testing.T) {
+		// 期待値: user_idがない場合は400
+		handler, _ := setupSocialTestHandler()
+
+		c, w := setupTestContext(http.MethodPost, "/v1/friends/requests", map[string]interface{}{})
+
+		handler.SendFollowRequest(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestSocialHandler_AcceptFollowRequest_NotFound(t *testing.T) {
+	// 期待値: 承認待ちのリクエストがない場合は404
+	handler, mockUsecase := setupSocialTestHandler()
+
+	mockUsecase.On("AcceptFollowRequest", mock.Anything, "test-user", "bob").Return(nil, social.ErrRequestNotFound)
+
+	c, w := setupTestContext(http.MethodPost, "/v1/friends/requests/bob/accept", nil)
+	c.Params = gin.Params{{Key: "userId", Value: "bob"}}
+
+	handler.AcceptFollowRequest(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestSocialHandler_BlockUser(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// 期待値: ブロックに成功すると204 No Content
+		handler, mockUsecase := setupSocialTestHandler()
+
+		mockUsecase.On("BlockUser", mock.Anything, "test-user", "bob").Return(nil)
+
+		c, _ := setupTestContext(http.MethodPost, "/v1/friends/blocks", UserTargetRequest{UserID: "bob"})
+
+		handler.BlockUser(c)
+
+		// ボディなしのレスポンスはレコーダーにヘッダーが書き込まれないため、gin側のステータスで確認する
+		assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("self", func(t *testing.T) {
+		// 期待値: 自分自身のブロックは400
+		handler, mockUsecase := setupSocialTestHandler()
+
+		mockUsecase.On("BlockUser", mock.Anything, "test-user", "test-user").Return(social.ErrSelfRelation)
+
+		c, w := setupTestContext(http.MethodPost, "/v1/friends/blocks", UserTargetRequest{UserID: "test-user"})
+
+		handler.BlockUser(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/stats"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/api/presenter"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+	statsusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/stats"
+	"github.com/gin-gonic/gin"
+)
+
+// StatsHandler は散歩統計APIのハンドラー
+type StatsHandler struct {
+	statsUsecase statsusecase.Usecase
+}
+
+// NewStatsHandler は新しいStatsHandlerを生成する
+func NewStatsHandler(container *di.Container) *StatsHandler {
+	return &StatsHandler{
+		statsUsecase: container.StatsUsecase,
+	}
+}
+
+// GetMyStats は認証ユーザーの散歩統計を期間ごとに取得する
+// GET /v1/users/me/stats?granularity=day|week|month&from=YYYY-MM-DD&to=YYYY-MM-DD&tz=Asia/Tokyo
+func (h *StatsHandler) GetMyStats(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	input := statsusecase.GetStatsInput{
+		UserID:      userID,
+		Granularity: stats.Granularity(c.DefaultQuery("granularity", string(stats.GranularityDay))),
+	}
+
+	var errs validator.ValidationErrors
+	if v := c.Query("from"); v != "" {
+		if d, err := time.Parse(time.DateOnly, v); err != nil {
+			errs.AddField("from", "from must be YYYY-MM-DD")
+		} else {
+			input.From = &d
+		}
+	}
+	if v := c.Query("to"); v != "" {
+		if d, err := time.Parse(time.DateOnly, v); err != nil {
+			errs.AddField("to", "to must be YYYY-MM-DD")
+		} else {
+			input.To = &d
+		}
+	}
+	if v := c.Query("tz"); v != "" {
+		// "Local" はサーバーのタイムゾーンになるため受け付けない
+		if loc, err := time.LoadLocation(v); err != nil || v == "Local" {
+			errs.AddField("tz", "tz must be an IANA time zone name")
+		} else {
+			input.Location = loc
+		}
+	}
+	if err := errs.Err(); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Usecase呼び出し
+	result, err := h.statsUsecase.GetStats(ctx, input)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.ToStatsResponse(result))
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/stats"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+	statsusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/stats"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockStatsUsecase はStatsUsecaseのモック
+type MockStatsUsecase struct {
+	mock.Mock
+}
+
+func (m *MockStatsUsecase) GetStats(ctx context.Context, input statsusecase.GetStatsInput) (*statsusecase.StatsResult, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*statsusecase.StatsResult), args.Error(1)
+}
+
+func setupStatsTestHandler() (*StatsHandler, *MockStatsUsecase) {
+	gin.SetMode(gin.TestMode)
+	mockUsecase := new(MockStatsUsecase)
+	container := &di.Container{
+		StatsUsecase: mockUsecase,
+	}
+	return NewStatsHandler(container), mockUsecase
+}
+
+func TestStatsHandler_GetMyStats_Success(t *testing.T) {
+	// 期待値: クエリパラメータがUsecaseに渡され、期間ごとの集計値を200 OKで返す
+	handler, mockUsecase := setupStatsTestHandler()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	from := time.Date(2025, 1, 13, 0, 0, 0, 0, tokyo)
+	result := &statsusecase.StatsResult{
+		Granularity: stats.GranularityWeek,
+		From:        from,
+		To:          from.AddDate(0, 0, 14),
+		Buckets: []stats.Bucket{
+			{PeriodStart: from, WalkCount: 2, TotalDistance: 3000, TotalSteps: 4000, MovingTime: 1800, AveragePace: 600},
+			{PeriodStart: from.AddDate(0, 0, 7)},
+		},
+		Total: stats.Bucket{WalkCount: 2, TotalDistance: 3000, TotalSteps: 4000, MovingTime: 1800, AveragePace: 600},
+	}
+
+	mockUsecase.On("GetStats", mock.Anything, mock.MatchedBy(func(input statsusecase.GetStatsInput) bool {
+		return input.UserID == "test-user" &&
+			input.Granularity == stats.GranularityWeek &&
+			input.From != nil && input.From.Format(time.DateOnly) == "2025-01-13" &&
+			input.To != nil && input.To.Format(time.DateOnly) == "2025-01-26" &&
+			input.Location != nil && input.Location.String() == "Asia/Tokyo"
+	})).Return(result, nil)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/users/me/stats?granularity=week&from=2025-01-13&to=2025-01-26&tz=Asia/Tokyo", nil)
+
+	handler.GetMyStats(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "week", response["granularity"])
+	assert.Equal(t, "Asia/Tokyo", response["time_zone"])
+
+	buckets := response["buckets"].([]interface{})
+	require.Len(t, buckets, 2)
+	first := buckets[0].(map[string]interface{})
+	assert.Equal(t, "2025-01-13T00:00:00+09:00", first["period_start"])
+	assert.Equal(t, float64(2), first["walk_count"])
+	assert.Equal(t, float64(600), first["average_pace"])
+
+	total := response["total"].(map[string]interface{})
+	assert.Equal(t, float64(3000), total["total_distance"])
+
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestStatsHandler_GetMyStats_Defaults(t *testing.T) {
+	// 期待値: パラメータ未指定の場合は日単位・期間とタイムゾーンはUsecaseのデフォルト
+	handler, mockUsecase := setupStatsTestHandler()
+
+	mockUsecase.On("GetStats", mock.Anything, statsusecase.GetStatsInput{
+		UserID:      "test-user",
+		Granularity: stats.GranularityDay,
+	}).Return(&statsusecase.StatsResult{Granularity: stats.GranularityDay}, nil)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/users/me/stats", nil)
+
+	handler.GetMyStats(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestStatsHandler_GetMyStats_InvalidParams(t *testing.T) {
+	// 期待値: 形式が不正なパラメータはフィールドごとの詳細付きで400を返す
+	handler, mockUsecase := setupStatsTestHandler()
+
+	c, w := setupTestContext(http.MethodGet, "/v1/users/me/stats?from=2025/01/01&tz=Mars/Olympus", nil)
+
+	handler.GetMyStats(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"from"`)
+	assert.Contains(t, w.Body.String(), `"tz"`)
+	mockUsecase.AssertNotCalled(t, "GetStats", mock.Anything, mock.Anything)
+}
+
+func TestStatsHandler_GetMyStats_UsecaseValidationError(t *testing.T) {
+	// 期待値: Usecaseのバリデーションエラー（未定義の集計単位など）は400
+	handler, mockUsecase := setupStatsTestHandler()
+
+	var errs validator.ValidationErrors
+	errs.AddField("granularity", "granularity must be one of day, week, month")
+	mockUsecase.On("GetStats", mock.Anything, mock.Anything).Return(nil, errs)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/users/me/stats?granularity=year", nil)
+
+	handler.GetMyStats(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockUsecase.AssertExpectations(t)
+}
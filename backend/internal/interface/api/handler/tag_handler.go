@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/api/presenter"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/errors"
+	tagusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/tag"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// TagHandler はタグAPIのハンドラー
+type TagHandler struct {
+	tagUsecase tagusecase.Usecase
+}
+
+// NewTagHandler は新しいTagHandlerを生成する
+func NewTagHandler(container *di.Container) *TagHandler {
+	return &TagHandler{
+		tagUsecase: container.TagUsecase,
+	}
+}
+
+// TagRequest はタグ作成・名前変更のリクエスト
+type TagRequest struct {
+	Name string `json:"name"`
+}
+
+// ListTags はタグの一覧を取得する
+// GET /v1/tags
+func (h *TagHandler) ListTags(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// Usecase呼び出し
+	tags, err := h.tagUsecase.ListTags(ctx, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.ToTagListResponse(tags))
+}
+
+// CreateTag はタグを作成する
+// POST /v1/tags
+func (h *TagHandler) CreateTag(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	var req TagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	// Usecase呼び出し
+	t, err := h.tagUsecase.CreateTag(ctx, userID, req.Name)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusCreated, presenter.ToTagResponse(t))
+}
+
+// RenameTag はタグ名を変更する
+// PUT /v1/tags/:id
+func (h *TagHandler) RenameTag(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// IDパラメータ取得
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid tag ID"))
+		return
+	}
+
+	var req TagRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	// Usecase呼び出し
+	t, err := h.tagUsecase.RenameTag(ctx, id, userID, req.Name)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.JSON(http.StatusOK, presenter.ToTagResponse(t))
+}
+
+// DeleteTag はタグを削除する（タグが付いていた散歩は削除しない）
+// DELETE /v1/tags/:id
+func (h *TagHandler) DeleteTag(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// IDパラメータ取得
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid tag ID"))
+		return
+	}
+
+	// Usecase呼び出し
+	if err := h.tagUsecase.DeleteTag(ctx, id, userID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/tag"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTagUsecase はTagUsecaseのモック
+type MockTagUsecase struct {
+	mock.Mock
+}
+
+func (m *MockTagUsecase) ListTags(ctx context.Context, userID string) ([]*tag.Tag, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*tag.Tag), args.Error(1)
+}
+
+func (m *MockTagUsecase) CreateTag(ctx context.Context, userID, name string) (*tag.Tag, error) {
+	args := m.Called(ctx, userID, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tag.Tag), args.Error(1)
+}
+
+func (m *MockTagUsecase) RenameTag(ctx context.Context, id uuid.UUID, userID, name string) (*tag.Tag, error) {
+	args := m.Called(ctx, id, userID, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tag.Tag), args.Error(1)
+}
+
+func (m *MockTagUsecase) DeleteTag(ctx context.Context, id uuid.UUID, userID string) error {
+	return m.Called(ctx, id, userID).Error(0)
+}
+
+func setupTagTestHandler() (*TagHandler, *MockTagUsecase) {
+	gin.SetMode(gin.TestMode)
+	mockUsecase := new(MockTagUsecase)
+	container := &di.Container{
+		TagUsecase: mockUsecase,
+	}
+	return NewTagHandler(container), mockUsecase
+}
+
+func TestTagHandler_ListTags_Success(t *testing.T) {
+	// 期待値: タグの一覧を散歩の数とあわせて200 OKで返す
+	handler, mockUsecase := setupTagTestHandler()
+
+	dog, _ := tag.NewTag("test-user", "dog")
+	dog.WalkCount = 3
+	mockUsecase.On("ListTags", mock.Anything, "test-user").Return([]*tag.Tag{dog}, nil)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/tags", nil)
+
+	handler.ListTags(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response map[string][]map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response["tags"], 1)
+	assert.Equal(t, "dog", response["tags"][0]["name"])
+	assert.Equal(t, float64(3), response["tags"][0]["walk_count"])
+}
+
+func TestTagHandler_CreateTag(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// 期待値: 作成したタグを201 Createdで返す
+		handler, mockUsecase := setupTagTestHandler()
+
+		created, _ := tag.NewTag("test-user", "Kyoto")
+		mockUsecase.On("CreateTag", mock.Anything, "test-user", "Kyoto").Return(created, nil)
+
+		c, w := setupTestContext(http.MethodPost, "/v1/tags", TagRequest{Name: "Kyoto"})
+
+		handler.CreateTag(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"name":"Kyoto"`)
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		// 期待値: 同じ名前のタグが存在する場合は409
+		handler, mockUsecase := setupTagTestHandler()
+
+		mockUsecase.On("CreateTag", mock.Anything, "test-user", "kyoto").Return(nil, tag.ErrDuplicateName)
+
+		c, w := setupTestContext(http.MethodPost, "/v1/tags", TagRequest{Name: "kyoto"})
+
+		handler.CreateTag(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestTagHandler_DeleteTag_NotFound(t *testing.T) {
+	// 期待値: 存在しない（他のユーザーの）タグは404
+	handler, mockUsecase := setupTagTestHandler()
+
+	id := uuid.New()
+	mockUsecase.On("DeleteTag", mock.Anything, id, "test-user").Return(tag.ErrTagNotFound)
+
+	c, w := setupTestContext(http.MethodDelete, "/v1/tags/"+id.String(), nil)
+	c.Params = gin.Params{{Key: "id", Value: id.String()}}
+
+	handler.DeleteTag(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "Tag not found")
+}
@@ -1,21 +1,29 @@
 package handler
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
-	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/api/middleware"
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/api/presenter"
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/errors"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/pagination"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/trackfile"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
 	walkusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/walk"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
+// maxImportFileSize はインポートするトラックファイルの最大サイズ（バイト）
+const maxImportFileSize = 20 << 20
+
 // WalkHandler は散歩APIのハンドラー
 type WalkHandler struct {
 	container   *di.Container
@@ -55,6 +63,7 @@ type UpdateWalkRequest struct {
 	Title               *string           `json:"title,omitempty"`
 	Description         *string           `json:"description,omitempty"`
 	Status              *walk.WalkStatus  `json:"status,omitempty"`
+	Visibility          *walk.Visibility  `json:"visibility,omitempty"`
 	TotalSteps          *int              `json:"total_steps,omitempty"`
 	StartTime           *time.Time        `json:"start_time,omitempty"`
 	EndTime             *time.Time        `json:"end_time,omitempty"`
@@ -64,14 +73,18 @@ type UpdateWalkRequest struct {
 	PausedAt            *time.Time        `json:"paused_at,omitempty"`
 	TotalPausedDuration *float64          `json:"total_paused_duration,omitempty"`
 	Locations           []LocationRequest `json:"locations,omitempty"`
+	Tags                *[]string         `json:"tags,omitempty"`
 }
 
 // ListWalks は散歩一覧を取得する
 // GET /v1/walks?page=1&limit=20
+// GET /v1/walks?status=completed&start_from=2025-01-01&min_distance=1000&q=公園&sort=distance&order=asc
+// GET /v1/walks?tag=朝の散歩
+// GET /v1/walks?cursor=&limit=20&include_total=true（カーソル方式。初回は空のcursorを指定する）
 func (h *WalkHandler) ListWalks(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	userID := h.getUserID(c)
+	userID := getUserID(c)
 
 	// ページネーションパラメータ取得
 	pageInt := 1
@@ -87,32 +100,93 @@ func (h *WalkHandler) ListWalks(c *gin.Context) {
 		}
 	}
 
+	// 絞り込み・並び替えパラメータ取得
+	query, err := parseListQuery(c)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// cursorパラメータがある場合はカーソル方式
+	if cursor, exists := c.GetQuery("cursor"); exists {
+		h.listWalksByCursor(c, userID, query, cursor, limitInt)
+		return
+	}
+
 	offset := (pageInt - 1) * limitInt
 
 	// Usecase呼び出し
-	walks, totalCount, err := h.walkUsecase.ListWalks(ctx, userID, limitInt, offset)
+	walks, totalCount, err := h.walkUsecase.ListWalks(ctx, userID, query, limitInt, offset)
 	if err != nil {
-		h.respondError(c, err)
+		respondError(c, err)
 		return
 	}
 
-	// レスポンス返却
+	// レスポンス返却（Acceptヘッダーに応じてGeoJSONを返す）
+	if wantsGeoJSON(c) {
+		respondGeoJSON(c, http.StatusOK, presenter.ToWalkFeatureCollection(walks, totalCount, pageInt, limitInt))
+		return
+	}
 	response := presenter.ToWalkListResponse(walks, totalCount, pageInt, limitInt)
 	c.JSON(http.StatusOK, response)
 }
 
+// listWalksByCursor はカーソル方式で散歩一覧を返す
+// 作成日時とIDによるキーセットページネーションのため、取得中に散歩が追加されても重複・欠落しない
+func (h *WalkHandler) listWalksByCursor(c *gin.Context, userID string, query walkusecase.ListQuery, cursor string, limit int) {
+	ctx := c.Request.Context()
+
+	input := walkusecase.ListWalksByCursorInput{
+		UserID:       userID,
+		Query:        query,
+		Limit:        limit,
+		IncludeTotal: c.Query("include_total") == "true",
+	}
+	if cursor != "" {
+		var after walk.ListCursor
+		if err := pagination.DecodeCursor(cursor, &after); err != nil {
+			respondError(c, errors.NewInvalidRequestError("Invalid cursor"))
+			return
+		}
+		input.Cursor = &after
+	}
+
+	// Usecase呼び出し
+	page, err := h.walkUsecase.ListWalksByCursor(ctx, input)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var nextCursor string
+	if page.NextCursor != nil {
+		if nextCursor, err = pagination.EncodeCursor(page.NextCursor); err != nil {
+			respondError(c, err)
+			return
+		}
+	}
+
+	// レスポンス返却（Acceptヘッダーに応じてGeoJSONを返す）
+	if wantsGeoJSON(c) {
+		respondGeoJSON(c, http.StatusOK, presenter.ToWalkCursorFeatureCollection(page.Walks, page.TotalCount, limit, nextCursor))
+		return
+	}
+	response := presenter.ToWalkCursorListResponse(page.Walks, page.TotalCount, limit, nextCursor)
+	c.JSON(http.StatusOK, response)
+}
+
 // GetWalk は散歩詳細を取得する（位置情報を含む）
 // GET /v1/walks/:id
 func (h *WalkHandler) GetWalk(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	userID := h.getUserID(c)
+	userID := getUserID(c)
 
 	// IDパラメータ取得
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.respondError(c, errors.NewInvalidRequestError("Invalid walk ID"))
+		respondError(c, errors.NewInvalidRequestError("Invalid walk ID"))
 		return
 	}
 
@@ -120,15 +194,20 @@ func (h *WalkHandler) GetWalk(c *gin.Context) {
 	result, err := h.walkUsecase.GetWalkWithLocations(ctx, id, userID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			h.respondError(c, errors.NewNotFoundError("Walk not found"))
+			respondError(c, errors.NewNotFoundError("Walk not found"))
 			return
 		}
-		h.respondError(c, err)
+		respondError(c, err)
 		return
 	}
 
-	// レスポンス返却（位置情報を含む）
-	response := presenter.ToWalkDetailResponse(result.Walk, result.Locations)
+	// レスポンス返却（位置情報を含む。Acceptヘッダーに応じてGeoJSONを返す）
+	c.Header("ETag", presenter.WalkETag(result.Walk))
+	if wantsGeoJSON(c) {
+		respondGeoJSON(c, http.StatusOK, presenter.ToWalkFeature(result.Walk, result.Locations))
+		return
+	}
+	response := presenter.ToWalkDetailResponse(result.Walk, result.Locations, result.Photos)
 	c.JSON(http.StatusOK, response)
 }
 
@@ -137,12 +216,12 @@ func (h *WalkHandler) GetWalk(c *gin.Context) {
 func (h *WalkHandler) CreateWalk(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	userID := h.getUserID(c)
+	userID := getUserID(c)
 
 	// リクエストボディをバインド
 	var req CreateWalkRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		h.respondError(c, errors.NewInvalidRequestError("Invalid request body"))
+		respondError(c, errors.NewInvalidRequestError("Invalid request body"))
 		return
 	}
 
@@ -154,7 +233,61 @@ func (h *WalkHandler) CreateWalk(c *gin.Context) {
 	}
 	wlk, err := h.walkUsecase.CreateWalk(ctx, input)
 	if err != nil {
-		h.respondError(c, err)
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.Header("ETag", presenter.WalkETag(wlk))
+	response := presenter.ToWalkResponse(wlk)
+	c.JSON(http.StatusCreated, response)
+}
+
+// ImportWalk はGPX/TCXファイルから完了済みの散歩を作成する
+// POST /v1/walks/import (multipart/form-data: file, title, description)
+func (h *WalkHandler) ImportWalk(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// アップロードファイル取得（サイズ上限を超える場合は読み込みを打ち切る）
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportFileSize)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("file is required"))
+		return
+	}
+
+	format, err := trackfile.DetectFormat(fileHeader.Filename)
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Unsupported file format: only .gpx and .tcx are supported"))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid upload file"))
+		return
+	}
+	defer file.Close()
+
+	// トラックファイル解析
+	track, err := trackfile.Parse(file, format)
+	if err != nil {
+		respondError(c, errors.NewAppError(errors.CodeInvalidRequest, "Invalid track file", err))
+		return
+	}
+
+	// Usecase呼び出し
+	input := walkusecase.ImportWalkInput{
+		UserID:      userID,
+		Title:       c.PostForm("title"),
+		Description: c.PostForm("description"),
+		Track:       track,
+	}
+	wlk, err := h.walkUsecase.ImportWalk(ctx, input)
+	if err != nil {
+		respondError(c, err)
 		return
 	}
 
@@ -168,20 +301,26 @@ func (h *WalkHandler) CreateWalk(c *gin.Context) {
 func (h *WalkHandler) UpdateWalk(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	userID := h.getUserID(c)
+	userID := getUserID(c)
 
 	// IDパラメータ取得
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.respondError(c, errors.NewInvalidRequestError("Invalid walk ID"))
+		respondError(c, errors.NewInvalidRequestError("Invalid walk ID"))
+		return
+	}
+
+	ifMatch, err := parseIfMatch(c)
+	if err != nil {
+		respondError(c, err)
 		return
 	}
 
 	// リクエストボディをバインド
 	var req UpdateWalkRequest
 	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
-		h.respondError(c, errors.NewInvalidRequestError("Invalid request body"))
+		respondError(c, errors.NewInvalidRequestError("Invalid request body"))
 		return
 	}
 
@@ -211,6 +350,7 @@ func (h *WalkHandler) UpdateWalk(c *gin.Context) {
 		Title:               req.Title,
 		Description:         req.Description,
 		Status:              req.Status,
+		Visibility:          req.Visibility,
 		TotalSteps:          req.TotalSteps,
 		StartTime:           req.StartTime,
 		EndTime:             req.EndTime,
@@ -220,15 +360,18 @@ func (h *WalkHandler) UpdateWalk(c *gin.Context) {
 		PausedAt:            req.PausedAt,
 		TotalPausedDuration: req.TotalPausedDuration,
 		Locations:           locations,
+		Tags:                req.Tags,
+		IfMatch:             ifMatch,
 	}
 	wlk, err := h.walkUsecase.UpdateWalk(ctx, input, userID)
 	if err != nil {
 		fmt.Printf("[DEBUG] UpdateWalk error: %+v\n", err)
-		h.respondError(c, err)
+		respondError(c, err)
 		return
 	}
 
 	// レスポンス返却
+	c.Header("ETag", presenter.WalkETag(wlk))
 	response := presenter.ToWalkResponse(wlk)
 	c.JSON(http.StatusOK, response)
 }
@@ -238,23 +381,29 @@ func (h *WalkHandler) UpdateWalk(c *gin.Context) {
 func (h *WalkHandler) DeleteWalk(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	userID := h.getUserID(c)
+	userID := getUserID(c)
 
 	// IDパラメータ取得
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.respondError(c, errors.NewInvalidRequestError("Invalid walk ID"))
+		respondError(c, errors.NewInvalidRequestError("Invalid walk ID"))
+		return
+	}
+
+	ifMatch, err := parseIfMatch(c)
+	if err != nil {
+		respondError(c, err)
 		return
 	}
 
 	// Usecase呼び出し
-	if err := h.walkUsecase.DeleteWalk(ctx, id, userID); err != nil {
+	if err := h.walkUsecase.DeleteWalk(ctx, id, userID, ifMatch); err != nil {
 		if err == sql.ErrNoRows {
-			h.respondError(c, errors.NewNotFoundError("Walk not found"))
+			respondError(c, errors.NewNotFoundError("Walk not found"))
 			return
 		}
-		h.respondError(c, err)
+		respondError(c, err)
 		return
 	}
 
@@ -262,46 +411,208 @@ func (h *WalkHandler) DeleteWalk(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// StartWalk は散歩を開始する
+// POST /v1/walks/:id/start
+func (h *WalkHandler) StartWalk(c *gin.Context) {
+	h.handleTransition(c, h.walkUsecase.StartWalk)
+}
+
+// PauseWalk は散歩を一時停止する
+// POST /v1/walks/:id/pause
+func (h *WalkHandler) PauseWalk(c *gin.Context) {
+	h.handleTransition(c, h.walkUsecase.PauseWalk)
+}
+
+// ResumeWalk は散歩を再開する
+// POST /v1/walks/:id/resume
+func (h *WalkHandler) ResumeWalk(c *gin.Context) {
+	h.handleTransition(c, h.walkUsecase.ResumeWalk)
+}
+
+// CompleteWalk は散歩を完了する
+// POST /v1/walks/:id/complete
+func (h *WalkHandler) CompleteWalk(c *gin.Context) {
+	h.handleTransition(c, h.walkUsecase.CompleteWalk)
+}
+
+// ExportWalkGPX は散歩をGPX 1.1形式でエクスポートする
+// GET /v1/walks/:id/export.gpx
+func (h *WalkHandler) ExportWalkGPX(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// IDパラメータ取得
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		respondError(c, errors.NewInvalidRequestError("Invalid walk ID"))
+		return
+	}
+
+	// Usecase呼び出し（位置情報を1行ずつレスポンスへ書き出す）
+	exporter := &gpxResponseExporter{
+		GPXWriter: presenter.NewGPXWriter(c.Writer),
+		c:         c,
+	}
+	if err := h.walkUsecase.ExportWalk(ctx, id, userID, exporter); err != nil {
+		if c.Writer.Written() {
+			// 書き出し開始後はステータスを変更できないため、ログに記録して中断する
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+		respondError(c, err)
+	}
+}
+
+// gpxResponseExporter はGPXの書き出し開始時にレスポンスヘッダーを設定する
+// 権限エラーなどで書き出し前に失敗した場合は通常のJSONエラーを返せるよう、ヘッダー設定を遅延させる
+type gpxResponseExporter struct {
+	*presenter.GPXWriter
+	c *gin.Context
+}
+
+// WriteHeader はレスポンスヘッダーを設定してからGPXのヘッダーを書き出す
+func (e *gpxResponseExporter) WriteHeader(w *walk.Walk) error {
+	e.c.Header("Content-Type", presenter.GPXContentType)
+	e.c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, presenter.GPXFilename(w)))
+	e.c.Status(http.StatusOK)
+	return e.GPXWriter.WriteHeader(w)
+}
+
 // ヘルパーメソッド
 
-// getUserID は現在のユーザーIDを取得する
-// 認証ミドルウェアで設定されたユーザーIDを取得する
-// エラーが発生する場合は認証設定に問題があるため、panicで早期検知する
-func (h *WalkHandler) getUserID(c *gin.Context) string {
-	userID, err := middleware.GetUserID(c)
+// transitionFunc は状態遷移を行うUsecaseメソッドのシグネチャ
+type transitionFunc func(ctx context.Context, id uuid.UUID, userID string) (*walk.Walk, error)
+
+// handleTransition は状態遷移エンドポイントの共通処理
+// タイムスタンプと一時停止時間の計算はサーバー側（ドメイン層）で行う
+func (h *WalkHandler) handleTransition(c *gin.Context, transition transitionFunc) {
+	ctx := c.Request.Context()
+
+	userID := getUserID(c)
+
+	// IDパラメータ取得
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
 	if err != nil {
-		panic(fmt.Sprintf("authentication misconfiguration: %v", err))
+		respondError(c, errors.NewInvalidRequestError("Invalid walk ID"))
+		return
 	}
-	return userID
+
+	// Usecase呼び出し
+	wlk, err := transition(ctx, id, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// レスポンス返却
+	c.Header("ETag", presenter.WalkETag(wlk))
+	response := presenter.ToWalkResponse(wlk)
+	c.JSON(http.StatusOK, response)
+}
+
+// parseIfMatch はIf-Matchヘッダーから更新対象の散歩の版数を取得する
+// ヘッダーがない場合や * の場合は版数を指定しない（nil）
+// 散歩のETagは強いエンティティタグのため、弱いタグや形式が不正な値はどの版数にも一致しない
+func parseIfMatch(c *gin.Context) (*int, error) {
+	v := strings.TrimSpace(c.GetHeader("If-Match"))
+	if v == "" || v == "*" {
+		return nil, nil
+	}
+
+	if len(v) < 2 || !strings.HasPrefix(v, `"`) || !strings.HasSuffix(v, `"`) {
+		return nil, errors.NewPreconditionFailedError("Walk has been modified")
+	}
+	version, err := strconv.Atoi(v[1 : len(v)-1])
+	if err != nil || version < 1 {
+		return nil, errors.NewPreconditionFailedError("Walk has been modified")
+	}
+	return &version, nil
+}
+
+// wantsGeoJSON はAcceptヘッダーでGeoJSONが要求されているかを判定する
+// Acceptヘッダーがない場合や */* の場合は通常のJSONを返す
+func wantsGeoJSON(c *gin.Context) bool {
+	return c.NegotiateFormat(gin.MIMEJSON, presenter.GeoJSONContentType) == presenter.GeoJSONContentType
+}
+
+// respondGeoJSON はContent-Typeを application/geo+json としてJSONレスポンスを返す
+func respondGeoJSON(c *gin.Context, status int, obj interface{}) {
+	c.Header("Content-Type", presenter.GeoJSONContentType)
+	c.JSON(status, obj)
+}
+
+// parseListQuery はクエリパラメータから散歩一覧の絞り込み・並び替え条件を取得する
+// 値の形式が不正なパラメータはすべて収集して validator.ValidationErrors として返す
+// 値の範囲や組み合わせの検証はUsecaseで行う
+func parseListQuery(c *gin.Context) (walkusecase.ListQuery, error) {
+	var query walkusecase.ListQuery
+	var errs validator.ValidationErrors
+
+	if v := c.Query("status"); v != "" {
+		for _, s := range strings.Split(v, ",") {
+			query.Filter.Statuses = append(query.Filter.Statuses, walk.WalkStatus(strings.TrimSpace(s)))
+		}
+	}
+	if v := c.Query("start_from"); v != "" {
+		t, err := parseQueryTime(v, false)
+		if err != nil {
+			errs.AddField("start_from", err.Error())
+		} else {
+			query.Filter.StartFrom = &t
+		}
+	}
+	if v := c.Query("start_to"); v != "" {
+		t, err := parseQueryTime(v, true)
+		if err != nil {
+			errs.AddField("start_to", err.Error())
+		} else {
+			query.Filter.StartTo = &t
+		}
+	}
+	if v := c.Query("min_distance"); v != "" {
+		d, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			errs.AddField("min_distance", "min_distance must be a number")
+		} else {
+			query.Filter.MinDistance = &d
+		}
+	}
+	if v := c.Query("max_distance"); v != "" {
+		d, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			errs.AddField("max_distance", "max_distance must be a number")
+		} else {
+			query.Filter.MaxDistance = &d
+		}
+	}
+	query.Filter.Query = strings.TrimSpace(c.Query("q"))
+	query.Filter.Tag = strings.TrimSpace(c.Query("tag"))
+	query.Sort = walk.SortOption{
+		Field: walk.SortField(c.Query("sort")),
+		Order: walk.SortOrder(c.Query("order")),
+	}
+
+	return query, errs.Err()
 }
 
-// respondError はエラーレスポンスを返す
-func (h *WalkHandler) respondError(c *gin.Context, err error) {
-	appErr := errors.GetAppError(err)
-	if appErr == nil {
-		appErr = errors.NewInternalError("Internal server error", err)
-	}
-
-	status := http.StatusInternalServerError
-	switch appErr.Code {
-	case errors.CodeInvalidRequest:
-		status = http.StatusBadRequest
-	case errors.CodeUnauthorized:
-		status = http.StatusUnauthorized
-	case errors.CodeForbidden:
-		status = http.StatusForbidden
-	case errors.CodeNotFound:
-		status = http.StatusNotFound
-	case errors.CodeConflict:
-		status = http.StatusConflict
-	}
-
-	c.JSON(status, gin.H{
-		"error": gin.H{
-			"code":    appErr.Code,
-			"message": appErr.Message,
-		},
-	})
+// parseQueryTime はRFC3339または日付（YYYY-MM-DD、UTC）形式の時刻を解析する
+// endOfRange がtrueの場合、日付指定はその日を含むよう翌日0時に変換する
+func parseQueryTime(v string, endOfRange bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.DateOnly, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("must be RFC3339 or YYYY-MM-DD")
+	}
+	if endOfRange {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, nil
 }
 
 // parsePositiveInt は文字列を正の整数に変換する
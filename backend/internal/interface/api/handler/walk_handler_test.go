@@ -5,14 +5,19 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/api/middleware"
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/errors"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/pagination"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
 	walkusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/walk"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -49,14 +54,22 @@ func (m *MockWalkUsecase) GetWalkWithLocations(ctx context.Context, id uuid.UUID
 	return args.Get(0).(*walkusecase.WalkWithLocations), args.Error(1)
 }
 
-func (m *MockWalkUsecase) ListWalks(ctx context.Context, userID string, limit, offset int) ([]*walk.Walk, int, error) {
-	args := m.Called(ctx, userID, limit, offset)
+func (m *MockWalkUsecase) ListWalks(ctx context.Context, userID string, query walkusecase.ListQuery, limit, offset int) ([]*walk.Walk, int, error) {
+	args := m.Called(ctx, userID, query, limit, offset)
 	if args.Get(0) == nil {
 		return nil, 0, args.Error(2)
 	}
 	return args.Get(0).([]*walk.Walk), args.Int(1), args.Error(2)
 }
 
+func (m *MockWalkUsecase) ListWalksByCursor(ctx context.Context, input walkusecase.ListWalksByCursorInput) (*walkusecase.WalkPage, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walkusecase.WalkPage), args.Error(1)
+}
+
 func (m *MockWalkUsecase) UpdateWalk(ctx context.Context, input walkusecase.UpdateWalkInput, userID string) (*walk.Walk, error) {
 	args := m.Called(ctx, input, userID)
 	if args.Get(0) == nil {
@@ -65,8 +78,8 @@ func (m *MockWalkUsecase) UpdateWalk(ctx context.Context, input walkusecase.Upda
 	return args.Get(0).(*walk.Walk), args.Error(1)
 }
 
-func (m *MockWalkUsecase) DeleteWalk(ctx context.Context, id uuid.UUID, userID string) error {
-	args := m.Called(ctx, id, userID)
+func (m *MockWalkUsecase) DeleteWalk(ctx context.Context, id uuid.UUID, userID string, ifMatch *int) error {
+	args := m.Called(ctx, id, userID, ifMatch)
 	return args.Error(0)
 }
 
@@ -102,6 +115,19 @@ func (m *MockWalkUsecase) CompleteWalk(ctx context.Context, id uuid.UUID, userID
 	return args.Get(0).(*walk.Walk), args.Error(1)
 }
 
+func (m *MockWalkUsecase) ImportWalk(ctx context.Context, input walkusecase.ImportWalkInput) (*walk.Walk, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walk.Walk), args.Error(1)
+}
+
+func (m *MockWalkUsecase) ExportWalk(ctx context.Context, id uuid.UUID, userID string, exporter walkusecase.WalkExporter) error {
+	args := m.Called(ctx, id, userID, exporter)
+	return args.Error(0)
+}
+
 // テストヘルパー関数
 
 func setupTestHandler() (*WalkHandler, *MockWalkUsecase) {
@@ -136,6 +162,29 @@ func setupTestContext(method, path string, body interface{}) (*gin.Context, *htt
 	return c, w
 }
 
+// setupMultipartContext はファイルアップロードを含むmultipartリクエストのテストコンテキストを生成する
+// ファイルは fileField のフォーム項目として送る（filename が空の場合は送らない）
+func setupMultipartContext(path, fileField, filename, content string, fields map[string]string) (*gin.Context, *httptest.ResponseRecorder) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	if filename != "" {
+		part, _ := mw.CreateFormFile(fileField, filename)
+		_, _ = part.Write([]byte(content))
+	}
+	for k, v := range fields {
+		_ = mw.WriteField(k, v)
+	}
+	_ = mw.Close()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, path, &body)
+	c.Request.Header.Set("Content-Type", mw.FormDataContentType())
+	c.Set(middleware.AuthContextKey, "test-user")
+
+	return c, w
+}
+
 // テストケース
 
 func TestWalkHandler_CreateWalk_Success(t *testing.T) {
@@ -211,6 +260,7 @@ func TestWalkHandler_GetWalk_Success(t *testing.T) {
 	walkID := uuid.New()
 	expectedWalk := walk.NewWalk("test-user", "Test Walk", "Description")
 	expectedWalk.ID = walkID
+	expectedWalk.Version = 3
 
 	expectedResult := &walkusecase.WalkWithLocations{
 		Walk:      expectedWalk,
@@ -226,6 +276,8 @@ func TestWalkHandler_GetWalk_Success(t *testing.T) {
 
 	// 期待値検証: HTTPステータス200、散歩IDが正しく返却される
 	assert.Equal(t, http.StatusOK, w.Code)
+	// 期待値検証: 版数をETagとして返す
+	assert.Equal(t, `"3"`, w.Header().Get("ETag"))
 
 	var response map[string]interface{}
 	err := json.Unmarshal(w.Body.Bytes(), &response)
@@ -233,6 +285,52 @@ func TestWalkHandler_GetWalk_Success(t *testing.T) {
 	assert.Equal(t, walkID.String(), response["id"])
 	// 期待値検証: locations配列が存在する
 	assert.NotNil(t, response["locations"])
+	// 期待値検証: 写真がない場合もphotosは空配列
+	assert.Equal(t, []interface{}{}, response["photos"])
+
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestWalkHandler_GetWalk_GeoJSON(t *testing.T) {
+	// 期待値: Accept: application/geo+json の場合、LineStringのFeatureを返す
+	handler, mockUsecase := setupTestHandler()
+
+	walkID := uuid.New()
+	expectedWalk := walk.NewWalk("test-user", "Test Walk", "")
+	expectedWalk.ID = walkID
+	now := time.Now()
+
+	expectedResult := &walkusecase.WalkWithLocations{
+		Walk: expectedWalk,
+		Locations: []*walk.WalkLocation{
+			walk.NewWalkLocationWithOptionals(walkID, 35.6812, 139.7671, nil, now, nil, nil, nil, nil, 0),
+			walk.NewWalkLocationWithOptionals(walkID, 35.6813, 139.7672, nil, now.Add(time.Minute), nil, nil, nil, nil, 1),
+		},
+	}
+
+	mockUsecase.On("GetWalkWithLocations", mock.Anything, walkID, "test-user").Return(expectedResult, nil)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/walks/"+walkID.String(), nil)
+	c.Request.Header.Set("Accept", "application/geo+json")
+	c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+	handler.GetWalk(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/geo+json", w.Header().Get("Content-Type"))
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Feature", response["type"])
+	assert.Equal(t, walkID.String(), response["id"])
+
+	geometry := response["geometry"].(map[string]interface{})
+	assert.Equal(t, "LineString", geometry["type"])
+	assert.Len(t, geometry["coordinates"], 2)
+
+	properties := response["properties"].(map[string]interface{})
+	assert.Equal(t, "Test Walk", properties["title"])
 
 	mockUsecase.AssertExpectations(t)
 }
@@ -277,7 +375,7 @@ func TestWalkHandler_ListWalks_Success(t *testing.T) {
 		walk.NewWalk("test-user", "Walk 2", "Description 2"),
 	}
 
-	mockUsecase.On("ListWalks", mock.Anything, "test-user", 20, 0).Return(walks, 2, nil)
+	mockUsecase.On("ListWalks", mock.Anything, "test-user", walkusecase.ListQuery{}, 20, 0).Return(walks, 2, nil)
 
 	c, w := setupTestContext(http.MethodGet, "/v1/walks", nil)
 
@@ -295,6 +393,178 @@ func TestWalkHandler_ListWalks_Success(t *testing.T) {
 	mockUsecase.AssertExpectations(t)
 }
 
+func TestWalkHandler_ListWalks_GeoJSON(t *testing.T) {
+	// 期待値: Accept: application/geo+json の場合、FeatureCollectionを返す
+	handler, mockUsecase := setupTestHandler()
+
+	walks := []*walk.Walk{
+		walk.NewWalk("test-user", "Walk 1", ""),
+		walk.NewWalk("test-user", "Walk 2", ""),
+	}
+
+	mockUsecase.On("ListWalks", mock.Anything, "test-user", walkusecase.ListQuery{}, 20, 0).Return(walks, 2, nil)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/walks", nil)
+	c.Request.Header.Set("Accept", "application/geo+json")
+
+	handler.ListWalks(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/geo+json", w.Header().Get("Content-Type"))
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "FeatureCollection", response["type"])
+	assert.Len(t, response["features"], 2)
+	assert.Equal(t, float64(2), response["total_count"])
+
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestWalkHandler_ListWalks_AcceptJSON(t *testing.T) {
+	// 期待値: Accept: application/json の場合は従来のJSONを返す
+	handler, mockUsecase := setupTestHandler()
+
+	mockUsecase.On("ListWalks", mock.Anything, "test-user", walkusecase.ListQuery{}, 20, 0).Return([]*walk.Walk{}, 0, nil)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/walks", nil)
+	c.Request.Header.Set("Accept", "application/json, application/geo+json;q=0.5")
+
+	handler.ListWalks(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Type"), "application/json")
+	assert.Contains(t, w.Body.String(), `"walks"`)
+
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestWalkHandler_ListWalks_Cursor(t *testing.T) {
+	// 期待値: 空のcursorで先頭ページを取得し、次ページのカーソルを返す（total_countは省略）
+	handler, mockUsecase := setupTestHandler()
+
+	walks := []*walk.Walk{
+		walk.NewWalk("test-user", "Walk 1", ""),
+		walk.NewWalk("test-user", "Walk 2", ""),
+	}
+	next := walk.CursorOf(walks[1])
+
+	mockUsecase.On("ListWalksByCursor", mock.Anything, walkusecase.ListWalksByCursorInput{
+		UserID: "test-user",
+		Limit:  2,
+	}).Return(&walkusecase.WalkPage{Walks: walks, NextCursor: &next}, nil)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/walks?cursor=&limit=2", nil)
+
+	handler.ListWalks(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response["walks"], 2)
+	assert.NotContains(t, response, "total_count")
+	assert.NotContains(t, response, "page")
+
+	// 期待値: next_cursorを次のリクエストに渡すと同じ位置に復元される
+	nextCursor, ok := response["next_cursor"].(string)
+	assert.True(t, ok)
+
+	var decoded walk.ListCursor
+	assert.NoError(t, pagination.DecodeCursor(nextCursor, &decoded))
+	assert.Equal(t, next.ID, decoded.ID)
+	assert.True(t, next.CreatedAt.Equal(decoded.CreatedAt))
+
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestWalkHandler_ListWalks_CursorWithTotal(t *testing.T) {
+	// 期待値: 続きのカーソルとinclude_total=trueで総件数を返し、最終ページではnext_cursorを省略する
+	handler, mockUsecase := setupTestHandler()
+
+	after := walk.ListCursor{CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), ID: uuid.New()}
+	cursor, err := pagination.EncodeCursor(after)
+	assert.NoError(t, err)
+
+	total := 3
+	mockUsecase.On("ListWalksByCursor", mock.Anything, mock.MatchedBy(func(input walkusecase.ListWalksByCursorInput) bool {
+		return input.Cursor != nil && input.Cursor.ID == after.ID && input.IncludeTotal && input.Limit == 20
+	})).Return(&walkusecase.WalkPage{Walks: []*walk.Walk{walk.NewWalk("test-user", "Walk 3", "")}, TotalCount: &total}, nil)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/walks?include_total=true&cursor="+cursor, nil)
+
+	handler.ListWalks(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(3), response["total_count"])
+	assert.NotContains(t, response, "next_cursor")
+
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestWalkHandler_ListWalks_InvalidCursor(t *testing.T) {
+	// 期待値: 不正なカーソルは400 Bad Request
+	handler, mockUsecase := setupTestHandler()
+
+	c, w := setupTestContext(http.MethodGet, "/v1/walks?cursor=not-a-cursor", nil)
+
+	handler.ListWalks(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockUsecase.AssertNotCalled(t, "ListWalksByCursor", mock.Anything, mock.Anything)
+}
+
+func TestWalkHandler_ListWalks_FilterAndSort(t *testing.T) {
+	// 期待値: 絞り込み・並び替えパラメータがListQueryとしてUsecaseに渡される
+	handler, mockUsecase := setupTestHandler()
+
+	minDistance := 1000.0
+	startFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	startTo := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC) // 日付指定の上限はその日を含む
+	expectedQuery := walkusecase.ListQuery{
+		Filter: walk.WalkFilter{
+			Statuses:    []walk.WalkStatus{walk.StatusCompleted, walk.StatusPaused},
+			StartFrom:   &startFrom,
+			StartTo:     &startTo,
+			MinDistance: &minDistance,
+			Query:       "公園",
+			Tag:         "morning",
+		},
+		Sort: walk.SortOption{Field: walk.SortByDistance, Order: walk.SortAsc},
+	}
+
+	mockUsecase.On("ListWalks", mock.Anything, "test-user", expectedQuery, 20, 0).Return([]*walk.Walk{}, 0, nil)
+
+	c, w := setupTestContext(http.MethodGet,
+		"/v1/walks?status=completed,paused&start_from=2025-01-01&start_to=2025-01-31&min_distance=1000&q=%E5%85%AC%E5%9C%92&tag=morning&sort=distance&order=asc", nil)
+
+	handler.ListWalks(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestWalkHandler_ListWalks_InvalidFilter(t *testing.T) {
+	// 期待値: 形式が不正なパラメータはフィールドごとの詳細付きで400を返す
+	handler, mockUsecase := setupTestHandler()
+
+	c, w := setupTestContext(http.MethodGet, "/v1/walks?start_from=yesterday&max_distance=far", nil)
+
+	handler.ListWalks(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "start_from")
+	assert.Contains(t, w.Body.String(), "max_distance")
+	mockUsecase.AssertNotCalled(t, "ListWalks", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 // 期待値: 散歩一覧をデフォルトページネーション（page=1, limit=20）で取得し、200 OKを返す
 func TestWalkHandler_ListWalks_WithPagination(t *testing.T) {
 	handler, mockUsecase := setupTestHandler()
@@ -304,7 +574,7 @@ func TestWalkHandler_ListWalks_WithPagination(t *testing.T) {
 	}
 
 	// page=2, limit=10 → offset=10
-	mockUsecase.On("ListWalks", mock.Anything, "test-user", 10, 10).Return(walks, 15, nil)
+	mockUsecase.On("ListWalks", mock.Anything, "test-user", walkusecase.ListQuery{}, 10, 10).Return(walks, 15, nil)
 
 	c, w := setupTestContext(http.MethodGet, "/v1/walks?page=2&limit=10", nil)
 
@@ -342,6 +612,35 @@ func TestWalkHandler_UpdateWalk_Success(t *testing.T) {
 	mockUsecase.AssertExpectations(t)
 }
 
+// 期待値: 公開範囲をUsecaseへ渡し、レスポンスに含める
+func TestWalkHandler_UpdateWalk_Visibility(t *testing.T) {
+	handler, mockUsecase := setupTestHandler()
+
+	walkID := uuid.New()
+	visibility := walk.VisibilityFriends
+	reqBody := UpdateWalkRequest{
+		Visibility: &visibility,
+	}
+
+	updatedWalk := walk.NewWalk("test-user", "Walk", "")
+	updatedWalk.ID = walkID
+	updatedWalk.Visibility = walk.VisibilityFriends
+
+	mockUsecase.On("UpdateWalk", mock.Anything, mock.MatchedBy(func(input walkusecase.UpdateWalkInput) bool {
+		return input.ID == walkID && input.Visibility != nil && *input.Visibility == walk.VisibilityFriends
+	}), "test-user").Return(updatedWalk, nil)
+
+	c, w := setupTestContext(http.MethodPut, "/v1/walks/"+walkID.String(), reqBody)
+	c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+	handler.UpdateWalk(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"visibility":"friends"`)
+
+	mockUsecase.AssertExpectations(t)
+}
+
 // 期待値: 存在しないIDでもupsertにより新規作成され、200 OKを返す
 func TestWalkHandler_UpdateWalk_Upsert(t *testing.T) {
 	handler, mockUsecase := setupTestHandler()
@@ -370,13 +669,111 @@ func TestWalkHandler_UpdateWalk_Upsert(t *testing.T) {
 	mockUsecase.AssertExpectations(t)
 }
 
+// 期待値: If-Matchの版数をUsecaseへ渡し、更新後の版数をETagとして返す
+func TestWalkHandler_UpdateWalk_IfMatch(t *testing.T) {
+	handler, mockUsecase := setupTestHandler()
+
+	walkID := uuid.New()
+	newTitle := "Updated Title"
+	reqBody := UpdateWalkRequest{
+		Title: &newTitle,
+	}
+
+	updatedWalk := walk.NewWalk("test-user", "Updated Title", "")
+	updatedWalk.ID = walkID
+	updatedWalk.Version = 4
+
+	mockUsecase.On("UpdateWalk", mock.Anything, mock.MatchedBy(func(input walkusecase.UpdateWalkInput) bool {
+		return input.IfMatch != nil && *input.IfMatch == 3
+	}), "test-user").Return(updatedWalk, nil)
+
+	c, w := setupTestContext(http.MethodPut, "/v1/walks/"+walkID.String(), reqBody)
+	c.Request.Header.Set("If-Match", `"3"`)
+	c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+	handler.UpdateWalk(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+
+	mockUsecase.AssertExpectations(t)
+}
+
+// 期待値: 版数が一致しない場合は412 Precondition Failedを返す
+func TestWalkHandler_UpdateWalk_VersionMismatch(t *testing.T) {
+	handler, mockUsecase := setupTestHandler()
+
+	walkID := uuid.New()
+	newTitle := "Updated Title"
+	reqBody := UpdateWalkRequest{
+		Title: &newTitle,
+	}
+
+	mockUsecase.On("UpdateWalk", mock.Anything, mock.Anything, "test-user").Return(nil, fmt.Errorf("failed to upsert walk: %w", walk.ErrVersionMismatch))
+
+	c, w := setupTestContext(http.MethodPut, "/v1/walks/"+walkID.String(), reqBody)
+	c.Request.Header.Set("If-Match", `"3"`)
+	c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+	handler.UpdateWalk(c)
+
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	assert.Contains(t, w.Body.String(), errors.CodePreconditionFailed)
+
+	mockUsecase.AssertExpectations(t)
+}
+
+// 期待値: 散歩のETagに一致し得ないIf-Matchは、Usecaseを呼ばずに412 Precondition Failedを返す
+func TestWalkHandler_UpdateWalk_InvalidIfMatch(t *testing.T) {
+	for _, ifMatch := range []string{`W/"3"`, `3`, `"abc"`, `"0"`} {
+		t.Run(ifMatch, func(t *testing.T) {
+			handler, mockUsecase := setupTestHandler()
+
+			walkID := uuid.New()
+			newTitle := "Updated Title"
+			reqBody := UpdateWalkRequest{
+				Title: &newTitle,
+			}
+
+			c, w := setupTestContext(http.MethodPut, "/v1/walks/"+walkID.String(), reqBody)
+			c.Request.Header.Set("If-Match", ifMatch)
+			c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+			handler.UpdateWalk(c)
+
+			assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+			mockUsecase.AssertNotCalled(t, "UpdateWalk", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// 期待値: If-Matchの版数をUsecaseへ渡し、一致しない場合は412 Precondition Failedを返す
+func TestWalkHandler_DeleteWalk_IfMatch(t *testing.T) {
+	handler, mockUsecase := setupTestHandler()
+
+	walkID := uuid.New()
+	version := 2
+
+	mockUsecase.On("DeleteWalk", mock.Anything, walkID, "test-user", &version).Return(walk.ErrVersionMismatch)
+
+	c, w := setupTestContext(http.MethodDelete, "/v1/walks/"+walkID.String(), nil)
+	c.Request.Header.Set("If-Match", `"2"`)
+	c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+	handler.DeleteWalk(c)
+
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+
+	mockUsecase.AssertExpectations(t)
+}
+
 // 期待値: 存在しないIDの更新で404 Not Foundを返す
 func TestWalkHandler_DeleteWalk_Success(t *testing.T) {
 	handler, mockUsecase := setupTestHandler()
 
 	walkID := uuid.New()
 
-	mockUsecase.On("DeleteWalk", mock.Anything, walkID, "test-user").Return(nil)
+	mockUsecase.On("DeleteWalk", mock.Anything, walkID, "test-user", (*int)(nil)).Return(nil)
 
 	c, w := setupTestContext(http.MethodDelete, "/v1/walks/"+walkID.String(), nil)
 	c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
@@ -396,7 +793,7 @@ func TestWalkHandler_DeleteWalk_NotFound(t *testing.T) {
 
 	walkID := uuid.New()
 
-	mockUsecase.On("DeleteWalk", mock.Anything, walkID, "test-user").Return(sql.ErrNoRows)
+	mockUsecase.On("DeleteWalk", mock.Anything, walkID, "test-user", (*int)(nil)).Return(sql.ErrNoRows)
 
 	c, w := setupTestContext(http.MethodDelete, "/v1/walks/"+walkID.String(), nil)
 	c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
@@ -410,7 +807,7 @@ func TestWalkHandler_DeleteWalk_NotFound(t *testing.T) {
 
 // 期待値: 存在しないIDの削除で404 Not Foundを返す
 func TestWalkHandler_RespondError(t *testing.T) {
-	handler, _ := setupTestHandler()
+	gin.SetMode(gin.TestMode)
 
 	tests := []struct {
 		name           string
@@ -436,13 +833,28 @@ func TestWalkHandler_RespondError(t *testing.T) {
 			expectedStatus: http.StatusUnauthorized,
 			expectedCode:   errors.CodeUnauthorized,
 		},
+		{
+			name: "InvalidTransition",
+			err: fmt.Errorf("failed to start walk: %w", &walk.InvalidTransitionError{
+				From: walk.StatusCompleted,
+				To:   walk.StatusInProgress,
+			}),
+			expectedStatus: http.StatusConflict,
+			expectedCode:   errors.CodeConflict,
+		},
+		{
+			name:           "UnknownError",
+			err:            fmt.Errorf("unexpected"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   errors.CodeInternalError,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c, w := setupTestContext(http.MethodGet, "/test", nil)
 
-			handler.respondError(c, tt.err)
+			respondError(c, tt.err)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
 
@@ -458,13 +870,13 @@ func TestWalkHandler_RespondError(t *testing.T) {
 
 func TestWalkHandler_GetUserID_Success(t *testing.T) {
 	// 期待値: 認証ミドルウェアからユーザーIDを正常に取得できること
-	handler, _ := setupTestHandler()
+	gin.SetMode(gin.TestMode)
 
 	c, _ := setupTestContext(http.MethodGet, "/test", nil)
 	expectedUserID := "authenticated-user-123"
 	c.Set(middleware.AuthContextKey, expectedUserID)
 
-	userID := handler.getUserID(c)
+	userID := getUserID(c)
 
 	// 期待値検証: ユーザーIDが一致
 	assert.Equal(t, expectedUserID, userID)
@@ -472,7 +884,7 @@ func TestWalkHandler_GetUserID_Success(t *testing.T) {
 
 func TestWalkHandler_GetUserID_Panic(t *testing.T) {
 	// 期待値: 認証情報がない場合、panicが発生すること
-	handler, _ := setupTestHandler()
+	gin.SetMode(gin.TestMode)
 
 	// 認証情報なしのコンテキストを作成
 	w := httptest.NewRecorder()
@@ -483,6 +895,324 @@ func TestWalkHandler_GetUserID_Panic(t *testing.T) {
 
 	// 期待値検証: panicが発生する
 	assert.Panics(t, func() {
-		handler.getUserID(c)
+		getUserID(c)
 	}, "認証情報がない場合はpanicが発生すべき")
 }
+
+func TestWalkHandler_LifecycleEndpoints_Success(t *testing.T) {
+	// 期待値: 各状態遷移エンドポイントが200 OKと更新後の散歩を返す
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		status   walk.WalkStatus
+		callFunc func(h *WalkHandler, c *gin.Context)
+	}{
+		{name: "start", method: "StartWalk", path: "start", status: walk.StatusInProgress, callFunc: (*WalkHandler).StartWalk},
+		{name: "pause", method: "PauseWalk", path: "pause", status: walk.StatusPaused, callFunc: (*WalkHandler).PauseWalk},
+		{name: "resume", method: "ResumeWalk", path: "resume", status: walk.StatusInProgress, callFunc: (*WalkHandler).ResumeWalk},
+		{name: "complete", method: "CompleteWalk", path: "complete", status: walk.StatusCompleted, callFunc: (*WalkHandler).CompleteWalk},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, mockUsecase := setupTestHandler()
+
+			walkID := uuid.New()
+			updatedWalk := walk.NewWalk("test-user", "Test Walk", "")
+			updatedWalk.ID = walkID
+			updatedWalk.Status = tt.status
+
+			mockUsecase.On(tt.method, mock.Anything, walkID, "test-user").Return(updatedWalk, nil)
+
+			c, w := setupTestContext(http.MethodPost, "/v1/walks/"+walkID.String()+"/"+tt.path, nil)
+			c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+			tt.callFunc(handler, c)
+
+			// 期待値検証: HTTPステータス200、ステータスが更新後の値
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, walkID.String(), response["id"])
+			assert.Equal(t, string(tt.status), response["status"])
+
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWalkHandler_PauseWalk_InvalidTransition(t *testing.T) {
+	// 期待値: 不正な状態遷移の場合、409 Conflictを返す
+	handler, mockUsecase := setupTestHandler()
+
+	walkID := uuid.New()
+	transitionErr := &walk.InvalidTransitionError{From: walk.StatusCompleted, To: walk.StatusPaused}
+
+	mockUsecase.On("PauseWalk", mock.Anything, walkID, "test-user").
+		Return(nil, fmt.Errorf("failed to pause walk: %w", transitionErr))
+
+	c, w := setupTestContext(http.MethodPost, "/v1/walks/"+walkID.String()+"/pause", nil)
+	c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+	handler.PauseWalk(c)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	errorMap := response["error"].(map[string]interface{})
+	assert.Equal(t, errors.CodeConflict, errorMap["code"])
+
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestWalkHandler_StartWalk_NotFound(t *testing.T) {
+	// 期待値: 存在しない散歩の場合、404 Not Foundを返す
+	handler, mockUsecase := setupTestHandler()
+
+	walkID := uuid.New()
+
+	mockUsecase.On("StartWalk", mock.Anything, walkID, "test-user").
+		Return(nil, fmt.Errorf("failed to get walk: %w", sql.ErrNoRows))
+
+	c, w := setupTestContext(http.MethodPost, "/v1/walks/"+walkID.String()+"/start", nil)
+	c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+	handler.StartWalk(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestWalkHandler_LifecycleEndpoints_NotOwner(t *testing.T) {
+	// 期待値: 他のユーザーの散歩の場合、存在しない場合と同じ404 Not Foundを返す
+	handler, mockUsecase := setupTestHandler()
+
+	walkID := uuid.New()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		handle gin.HandlerFunc
+	}{
+		{"start", "StartWalk", "/start", handler.StartWalk},
+		{"pause", "PauseWalk", "/pause", handler.PauseWalk},
+		{"resume", "ResumeWalk", "/resume", handler.ResumeWalk},
+		{"complete", "CompleteWalk", "/complete", handler.CompleteWalk},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsecase.On(tt.method, mock.Anything, walkID, "test-user").Return(nil, walk.ErrNotOwner).Once()
+
+			c, w := setupTestContext(http.MethodPost, "/v1/walks/"+walkID.String()+tt.path, nil)
+			c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+			tt.handle(c)
+
+			assert.Equal(t, http.StatusNotFound, w.Code)
+		})
+	}
+
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestWalkHandler_CompleteWalk_InvalidID(t *testing.T) {
+	// 期待値: 不正なUUID形式の場合、400 Bad Requestを返す
+	handler, _ := setupTestHandler()
+
+	c, w := setupTestContext(http.MethodPost, "/v1/walks/invalid-uuid/complete", nil)
+	c.Params = gin.Params{{Key: "id", Value: "invalid-uuid"}}
+
+	handler.CompleteWalk(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestWalkHandler_UpdateWalk_ValidationError(t *testing.T) {
+	// 期待値: バリデーションエラーの場合、400 Bad Requestとフィールド単位のdetailsを返す
+	handler, mockUsecase := setupTestHandler()
+
+	walkID := uuid.New()
+	newTitle := "Title"
+	reqBody := UpdateWalkRequest{
+		Title: &newTitle,
+	}
+
+	validationErrs := validator.ValidationErrors{
+		{Field: "end_time", Message: "end_time must be after start_time"},
+		{Field: "total_distance", Message: "total_distance must be greater than or equal to 0"},
+	}
+	mockUsecase.On("UpdateWalk", mock.Anything, mock.Anything, "test-user").Return(nil, validationErrs)
+
+	c, w := setupTestContext(http.MethodPut, "/v1/walks/"+walkID.String(), reqBody)
+	c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+	handler.UpdateWalk(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	errorMap := response["error"].(map[string]interface{})
+	assert.Equal(t, errors.CodeInvalidRequest, errorMap["code"])
+
+	// 期待値検証: detailsに不正なフィールドがすべて含まれる
+	details := errorMap["details"].([]interface{})
+	assert.Len(t, details, 2)
+	first := details[0].(map[string]interface{})
+	assert.Equal(t, "end_time", first["field"])
+	assert.Equal(t, "end_time must be after start_time", first["message"])
+
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestWalkHandler_ExportWalkGPX_Success(t *testing.T) {
+	// 期待値: GPXファイルとして200 OK・添付ファイル名付きで位置情報がストリーミングされる
+	handler, mockUsecase := setupTestHandler()
+
+	walkID := uuid.New()
+	exportWalk := walk.NewWalk("test-user", "Morning Walk", "")
+	exportWalk.ID = walkID
+	altitude := 12.5
+	location := walk.NewWalkLocationWithOptionals(
+		walkID, 35.6812, 139.7671, &altitude, time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC), nil, nil, nil, nil, 0,
+	)
+
+	mockUsecase.On("ExportWalk", mock.Anything, walkID, "test-user", mock.Anything).
+		Run(func(args mock.Arguments) {
+			exporter := args.Get(3).(walkusecase.WalkExporter)
+			assert.NoError(t, exporter.WriteHeader(exportWalk))
+			assert.NoError(t, exporter.WriteLocation(location))
+			assert.NoError(t, exporter.Close())
+		}).
+		Return(nil)
+
+	c, w := setupTestContext(http.MethodGet, "/v1/walks/"+walkID.String()+"/export.gpx", nil)
+	c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+	handler.ExportWalkGPX(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/gpx+xml", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "walk-"+walkID.String()+".gpx")
+	assert.Contains(t, w.Body.String(), `<trkpt lat="35.6812" lon="139.7671">`)
+	assert.Contains(t, w.Body.String(), "<ele>12.5</ele>")
+
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestWalkHandler_ExportWalkGPX_NotFound(t *testing.T) {
+	// 期待値: 書き出し開始前のエラーは通常のJSONエラーレスポンスになる
+	handler, mockUsecase := setupTestHandler()
+
+	walkID := uuid.New()
+
+	mockUsecase.On("ExportWalk", mock.Anything, walkID, "test-user", mock.Anything).
+		Return(fmt.Errorf("failed to get walk: %w", sql.ErrNoRows))
+
+	c, w := setupTestContext(http.MethodGet, "/v1/walks/"+walkID.String()+"/export.gpx", nil)
+	c.Params = gin.Params{{Key: "id", Value: walkID.String()}}
+
+	handler.ExportWalkGPX(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Type"), "application/json")
+
+	mockUsecase.AssertExpectations(t)
+}
+
+const importTestGPX = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" xmlns="http://www.topografix.com/GPX/1/1">
+  <metadata><name>Imported Walk</name></metadata>
+  <trk><trkseg>
+    <trkpt lat="35.6812" lon="139.7671"><time>2025-01-01T00:00:00Z</time></trkpt>
+    <trkpt lat="35.6822" lon="139.7681"><time>2025-01-01T00:10:00Z</time></trkpt>
+  </trkseg></trk>
+</gpx>`
+
+func TestWalkHandler_ImportWalk_Success(t *testing.T) {
+	// 期待値: GPXファイルを解析して201 Createdを返し、フォームのタイトルをUsecaseへ渡す
+	handler, mockUsecase := setupTestHandler()
+
+	importedWalk := walk.NewWalk("test-user", "My Title", "")
+	importedWalk.Status = walk.StatusCompleted
+
+	mockUsecase.On("ImportWalk", mock.Anything, mock.MatchedBy(func(input walkusecase.ImportWalkInput) bool {
+		return input.UserID == "test-user" &&
+			input.Title == "My Title" &&
+			input.Track != nil &&
+			input.Track.Name == "Imported Walk" &&
+			len(input.Track.Points) == 2
+	})).Return(importedWalk, nil)
+
+	c, w := setupMultipartContext("/v1/walks/import", "file", "walk.gpx", importTestGPX, map[string]string{"title": "My Title"})
+
+	handler.ImportWalk(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "My Title", response["title"])
+	assert.Equal(t, "completed", response["status"])
+
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestWalkHandler_ImportWalk_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+	}{
+		// 期待値: ファイルがない場合は400
+		{name: "missing file", filename: ""},
+		// 期待値: 未対応の拡張子は400
+		{name: "unsupported format", filename: "walk.kml", content: "<kml></kml>"},
+		// 期待値: 解析できないファイルは400
+		{name: "malformed file", filename: "walk.gpx", content: "<gpx><trk>"},
+		// 期待値: トラックポイントのないファイルは400
+		{name: "no track points", filename: "walk.tcx", content: "<TrainingCenterDatabase></TrainingCenterDatabase>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, mockUsecase := setupTestHandler()
+
+			c, w := setupMultipartContext("/v1/walks/import", "file", tt.filename, tt.content, nil)
+
+			handler.ImportWalk(c)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockUsecase.AssertNotCalled(t, "ImportWalk", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestWalkHandler_ImportWalk_ValidationError(t *testing.T) {
+	// 期待値: Usecaseのバリデーションエラーは詳細付きの400になる
+	handler, mockUsecase := setupTestHandler()
+
+	var errs validator.ValidationErrors
+	errs.AddField("points[1]", "latitude must be between -90 and 90")
+	mockUsecase.On("ImportWalk", mock.Anything, mock.Anything).Return(nil, errs)
+
+	c, w := setupMultipartContext("/v1/walks/import", "file", "walk.gpx", importTestGPX, nil)
+
+	handler.ImportWalk(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "points[1]")
+
+	mockUsecase.AssertExpectations(t)
+}
@@ -0,0 +1,41 @@
+package presenter
+
+import (
+	"time"
+
+	achievementusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/achievement"
+)
+
+// AchievementResponse は獲得した実績のレスポンス
+type AchievementResponse struct {
+	Code        string    `json:"code"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	WalkID      *string   `json:"walk_id"` // 散歩が削除された場合はnull
+	AwardedAt   time.Time `json:"awarded_at"`
+}
+
+// AchievementListResponse は実績一覧APIのレスポンス
+type AchievementListResponse struct {
+	Achievements []AchievementResponse `json:"achievements"`
+}
+
+// ToAchievementListResponse は獲得した実績の一覧をレスポンスに変換する
+func ToAchievementListResponse(awarded []achievementusecase.AwardedAchievement) AchievementListResponse {
+	achievements := make([]AchievementResponse, len(awarded))
+	for i, a := range awarded {
+		res := AchievementResponse{
+			Code:        string(a.Rule.Code),
+			Title:       a.Rule.Title,
+			Description: a.Rule.Description,
+			AwardedAt:   a.Achievement.AwardedAt,
+		}
+		if a.Achievement.WalkID != nil {
+			id := a.Achievement.WalkID.String()
+			res.WalkID = &id
+		}
+		achievements[i] = res
+	}
+
+	return AchievementListResponse{Achievements: achievements}
+}
@@ -0,0 +1,49 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/collection"
+	"github.com/google/uuid"
+)
+
+// CollectionResponse はコレクションのレスポンス
+// walk_ids はコレクション内の並び順
+type CollectionResponse struct {
+	ID          uuid.UUID   `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	WalkIDs     []uuid.UUID `json:"walk_ids"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+// CollectionListResponse はコレクション一覧APIのレスポンス
+type CollectionListResponse struct {
+	Collections []CollectionResponse `json:"collections"`
+}
+
+// ToCollectionResponse はコレクションをレスポンスに変換する
+func ToCollectionResponse(c *collection.Collection) CollectionResponse {
+	walkIDs := c.WalkIDs
+	if walkIDs == nil {
+		walkIDs = []uuid.UUID{}
+	}
+	return CollectionResponse{
+		ID:          c.ID,
+		Name:        c.Name,
+		Description: c.Description,
+		WalkIDs:     walkIDs,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+// ToCollectionListResponse はコレクションの一覧をレスポンスに変換する
+func ToCollectionListResponse(collections []*collection.Collection) CollectionListResponse {
+	responses := make([]CollectionResponse, len(collections))
+	for i, c := range collections {
+		responses[i] = ToCollectionResponse(c)
+	}
+	return CollectionListResponse{Collections: responses}
+}
@@ -0,0 +1,119 @@
+package presenter
+
+import (
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/polyline"
+	"github.com/google/uuid"
+)
+
+// GeoJSONContentType はGeoJSON（RFC 7946）のContent-Type
+const GeoJSONContentType = "application/geo+json"
+
+// GeoJSONGeometry はGeoJSONのLineStringジオメトリ
+// 座標はRFC 7946に従い [経度, 緯度] の順
+type GeoJSONGeometry struct {
+	Type        string      `json:"type"`
+	Coordinates [][]float64 `json:"coordinates"`
+}
+
+// GeoJSONFeature は散歩1件を表すGeoJSONのFeature
+// 経路が2点未満の場合、geometryはnullになる
+type GeoJSONFeature struct {
+	Type       string           `json:"type"`
+	ID         uuid.UUID        `json:"id"`
+	Geometry   *GeoJSONGeometry `json:"geometry"`
+	Properties WalkResponse     `json:"properties"`
+}
+
+// GeoJSONFeatureCollection は散歩一覧を表すGeoJSONのFeatureCollection
+// ページネーション情報はRFC 7946の外部メンバーとして含める
+// 各メンバーの意味は WalkListResponse と同じ
+type GeoJSONFeatureCollection struct {
+	Type       string           `json:"type"`
+	Features   []GeoJSONFeature `json:"features"`
+	TotalCount *int             `json:"total_count,omitempty"`
+	Page       int              `json:"page,omitempty"`
+	Limit      int              `json:"limit"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+}
+
+// ToWalkFeature はWalkと位置情報をGeoJSONのFeatureに変換する
+// ジオメトリはsequence_number順の全位置情報から生成する
+func ToWalkFeature(w *walk.Walk, locations []*walk.WalkLocation) GeoJSONFeature {
+	coordinates := make([][]float64, len(locations))
+	for i, loc := range locations {
+		coordinates[i] = []float64{loc.Longitude, loc.Latitude}
+	}
+
+	return newWalkFeature(w, coordinates)
+}
+
+// ToWalkFeatureCollection はWalkリストをGeoJSONのFeatureCollectionに変換する
+// 一覧では位置情報を読み込まないため、ジオメトリは保存済みのポリラインから復元する
+func ToWalkFeatureCollection(walks []*walk.Walk, totalCount, page, limit int) GeoJSONFeatureCollection {
+	return GeoJSONFeatureCollection{
+		Type:       "FeatureCollection",
+		Features:   newWalkFeatures(walks),
+		TotalCount: &totalCount,
+		Page:       page,
+		Limit:      limit,
+	}
+}
+
+// ToWalkCursorFeatureCollection はカーソル方式の散歩一覧をGeoJSONのFeatureCollectionに変換する
+func ToWalkCursorFeatureCollection(walks []*walk.Walk, totalCount *int, limit int, nextCursor string) GeoJSONFeatureCollection {
+	return GeoJSONFeatureCollection{
+		Type:       "FeatureCollection",
+		Features:   newWalkFeatures(walks),
+		TotalCount: totalCount,
+		Limit:      limit,
+		NextCursor: nextCursor,
+	}
+}
+
+// newWalkFeatures はWalkリストを保存済みポリラインのジオメトリを持つFeatureリストに変換する
+func newWalkFeatures(walks []*walk.Walk) []GeoJSONFeature {
+	features := make([]GeoJSONFeature, len(walks))
+	for i, w := range walks {
+		features[i] = newWalkFeature(w, polylineCoordinates(w.PolylineData))
+	}
+	return features
+}
+
+// newWalkFeature はWalkと座標列からFeatureを生成する
+func newWalkFeature(w *walk.Walk, coordinates [][]float64) GeoJSONFeature {
+	feature := GeoJSONFeature{
+		Type:       "Feature",
+		ID:         w.ID,
+		Properties: ToWalkResponse(w),
+	}
+
+	// LineStringは2点以上の座標が必要
+	if len(coordinates) >= 2 {
+		feature.Geometry = &GeoJSONGeometry{
+			Type:        "LineString",
+			Coordinates: coordinates,
+		}
+	}
+
+	return feature
+}
+
+// polylineCoordinates はエンコード済みポリラインをGeoJSONの座標列に変換する
+// 未設定または不正なポリラインの場合はnilを返す
+func polylineCoordinates(encoded *string) [][]float64 {
+	if encoded == nil || *encoded == "" {
+		return nil
+	}
+
+	points, err := polyline.Decode(*encoded)
+	if err != nil {
+		return nil
+	}
+
+	coordinates := make([][]float64, len(points))
+	for i, p := range points {
+		coordinates[i] = []float64{p.Lng, p.Lat}
+	}
+	return coordinates
+}
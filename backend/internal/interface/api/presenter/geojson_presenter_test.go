@@ -0,0 +1,77 @@
+package presenter
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/polyline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToWalkFeature(t *testing.T) {
+	w := walk.NewWalk("user-123", "朝の散歩", "")
+	now := time.Now()
+	locations := []*walk.WalkLocation{
+		walk.NewWalkLocationWithOptionals(w.ID, 35.6812, 139.7671, nil, now, nil, nil, nil, nil, 0),
+		walk.NewWalkLocationWithOptionals(w.ID, 35.6813, 139.7672, nil, now.Add(time.Minute), nil, nil, nil, nil, 1),
+	}
+
+	feature := ToWalkFeature(w, locations)
+
+	// 期待値: 位置情報が [経度, 緯度] 順のLineStringになる
+	require.NotNil(t, feature.Geometry)
+	assert.Equal(t, "Feature", feature.Type)
+	assert.Equal(t, w.ID, feature.ID)
+	assert.Equal(t, "LineString", feature.Geometry.Type)
+	assert.Equal(t, [][]float64{{139.7671, 35.6812}, {139.7672, 35.6813}}, feature.Geometry.Coordinates)
+
+	// 期待値: 散歩のフィールドがpropertiesに含まれる
+	assert.Equal(t, "朝の散歩", feature.Properties.Title)
+}
+
+func TestToWalkFeature_NoRoute(t *testing.T) {
+	// 期待値: 位置情報が2点未満の場合はgeometryがnullとして出力される
+	w := walk.NewWalk("user-123", "", "")
+
+	body, err := json.Marshal(ToWalkFeature(w, nil))
+	require.NoError(t, err)
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(body, &decoded))
+	geometry, ok := decoded["geometry"]
+	assert.True(t, ok)
+	assert.Nil(t, geometry)
+}
+
+func TestToWalkFeatureCollection(t *testing.T) {
+	withRoute := walk.NewWalk("user-123", "経路あり", "")
+	encoded := polyline.Encode([]polyline.Point{{Lat: 35.6812, Lng: 139.7671}, {Lat: 35.6822, Lng: 139.7681}})
+	withRoute.PolylineData = &encoded
+
+	invalid := "!!"
+	withInvalidRoute := walk.NewWalk("user-123", "不正な経路", "")
+	withInvalidRoute.PolylineData = &invalid
+
+	withoutRoute := walk.NewWalk("user-123", "経路なし", "")
+
+	collection := ToWalkFeatureCollection([]*walk.Walk{withRoute, withInvalidRoute, withoutRoute}, 3, 1, 20)
+
+	assert.Equal(t, "FeatureCollection", collection.Type)
+	require.NotNil(t, collection.TotalCount)
+	assert.Equal(t, 3, *collection.TotalCount)
+	require.Len(t, collection.Features, 3)
+
+	// 期待値: ポリラインから復元したLineStringになる
+	first := collection.Features[0]
+	require.NotNil(t, first.Geometry)
+	require.Len(t, first.Geometry.Coordinates, 2)
+	assert.InDelta(t, 139.7671, first.Geometry.Coordinates[0][0], 1e-5)
+	assert.InDelta(t, 35.6812, first.Geometry.Coordinates[0][1], 1e-5)
+
+	// 期待値: 不正または未設定のポリラインはgeometryなし
+	assert.Nil(t, collection.Features[1].Geometry)
+	assert.Nil(t, collection.Features[2].Geometry)
+}
@@ -0,0 +1,184 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+)
+
+const (
+	// GPXContentType はGPXファイルのContent-Type
+	GPXContentType = "application/gpx+xml"
+
+	// gpxNamespace はGPX 1.1の名前空間
+	gpxNamespace = "http://www.topografix.com/GPX/1/1"
+	// gpxSchemaLocation はGPX 1.1のスキーマ位置
+	gpxSchemaLocation = "http://www.topografix.com/GPX/1/1 http://www.topografix.com/GPX/1/1/gpx.xsd"
+	// gpxTrackPointExtensionNamespace は速度・方位を表すGarmin TrackPointExtension v2の名前空間
+	gpxTrackPointExtensionNamespace = "http://www.garmin.com/xmlschemas/TrackPointExtension/v2"
+	// gpxCreator はGPXの作成アプリケーション名
+	gpxCreator = "TekuToko"
+
+	// gpxFlushInterval は書き出しをフラッシュする位置情報の件数間隔
+	gpxFlushInterval = 200
+)
+
+// gpxMetadata はGPXのmetadata要素
+type gpxMetadata struct {
+	XMLName xml.Name `xml:"metadata"`
+	Name    string   `xml:"name,omitempty"`
+	Desc    string   `xml:"desc,omitempty"`
+	Time    string   `xml:"time,omitempty"`
+}
+
+// gpxTrackPoint はGPXのtrkpt要素
+type gpxTrackPoint struct {
+	XMLName    xml.Name       `xml:"trkpt"`
+	Lat        float64        `xml:"lat,attr"`
+	Lon        float64        `xml:"lon,attr"`
+	Ele        *float64       `xml:"ele,omitempty"`
+	Time       string         `xml:"time"`
+	Extensions *gpxExtensions `xml:"extensions,omitempty"`
+}
+
+// gpxExtensions はtrkptの拡張要素
+type gpxExtensions struct {
+	TrackPointExtension gpxTrackPointExtension `xml:"gpxtpx:TrackPointExtension"`
+}
+
+// gpxTrackPointExtension はGarmin TrackPointExtensionの速度（m/s）と方位（度）
+type gpxTrackPointExtension struct {
+	Speed  *float64 `xml:"gpxtpx:speed,omitempty"`
+	Course *float64 `xml:"gpxtpx:course,omitempty"`
+}
+
+// GPXWriter はWalkをGPX 1.1形式で逐次書き出すエクスポーター
+// walkusecase.WalkExporter を実装する
+type GPXWriter struct {
+	enc   *xml.Encoder
+	count int
+}
+
+// NewGPXWriter は新しいGPXWriterを生成する
+func NewGPXWriter(w io.Writer) *GPXWriter {
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return &GPXWriter{enc: enc}
+}
+
+// GPXFilename はダウンロード時のファイル名を返す
+func GPXFilename(w *walk.Walk) string {
+	return fmt.Sprintf("walk-%s.gpx", w.ID)
+}
+
+// WriteHeader はXML宣言・metadata・トラック開始要素を書き出す
+func (g *GPXWriter) WriteHeader(w *walk.Walk) error {
+	if err := g.enc.EncodeToken(xml.ProcInst{
+		Target: "xml",
+		Inst:   []byte(`version="1.0" encoding="UTF-8"`),
+	}); err != nil {
+		return err
+	}
+
+	root := xml.StartElement{
+		Name: xml.Name{Local: "gpx"},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "version"}, Value: "1.1"},
+			{Name: xml.Name{Local: "creator"}, Value: gpxCreator},
+			{Name: xml.Name{Local: "xmlns"}, Value: gpxNamespace},
+			{Name: xml.Name{Local: "xmlns:xsi"}, Value: "http://www.w3.org/2001/XMLSchema-instance"},
+			{Name: xml.Name{Local: "xmlns:gpxtpx"}, Value: gpxTrackPointExtensionNamespace},
+			{Name: xml.Name{Local: "xsi:schemaLocation"}, Value: gpxSchemaLocation},
+		},
+	}
+	if err := g.enc.EncodeToken(root); err != nil {
+		return err
+	}
+
+	metadata := gpxMetadata{Name: w.Title, Desc: w.Description}
+	if w.StartTime != nil {
+		metadata.Time = formatGPXTime(*w.StartTime)
+	}
+	if err := g.enc.Encode(metadata); err != nil {
+		return err
+	}
+
+	if err := g.enc.EncodeToken(xml.StartElement{Name: xml.Name{Local: "trk"}}); err != nil {
+		return err
+	}
+	if w.Title != "" {
+		if err := g.enc.EncodeElement(w.Title, xml.StartElement{Name: xml.Name{Local: "name"}}); err != nil {
+			return err
+		}
+	}
+	if err := g.enc.EncodeToken(xml.StartElement{Name: xml.Name{Local: "trkseg"}}); err != nil {
+		return err
+	}
+
+	return g.enc.Flush()
+}
+
+// WriteLocation は位置情報を1件のtrkptとして書き出す
+func (g *GPXWriter) WriteLocation(loc *walk.WalkLocation) error {
+	point := gpxTrackPoint{
+		Lat:  loc.Latitude,
+		Lon:  loc.Longitude,
+		Ele:  loc.Altitude,
+		Time: formatGPXTime(loc.Timestamp),
+	}
+	// iOSは無効な速度・方位を-1で報告するため、スキーマの範囲外の値は出力しない
+	speed := gpxSpeed(loc.Speed)
+	course := gpxCourse(loc.Course)
+	if speed != nil || course != nil {
+		point.Extensions = &gpxExtensions{
+			TrackPointExtension: gpxTrackPointExtension{
+				Speed:  speed,
+				Course: course,
+			},
+		}
+	}
+
+	if err := g.enc.Encode(point); err != nil {
+		return err
+	}
+
+	g.count++
+	if g.count%gpxFlushInterval == 0 {
+		return g.enc.Flush()
+	}
+	return nil
+}
+
+// Close はトラック・ルート要素を閉じて書き出しを完了する
+func (g *GPXWriter) Close() error {
+	for _, name := range []string{"trkseg", "trk", "gpx"} {
+		if err := g.enc.EncodeToken(xml.EndElement{Name: xml.Name{Local: name}}); err != nil {
+			return err
+		}
+	}
+	return g.enc.Flush()
+}
+
+// gpxSpeed はTrackPointExtensionの速度として有効な値（0以上）のみを返す
+func gpxSpeed(speed *float64) *float64 {
+	if speed == nil || *speed < 0 {
+		return nil
+	}
+	return speed
+}
+
+// gpxCourse はTrackPointExtensionの方位として有効な値（0以上360未満）のみを返す
+func gpxCourse(course *float64) *float64 {
+	if course == nil || *course < 0 || *course >= 360 {
+		return nil
+	}
+	return course
+}
+
+// formatGPXTime はGPXの時刻形式（UTCのRFC3339）に変換する
+func formatGPXTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
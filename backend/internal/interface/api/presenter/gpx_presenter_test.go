@@ -0,0 +1,150 @@
+package presenter
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// parsedGPX はテスト検証用のGPX構造
+type parsedGPX struct {
+	XMLName  xml.Name `xml:"http://www.topografix.com/GPX/1/1 gpx"`
+	Version  string   `xml:"version,attr"`
+	Metadata struct {
+		Name string `xml:"name"`
+		Desc string `xml:"desc"`
+		Time string `xml:"time"`
+	} `xml:"metadata"`
+	Track struct {
+		Name   string `xml:"name"`
+		Points []struct {
+			Lat        float64  `xml:"lat,attr"`
+			Lon        float64  `xml:"lon,attr"`
+			Ele        *float64 `xml:"ele"`
+			Time       string   `xml:"time"`
+			Extensions struct {
+				TPX struct {
+					Speed  *float64 `xml:"speed"`
+					Course *float64 `xml:"course"`
+				} `xml:"http://www.garmin.com/xmlschemas/TrackPointExtension/v2 TrackPointExtension"`
+			} `xml:"extensions"`
+		} `xml:"trkseg>trkpt"`
+	} `xml:"trk"`
+}
+
+func TestGPXWriter(t *testing.T) {
+	walkID := uuid.New()
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+
+	w := walk.NewWalk("user-123", "朝の散歩 & <公園>", "川沿いを一周")
+	w.ID = walkID
+	w.StartTime = &start
+
+	altitude := 10.5
+	speed := 1.4
+	course := 90.0
+	locations := []*walk.WalkLocation{
+		walk.NewWalkLocationWithOptionals(walkID, 35.6812, 139.7671, &altitude, start, nil, nil, &speed, &course, 0),
+		walk.NewWalkLocationWithOptionals(walkID, 35.6813, 139.7672, nil, start.Add(time.Minute), nil, nil, nil, nil, 1),
+	}
+
+	var buf bytes.Buffer
+	writer := NewGPXWriter(&buf)
+	require.NoError(t, writer.WriteHeader(w))
+	for _, loc := range locations {
+		require.NoError(t, writer.WriteLocation(loc))
+	}
+	require.NoError(t, writer.Close())
+
+	// 期待値: XML宣言から始まる整形式のGPX 1.1文書になる
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte(`<?xml version="1.0" encoding="UTF-8"?>`)))
+
+	var gpx parsedGPX
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &gpx))
+
+	// 期待値: メタデータにタイトル・説明・開始時刻（UTC）が含まれる
+	assert.Equal(t, "1.1", gpx.Version)
+	assert.Equal(t, "朝の散歩 & <公園>", gpx.Metadata.Name)
+	assert.Equal(t, "川沿いを一周", gpx.Metadata.Desc)
+	assert.Equal(t, "2025-01-01T00:00:00Z", gpx.Metadata.Time)
+	assert.Equal(t, "朝の散歩 & <公園>", gpx.Track.Name)
+
+	// 期待値: 位置情報が順番どおりにtrkptとして出力される
+	require.Len(t, gpx.Track.Points, 2)
+	first := gpx.Track.Points[0]
+	assert.Equal(t, 35.6812, first.Lat)
+	assert.Equal(t, 139.7671, first.Lon)
+	require.NotNil(t, first.Ele)
+	assert.Equal(t, 10.5, *first.Ele)
+	assert.Equal(t, "2025-01-01T00:00:00Z", first.Time)
+	require.NotNil(t, first.Extensions.TPX.Speed)
+	assert.Equal(t, 1.4, *first.Extensions.TPX.Speed)
+	require.NotNil(t, first.Extensions.TPX.Course)
+	assert.Equal(t, 90.0, *first.Extensions.TPX.Course)
+
+	// 期待値: 標高・速度がない点ではele/extensionsを出力しない
+	second := gpx.Track.Points[1]
+	assert.Nil(t, second.Ele)
+	assert.Nil(t, second.Extensions.TPX.Speed)
+	assert.Equal(t, "2025-01-01T00:01:00Z", second.Time)
+}
+
+func TestGPXWriter_InvalidSpeedAndCourse(t *testing.T) {
+	// 期待値: iOSの無効値（-1）の速度・方位はextensionsに出力しない
+	walkID := uuid.New()
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	w := walk.NewWalk("user-123", "", "")
+	w.ID = walkID
+
+	invalid := -1.0
+	speed := 1.2
+	locations := []*walk.WalkLocation{
+		walk.NewWalkLocationWithOptionals(walkID, 35.6812, 139.7671, nil, start, nil, nil, &invalid, &invalid, 0),
+		walk.NewWalkLocationWithOptionals(walkID, 35.6813, 139.7672, nil, start.Add(time.Second), nil, nil, &speed, &invalid, 1),
+	}
+
+	var buf bytes.Buffer
+	writer := NewGPXWriter(&buf)
+	require.NoError(t, writer.WriteHeader(w))
+	for _, loc := range locations {
+		require.NoError(t, writer.WriteLocation(loc))
+	}
+	require.NoError(t, writer.Close())
+
+	assert.NotContains(t, buf.String(), "-1")
+
+	var gpx parsedGPX
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &gpx))
+	require.Len(t, gpx.Track.Points, 2)
+
+	// 期待値: 速度・方位がともに無効な点ではextensions自体を出力しない
+	assert.Nil(t, gpx.Track.Points[0].Extensions.TPX.Speed)
+	assert.Nil(t, gpx.Track.Points[0].Extensions.TPX.Course)
+	assert.Equal(t, 1, strings.Count(buf.String(), "<extensions>"))
+
+	// 期待値: 有効な速度のみ出力し、無効な方位は省略する
+	require.NotNil(t, gpx.Track.Points[1].Extensions.TPX.Speed)
+	assert.Equal(t, 1.2, *gpx.Track.Points[1].Extensions.TPX.Speed)
+	assert.Nil(t, gpx.Track.Points[1].Extensions.TPX.Course)
+}
+
+func TestGPXWriter_NoLocations(t *testing.T) {
+	// 期待値: 位置情報がなくても空のtrksegを持つ有効なGPXになる
+	w := walk.NewWalk("user-123", "", "")
+
+	var buf bytes.Buffer
+	writer := NewGPXWriter(&buf)
+	require.NoError(t, writer.WriteHeader(w))
+	require.NoError(t, writer.Close())
+
+	var gpx parsedGPX
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &gpx))
+	assert.Empty(t, gpx.Track.Points)
+}
@@ -0,0 +1,43 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/photo"
+	"github.com/google/uuid"
+)
+
+// PhotoResponse は散歩の写真のレスポンス
+type PhotoResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	WalkID      uuid.UUID  `json:"walk_id"`
+	URL         string     `json:"url"`
+	ContentType string     `json:"content_type"`
+	CapturedAt  *time.Time `json:"captured_at"`
+	Latitude    *float64   `json:"latitude"`
+	Longitude   *float64   `json:"longitude"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ToPhotoResponse は写真をレスポンスに変換する
+func ToPhotoResponse(p *photo.Photo) PhotoResponse {
+	return PhotoResponse{
+		ID:          p.ID,
+		WalkID:      p.WalkID,
+		URL:         p.URL,
+		ContentType: p.ContentType,
+		CapturedAt:  p.CapturedAt,
+		Latitude:    p.Latitude,
+		Longitude:   p.Longitude,
+		CreatedAt:   p.CreatedAt,
+	}
+}
+
+// toPhotoResponses は写真の一覧をレスポンスに変換する（写真がない場合もnullではなく空配列にする）
+func toPhotoResponses(photos []*photo.Photo) []PhotoResponse {
+	responses := make([]PhotoResponse, len(photos))
+	for i, p := range photos {
+		responses[i] = ToPhotoResponse(p)
+	}
+	return responses
+}
@@ -0,0 +1,44 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/privacy"
+	"github.com/google/uuid"
+)
+
+// PrivacyZoneResponse はプライバシーゾーンのレスポンス
+type PrivacyZoneResponse struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	Latitude     float64   `json:"latitude"`
+	Longitude    float64   `json:"longitude"`
+	RadiusMeters float64   `json:"radius_meters"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// PrivacyZoneListResponse はプライバシーゾーン一覧APIのレスポンス
+type PrivacyZoneListResponse struct {
+	Zones []PrivacyZoneResponse `json:"zones"`
+}
+
+// ToPrivacyZoneResponse はプライバシーゾーンをレスポンスに変換する
+func ToPrivacyZoneResponse(z *privacy.Zone) PrivacyZoneResponse {
+	return PrivacyZoneResponse{
+		ID:           z.ID,
+		Name:         z.Name,
+		Latitude:     z.Latitude,
+		Longitude:    z.Longitude,
+		RadiusMeters: z.RadiusMeters,
+		CreatedAt:    z.CreatedAt,
+	}
+}
+
+// ToPrivacyZoneListResponse はプライバシーゾーンの一覧をレスポンスに変換する
+func ToPrivacyZoneListResponse(zones []*privacy.Zone) PrivacyZoneListResponse {
+	responses := make([]PrivacyZoneResponse, len(zones))
+	for i, z := range zones {
+		responses[i] = ToPrivacyZoneResponse(z)
+	}
+	return PrivacyZoneListResponse{Zones: responses}
+}
@@ -0,0 +1,77 @@
+package presenter
+
+import (
+	"time"
+
+	recordusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/record"
+)
+
+// LongestWalkResponse は最長距離の散歩のレスポンス
+type LongestWalkResponse struct {
+	WalkID   *string `json:"walk_id"` // 散歩が削除された場合はnull
+	Distance float64 `json:"distance"`
+}
+
+// FastestKmResponse は1km最速ペースのレスポンス
+type FastestKmResponse struct {
+	WalkID *string `json:"walk_id"` // 散歩が削除された場合はnull
+	Pace   float64 `json:"pace"`
+}
+
+// MostStepsResponse は1日の最多歩数のレスポンス
+type MostStepsResponse struct {
+	Date  string `json:"date"`
+	Steps int    `json:"steps"`
+}
+
+// RecordsResponse は連続記録・自己ベストAPIのレスポンス
+// 未記録の自己ベストはnull
+type RecordsResponse struct {
+	TimeZone       string               `json:"time_zone"`
+	CurrentStreak  int                  `json:"current_streak"`
+	LongestStreak  int                  `json:"longest_streak"`
+	LastWalkDate   *string              `json:"last_walk_date"`
+	LongestWalk    *LongestWalkResponse `json:"longest_walk"`
+	FastestKm      *FastestKmResponse   `json:"fastest_km"`
+	MostStepsInDay *MostStepsResponse   `json:"most_steps_in_day"`
+	UpdatedAt      time.Time            `json:"updated_at"`
+}
+
+// ToRecordsResponse は連続記録・自己ベストの取得結果をレスポンスに変換する
+func ToRecordsResponse(result *recordusecase.RecordsResult) RecordsResponse {
+	pr := result.Records
+
+	res := RecordsResponse{
+		TimeZone:      result.TimeZone.String(),
+		CurrentStreak: result.CurrentStreak,
+		LongestStreak: pr.LongestStreak,
+		UpdatedAt:     pr.UpdatedAt,
+	}
+
+	if pr.LastWalkDate != nil {
+		d := pr.LastWalkDate.Format(time.DateOnly)
+		res.LastWalkDate = &d
+	}
+	if pr.LongestWalkDistance > 0 {
+		res.LongestWalk = &LongestWalkResponse{Distance: pr.LongestWalkDistance}
+		if pr.LongestWalkID != nil {
+			id := pr.LongestWalkID.String()
+			res.LongestWalk.WalkID = &id
+		}
+	}
+	if pr.FastestKmPace != nil {
+		res.FastestKm = &FastestKmResponse{Pace: *pr.FastestKmPace}
+		if pr.FastestKmWalkID != nil {
+			id := pr.FastestKmWalkID.String()
+			res.FastestKm.WalkID = &id
+		}
+	}
+	if pr.MostStepsDate != nil {
+		res.MostStepsInDay = &MostStepsResponse{
+			Date:  pr.MostStepsDate.Format(time.DateOnly),
+			Steps: pr.MostStepsInDay,
+		}
+	}
+
+	return res
+}
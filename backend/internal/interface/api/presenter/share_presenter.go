@@ -0,0 +1,54 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	shareusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/share"
+	"github.com/google/uuid"
+)
+
+// ShareLinkResponse は共有リンクのレスポンス
+type ShareLinkResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	WalkID    uuid.UUID  `json:"walk_id"`
+	Token     string     `json:"token"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// ShareLinkListResponse は共有リンク一覧APIのレスポンス
+type ShareLinkListResponse struct {
+	Links []ShareLinkResponse `json:"links"`
+}
+
+// ToShareLinkResponse は共有リンクをレスポンスに変換する
+func ToShareLinkResponse(s *shareusecase.SharedLink) ShareLinkResponse {
+	return ShareLinkResponse{
+		ID:        s.Link.ID,
+		WalkID:    s.Link.WalkID,
+		Token:     s.Token,
+		ExpiresAt: s.Link.ExpiresAt,
+		CreatedAt: s.Link.CreatedAt,
+	}
+}
+
+// ToShareLinkListResponse は共有リンクの一覧をレスポンスに変換する
+func ToShareLinkListResponse(links []*shareusecase.SharedLink) ShareLinkListResponse {
+	responses := make([]ShareLinkResponse, len(links))
+	for i, s := range links {
+		responses[i] = ToShareLinkResponse(s)
+	}
+	return ShareLinkListResponse{Links: responses}
+}
+
+// ToSharedWalkDetailResponse は共有された散歩を認証なしの閲覧者向けのレスポンスに変換する
+// 所有者を特定できる情報と、所有者の整理用の情報（ユーザーID・公開範囲・タグ）は含めない
+// 写真は認証ユーザー向けのURLのため含めない
+func ToSharedWalkDetailResponse(w *walk.Walk, locations []*walk.WalkLocation) WalkDetailResponse {
+	response := ToWalkDetailResponse(w, locations, nil)
+	response.UserID = ""
+	response.Visibility = ""
+	response.Tags = []string{}
+	return response
+}
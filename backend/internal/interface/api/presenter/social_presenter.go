@@ -0,0 +1,63 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/social"
+)
+
+// ConnectionResponse は相手ユーザーとの関係のレスポンス
+type ConnectionResponse struct {
+	UserID      string    `json:"user_id"`
+	DisplayName string    `json:"display_name"`
+	Since       time.Time `json:"since"`
+}
+
+// FriendListResponse は友達一覧APIのレスポンス
+type FriendListResponse struct {
+	Friends []ConnectionResponse `json:"friends"`
+}
+
+// FollowRequestListResponse はフォローリクエスト一覧APIのレスポンス
+type FollowRequestListResponse struct {
+	Direction string               `json:"direction"`
+	Requests  []ConnectionResponse `json:"requests"`
+}
+
+// BlockListResponse はブロック一覧APIのレスポンス
+type BlockListResponse struct {
+	Blocks []ConnectionResponse `json:"blocks"`
+}
+
+// FollowResponse はフォロー関係のレスポンス
+type FollowResponse struct {
+	FollowerID string    `json:"follower_id"`
+	FolloweeID string    `json:"followee_id"`
+	Status     string    `json:"status"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// ToConnectionResponses は相手ユーザーとの関係の一覧をレスポンスに変換する
+func ToConnectionResponses(connections []*social.Connection) []ConnectionResponse {
+	responses := make([]ConnectionResponse, len(connections))
+	for i, c := range connections {
+		responses[i] = ConnectionResponse{
+			UserID:      c.UserID,
+			DisplayName: c.DisplayName,
+			Since:       c.Since,
+		}
+	}
+	return responses
+}
+
+// ToFollowResponse はフォロー関係をレスポンスに変換する
+func ToFollowResponse(f *social.Follow) FollowResponse {
+	return FollowResponse{
+		FollowerID: f.FollowerID,
+		FolloweeID: f.FolloweeID,
+		Status:     string(f.Status),
+		CreatedAt:  f.CreatedAt,
+		UpdatedAt:  f.UpdatedAt,
+	}
+}
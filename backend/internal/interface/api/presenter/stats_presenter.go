@@ -0,0 +1,72 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/stats"
+	statsusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/stats"
+)
+
+// StatsBucketResponse は1期間分の散歩統計のレスポンス
+type StatsBucketResponse struct {
+	PeriodStart   time.Time `json:"period_start"`
+	WalkCount     int       `json:"walk_count"`
+	TotalDistance float64   `json:"total_distance"`
+	TotalSteps    int       `json:"total_steps"`
+	MovingTime    float64   `json:"moving_time"`
+	AveragePace   float64   `json:"average_pace"`
+}
+
+// StatsTotalResponse は全期間の散歩統計のレスポンス
+type StatsTotalResponse struct {
+	WalkCount     int     `json:"walk_count"`
+	TotalDistance float64 `json:"total_distance"`
+	TotalSteps    int     `json:"total_steps"`
+	MovingTime    float64 `json:"moving_time"`
+	AveragePace   float64 `json:"average_pace"`
+}
+
+// StatsResponse は散歩統計APIのレスポンス
+type StatsResponse struct {
+	Granularity string                `json:"granularity"`
+	TimeZone    string                `json:"time_zone"`
+	From        time.Time             `json:"from"`
+	To          time.Time             `json:"to"`
+	Buckets     []StatsBucketResponse `json:"buckets"`
+	Total       StatsTotalResponse    `json:"total"`
+}
+
+// ToStatsResponse は散歩統計の結果をレスポンスに変換する
+func ToStatsResponse(result *statsusecase.StatsResult) StatsResponse {
+	buckets := make([]StatsBucketResponse, len(result.Buckets))
+	for i, b := range result.Buckets {
+		buckets[i] = StatsBucketResponse{
+			PeriodStart:   b.PeriodStart,
+			WalkCount:     b.WalkCount,
+			TotalDistance: b.TotalDistance,
+			TotalSteps:    b.TotalSteps,
+			MovingTime:    b.MovingTime,
+			AveragePace:   b.AveragePace,
+		}
+	}
+
+	return StatsResponse{
+		Granularity: string(result.Granularity),
+		TimeZone:    result.From.Location().String(),
+		From:        result.From,
+		To:          result.To,
+		Buckets:     buckets,
+		Total:       toStatsTotalResponse(result.Total),
+	}
+}
+
+// toStatsTotalResponse は全期間の合計値をレスポンスに変換する
+func toStatsTotalResponse(total stats.Bucket) StatsTotalResponse {
+	return StatsTotalResponse{
+		WalkCount:     total.WalkCount,
+		TotalDistance: total.TotalDistance,
+		TotalSteps:    total.TotalSteps,
+		MovingTime:    total.MovingTime,
+		AveragePace:   total.AveragePace,
+	}
+}
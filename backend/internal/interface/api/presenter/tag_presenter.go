@@ -0,0 +1,42 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/tag"
+	"github.com/google/uuid"
+)
+
+// TagResponse はタグのレスポンス
+type TagResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	WalkCount int       `json:"walk_count"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TagListResponse はタグ一覧APIのレスポンス
+type TagListResponse struct {
+	Tags []TagResponse `json:"tags"`
+}
+
+// ToTagResponse はタグをレスポンスに変換する
+func ToTagResponse(t *tag.Tag) TagResponse {
+	return TagResponse{
+		ID:        t.ID,
+		Name:      t.Name,
+		WalkCount: t.WalkCount,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+// ToTagListResponse はタグの一覧をレスポンスに変換する
+func ToTagListResponse(tags []*tag.Tag) TagListResponse {
+	responses := make([]TagResponse, len(tags))
+	for i, t := range tags {
+		responses[i] = ToTagResponse(t)
+	}
+	return TagListResponse{Tags: responses}
+}
@@ -1,8 +1,10 @@
 package presenter
 
 import (
+	"strconv"
 	"time"
 
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/photo"
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
 	"github.com/google/uuid"
 )
@@ -23,7 +25,7 @@ type LocationResponse struct {
 // WalkResponse は散歩APIのレスポンス
 type WalkResponse struct {
 	ID                  uuid.UUID  `json:"id"`
-	UserID              string     `json:"user_id"`
+	UserID              string     `json:"user_id,omitempty"` // 共有リンク経由では含めない
 	Title               string     `json:"title"`
 	Description         string     `json:"description"`
 	StartTime           *time.Time `json:"start_time"`
@@ -33,24 +35,38 @@ type WalkResponse struct {
 	PolylineData        *string    `json:"polyline_data,omitempty"`
 	ThumbnailImageURL   *string    `json:"thumbnail_image_url,omitempty"`
 	Status              string     `json:"status"`
+	Visibility          string     `json:"visibility,omitempty"` // 共有リンク経由では含めない
 	PausedAt            *time.Time `json:"paused_at"`
 	TotalPausedDuration float64    `json:"total_paused_duration"`
+	MovingTime          float64    `json:"moving_time"`
+	AveragePace         float64    `json:"average_pace"`
+	MaxSpeed            float64    `json:"max_speed"`
+	Tags                []string   `json:"tags"`
 	CreatedAt           time.Time  `json:"created_at"`
 	UpdatedAt           time.Time  `json:"updated_at"`
 }
 
-// WalkDetailResponse は散歩詳細APIのレスポンス（位置情報を含む）
+// WalkDetailResponse は散歩詳細APIのレスポンス（位置情報と写真を含む）
 type WalkDetailResponse struct {
 	WalkResponse
 	Locations []LocationResponse `json:"locations"`
+	Photos    []PhotoResponse    `json:"photos"`
 }
 
 // WalkListResponse は散歩一覧のレスポンス
+// ページ番号方式では page と total_count、カーソル方式では next_cursor（次ページがある場合のみ）を返す
+// カーソル方式の total_count は include_total=true を指定した場合のみ返す
 type WalkListResponse struct {
 	Walks      []WalkResponse `json:"walks"`
-	TotalCount int            `json:"total_count"`
-	Page       int            `json:"page"`
+	TotalCount *int           `json:"total_count,omitempty"`
+	Page       int            `json:"page,omitempty"`
 	Limit      int            `json:"limit"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+// WalkETag は散歩の版数を ETag ヘッダーの値（強いエンティティタグ）に変換する
+func WalkETag(w *walk.Walk) string {
+	return strconv.Quote(strconv.Itoa(w.Version))
 }
 
 // ToWalkResponse はドメインエンティティをレスポンスに変換する
@@ -67,13 +83,26 @@ func ToWalkResponse(w *walk.Walk) WalkResponse {
 		PolylineData:        w.PolylineData,
 		ThumbnailImageURL:   w.ThumbnailImageURL,
 		Status:              string(w.Status),
+		Visibility:          string(w.Visibility),
 		PausedAt:            w.PausedAt,
 		TotalPausedDuration: w.TotalPausedDuration,
+		MovingTime:          w.MovingTime,
+		AveragePace:         w.AveragePace,
+		MaxSpeed:            w.MaxSpeed,
+		Tags:                toTagNames(w.Tags),
 		CreatedAt:           w.CreatedAt,
 		UpdatedAt:           w.UpdatedAt,
 	}
 }
 
+// toTagNames はタグ名の一覧を返す（タグがない場合もnullではなく空配列にする）
+func toTagNames(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
 // ToWalkListResponse はドメインエンティティリストをレスポンスに変換する
 func ToWalkListResponse(walks []*walk.Walk, totalCount, page, limit int) WalkListResponse {
 	responses := make([]WalkResponse, len(walks))
@@ -83,12 +112,28 @@ func ToWalkListResponse(walks []*walk.Walk, totalCount, page, limit int) WalkLis
 
 	return WalkListResponse{
 		Walks:      responses,
-		TotalCount: totalCount,
+		TotalCount: &totalCount,
 		Page:       page,
 		Limit:      limit,
 	}
 }
 
+// ToWalkCursorListResponse はカーソル方式の散歩一覧をレスポンスに変換する
+// nextCursor はエンコード済みのカーソル（次ページがない場合は空文字）
+func ToWalkCursorListResponse(walks []*walk.Walk, totalCount *int, limit int, nextCursor string) WalkListResponse {
+	responses := make([]WalkResponse, len(walks))
+	for i, w := range walks {
+		responses[i] = ToWalkResponse(w)
+	}
+
+	return WalkListResponse{
+		Walks:      responses,
+		TotalCount: totalCount,
+		Limit:      limit,
+		NextCursor: nextCursor,
+	}
+}
+
 // ToLocationResponse は位置情報をレスポンスに変換する
 func ToLocationResponse(loc *walk.WalkLocation) LocationResponse {
 	return LocationResponse{
@@ -104,8 +149,8 @@ func ToLocationResponse(loc *walk.WalkLocation) LocationResponse {
 	}
 }
 
-// ToWalkDetailResponse はWalkと位置情報をレスポンスに変換する
-func ToWalkDetailResponse(w *walk.Walk, locations []*walk.WalkLocation) WalkDetailResponse {
+// ToWalkDetailResponse はWalkと位置情報・写真をレスポンスに変換する
+func ToWalkDetailResponse(w *walk.Walk, locations []*walk.WalkLocation, photos []*photo.Photo) WalkDetailResponse {
 	locationResponses := make([]LocationResponse, len(locations))
 	for i, loc := range locations {
 		locationResponses[i] = ToLocationResponse(loc)
@@ -114,5 +159,6 @@ func ToWalkDetailResponse(w *walk.Walk, locations []*walk.WalkLocation) WalkDeta
 	return WalkDetailResponse{
 		WalkResponse: ToWalkResponse(w),
 		Locations:    locationResponses,
+		Photos:       toPhotoResponses(photos),
 	}
 }
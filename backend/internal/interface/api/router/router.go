@@ -61,8 +61,17 @@ func NewRouter(container *di.Container) *gin.Engine {
 		})
 	})
 
-	// Walk API エンドポイント（認証必須）
+	// API エンドポイント（認証必須）
 	walkHandler := handler.NewWalkHandler(container)
+	statsHandler := handler.NewStatsHandler(container)
+	recordHandler := handler.NewRecordHandler(container)
+	achievementHandler := handler.NewAchievementHandler(container)
+	socialHandler := handler.NewSocialHandler(container)
+	tagHandler := handler.NewTagHandler(container)
+	collectionHandler := handler.NewCollectionHandler(container)
+	shareHandler := handler.NewShareHandler(container)
+	privacyHandler := handler.NewPrivacyHandler(container)
+	photoHandler := handler.NewPhotoHandler(container)
 	v1 := r.Group("/v1")
 	{
 		// 認証が必要なエンドポイント
@@ -71,10 +80,83 @@ func NewRouter(container *di.Container) *gin.Engine {
 		{
 			walks.GET("", walkHandler.ListWalks)
 			walks.POST("", walkHandler.CreateWalk)
+			walks.POST("/import", walkHandler.ImportWalk)
 			walks.GET("/:id", walkHandler.GetWalk)
 			walks.PUT("/:id", walkHandler.UpdateWalk)
 			walks.DELETE("/:id", walkHandler.DeleteWalk)
+			walks.POST("/:id/start", walkHandler.StartWalk)
+			walks.POST("/:id/pause", walkHandler.PauseWalk)
+			walks.POST("/:id/resume", walkHandler.ResumeWalk)
+			walks.POST("/:id/complete", walkHandler.CompleteWalk)
+			walks.GET("/:id/export.gpx", walkHandler.ExportWalkGPX)
+			walks.POST("/:id/share", shareHandler.CreateShareLink)
+			walks.GET("/:id/share", shareHandler.ListShareLinks)
+			walks.DELETE("/:id/share/:linkId", shareHandler.RevokeShareLink)
+			walks.POST("/:id/photos", photoHandler.UploadPhoto)
+			walks.DELETE("/:id/photos/:photoId", photoHandler.DeletePhoto)
 		}
+
+		// 共有リンクで公開された散歩（認証不要）
+		v1.GET("/shared/:token", shareHandler.GetSharedWalk)
+
+		// 認証ユーザー自身のリソース
+		me := v1.Group("/users/me")
+		me.Use(container.AuthMiddleware.Handler())
+		{
+			me.GET("/stats", statsHandler.GetMyStats)
+			me.GET("/records", recordHandler.GetMyRecords)
+			me.GET("/achievements", achievementHandler.GetMyAchievements)
+			me.GET("/privacy-zones", privacyHandler.ListPrivacyZones)
+			me.POST("/privacy-zones", privacyHandler.CreatePrivacyZone)
+			me.DELETE("/privacy-zones/:id", privacyHandler.DeletePrivacyZone)
+		}
+
+		// フォロー・友達・ブロック
+		friends := v1.Group("/friends")
+		friends.Use(container.AuthMiddleware.Handler())
+		{
+			friends.GET("", socialHandler.ListFriends)
+			friends.GET("/requests", socialHandler.ListFollowRequests)
+			friends.POST("/requests", socialHandler.SendFollowRequest)
+			friends.POST("/requests/:userId/accept", socialHandler.AcceptFollowRequest)
+			friends.POST("/requests/:userId/reject", socialHandler.RejectFollowRequest)
+			friends.GET("/blocks", socialHandler.ListBlockedUsers)
+			friends.POST("/blocks", socialHandler.BlockUser)
+			friends.DELETE("/blocks/:userId", socialHandler.UnblockUser)
+			friends.GET("/:userId/mutual", socialHandler.ListMutualFriends)
+			friends.DELETE("/:userId", socialHandler.RemoveFriend)
+		}
+
+		// タグ（散歩へのタグ付けは PUT /v1/walks/:id で行う）
+		tags := v1.Group("/tags")
+		tags.Use(container.AuthMiddleware.Handler())
+		{
+			tags.GET("", tagHandler.ListTags)
+			tags.POST("", tagHandler.CreateTag)
+			tags.PUT("/:id", tagHandler.RenameTag)
+			tags.DELETE("/:id", tagHandler.DeleteTag)
+		}
+
+		// コレクション
+		collections := v1.Group("/collections")
+		collections.Use(container.AuthMiddleware.Handler())
+		{
+			collections.GET("", collectionHandler.ListCollections)
+			collections.POST("", collectionHandler.CreateCollection)
+			collections.GET("/:id", collectionHandler.GetCollection)
+			collections.PUT("/:id", collectionHandler.UpdateCollection)
+			collections.DELETE("/:id", collectionHandler.DeleteCollection)
+			collections.POST("/:id/walks", collectionHandler.AddWalk)
+			collections.DELETE("/:id/walks/:walkId", collectionHandler.RemoveWalk)
+		}
+	}
+
+	// ローカルストレージのファイル配信（開発環境のみ、認証必須）
+	if container.LocalStorage != nil && container.Config.IsDevelopment() {
+		fileHandler := handler.NewFileHandler(container)
+		files := r.Group("/files")
+		files.Use(container.AuthMiddleware.Handler())
+		files.GET("/*path", fileHandler.ServeFile)
 	}
 
 	// TODO: 後のフェーズで実装
@@ -5,15 +5,22 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"firebase.google.com/go/v4/auth"
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/di"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/share"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/config"
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/database"
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/logger"
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/api/middleware"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/interface/persistence/storage"
+	shareusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/share"
+	walkusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/walk"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // mockAuthClient はテスト用のFirebase Auth Client
@@ -156,6 +163,162 @@ func TestRouter_WalkEndpoints_RouteExists(t *testing.T) {
 			path:           "/v1/walks/invalid-uuid",
 			expectedStatus: http.StatusBadRequest, // UUID検証エラー
 		},
+		{
+			name:           "POST /v1/walks/:id/start",
+			method:         http.MethodPost,
+			path:           "/v1/walks/invalid-uuid/start",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "POST /v1/walks/:id/pause",
+			method:         http.MethodPost,
+			path:           "/v1/walks/invalid-uuid/pause",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "POST /v1/walks/:id/resume",
+			method:         http.MethodPost,
+			path:           "/v1/walks/invalid-uuid/resume",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "POST /v1/walks/:id/complete",
+			method:         http.MethodPost,
+			path:           "/v1/walks/invalid-uuid/complete",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "POST /v1/walks/import",
+			method:         http.MethodPost,
+			path:           "/v1/walks/import",
+			expectedStatus: http.StatusBadRequest, // ファイル未指定
+		},
+		{
+			name:           "POST /v1/walks/:id/share",
+			method:         http.MethodPost,
+			path:           "/v1/walks/invalid-uuid/share",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "GET /v1/walks/:id/share",
+			method:         http.MethodGet,
+			path:           "/v1/walks/invalid-uuid/share",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "DELETE /v1/walks/:id/share/:linkId",
+			method:         http.MethodDelete,
+			path:           "/v1/walks/invalid-uuid/share/invalid-uuid",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "GET /v1/users/me/stats",
+			method:         http.MethodGet,
+			path:           "/v1/users/me/stats?tz=Invalid/Zone",
+			expectedStatus: http.StatusBadRequest, // タイムゾーン検証エラー
+		},
+		{
+			name:           "GET /v1/friends/requests",
+			method:         http.MethodGet,
+			path:           "/v1/friends/requests?direction=sideways",
+			expectedStatus: http.StatusBadRequest, // direction検証エラー
+		},
+		{
+			name:           "POST /v1/friends/requests",
+			method:         http.MethodPost,
+			path:           "/v1/friends/requests",
+			expectedStatus: http.StatusBadRequest, // bodyなしでエラー
+		},
+		{
+			name:           "POST /v1/friends/blocks",
+			method:         http.MethodPost,
+			path:           "/v1/friends/blocks",
+			expectedStatus: http.StatusBadRequest, // bodyなしでエラー
+		},
+		{
+			name:           "POST /v1/walks/:id/photos",
+			method:         http.MethodPost,
+			path:           "/v1/walks/invalid-uuid/photos",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "DELETE /v1/walks/:id/photos/:photoId",
+			method:         http.MethodDelete,
+			path:           "/v1/walks/invalid-uuid/photos/invalid-uuid",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "POST /v1/users/me/privacy-zones",
+			method:         http.MethodPost,
+			path:           "/v1/users/me/privacy-zones",
+			expectedStatus: http.StatusBadRequest, // bodyなしでエラー
+		},
+		{
+			name:           "DELETE /v1/users/me/privacy-zones/:id",
+			method:         http.MethodDelete,
+			path:           "/v1/users/me/privacy-zones/invalid-uuid",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "POST /v1/tags",
+			method:         http.MethodPost,
+			path:           "/v1/tags",
+			expectedStatus: http.StatusBadRequest, // bodyなしでエラー
+		},
+		{
+			name:           "PUT /v1/tags/:id",
+			method:         http.MethodPut,
+			path:           "/v1/tags/invalid-uuid",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "DELETE /v1/tags/:id",
+			method:         http.MethodDelete,
+			path:           "/v1/tags/invalid-uuid",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "POST /v1/collections",
+			method:         http.MethodPost,
+			path:           "/v1/collections",
+			expectedStatus: http.StatusBadRequest, // bodyなしでエラー
+		},
+		{
+			name:           "GET /v1/collections/:id",
+			method:         http.MethodGet,
+			path:           "/v1/collections/invalid-uuid",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "PUT /v1/collections/:id",
+			method:         http.MethodPut,
+			path:           "/v1/collections/invalid-uuid",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "DELETE /v1/collections/:id",
+			method:         http.MethodDelete,
+			path:           "/v1/collections/invalid-uuid",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "POST /v1/collections/:id/walks",
+			method:         http.MethodPost,
+			path:           "/v1/collections/invalid-uuid/walks",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "DELETE /v1/collections/:id/walks/:walkId",
+			method:         http.MethodDelete,
+			path:           "/v1/collections/invalid-uuid/walks/invalid-uuid",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
+		{
+			name:           "GET /v1/walks/:id/export.gpx",
+			method:         http.MethodGet,
+			path:           "/v1/walks/invalid-uuid/export.gpx",
+			expectedStatus: http.StatusBadRequest, // UUID検証エラー
+		},
 	}
 
 	for _, tt := range tests {
@@ -172,6 +335,74 @@ func TestRouter_WalkEndpoints_RouteExists(t *testing.T) {
 	}
 }
 
+// stubShareUsecase は共有リンクが常に見つからないテスト用のUsecase
+type stubShareUsecase struct {
+	shareusecase.Usecase
+}
+
+func (stubShareUsecase) GetSharedWalk(_ context.Context, _ string) (*walkusecase.WalkWithLocations, error) {
+	return nil, share.ErrLinkNotFound
+}
+
+func TestRouter_SharedWalk_NoAuth(t *testing.T) {
+	// 期待値: 共有リンクの閲覧は認証なしでハンドラーまで到達する
+	gin.SetMode(gin.TestMode)
+	testLogger, _ := logger.NewLogger("error", "console")
+	router := NewRouter(&di.Container{
+		DB:             &database.PostgresDB{},
+		Logger:         testLogger,
+		AuthMiddleware: middleware.NewAuthMiddlewareWithClient(&mockAuthClient{}, nil),
+		ShareUsecase:   stubShareUsecase{},
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/shared/some-token", nil)
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "Share link not found")
+}
+
+func TestRouter_LocalFiles(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	testLogger, _ := logger.NewLogger("error", "console")
+	localStorage, err := storage.NewLocalStorage(t.TempDir(), "http://localhost:8080")
+	require.NoError(t, err)
+	_, err = localStorage.Upload(context.Background(), "walk_photos/u/w/p.png", strings.NewReader("png-bytes"), "image/png")
+	require.NoError(t, err)
+
+	newRouter := func(environment string) *gin.Engine {
+		return NewRouter(&di.Container{
+			Config:         &config.Config{Environment: environment},
+			DB:             &database.PostgresDB{},
+			Logger:         testLogger,
+			AuthMiddleware: middleware.NewAuthMiddlewareWithClient(&mockAuthClient{}, nil),
+			LocalStorage:   localStorage,
+		})
+	}
+	serve := func(router *gin.Engine, withAuth bool) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/files/walk_photos/u/w/p.png", nil)
+		if withAuth {
+			req.Header.Set("Authorization", "Bearer test-token")
+		}
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// 期待値: 開発環境では認証済みのリクエストにファイルを返す
+	w := serve(newRouter("development"), true)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "png-bytes", w.Body.String())
+
+	// 期待値: 認証なしでは配信しない
+	assert.Equal(t, http.StatusUnauthorized, serve(newRouter("development"), false).Code)
+
+	// 期待値: 開発環境以外ではルート自体がない
+	assert.Equal(t, http.StatusNotFound, serve(newRouter("staging"), true).Code)
+}
+
 func TestRouter_NotFoundEndpoint(t *testing.T) {
 	// 期待値: 存在しないパスで404 Not Foundを返す
 	router := setupTestRouter()
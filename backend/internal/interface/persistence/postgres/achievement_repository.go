@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/achievement"
+	"github.com/google/uuid"
+)
+
+// AchievementRepository はPostgreSQLを使用した実績リポジトリ実装
+type AchievementRepository struct {
+	db *sql.DB
+}
+
+// NewAchievementRepository は新しいAchievementRepositoryを生成する
+func NewAchievementRepository(db *sql.DB) achievement.Repository {
+	return &AchievementRepository{
+		db: db,
+	}
+}
+
+// FindByUserID はユーザーが獲得した実績を獲得日時の昇順で返す
+func (r *AchievementRepository) FindByUserID(ctx context.Context, userID string) ([]*achievement.Achievement, error) {
+	query := `
+		SELECT user_id, code, walk_id, awarded_at
+		FROM user_achievements
+		WHERE user_id = $1
+		ORDER BY awarded_at, code
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	achievements := make([]*achievement.Achievement, 0)
+	for rows.Next() {
+		a := &achievement.Achievement{}
+		var walkID uuid.NullUUID
+		if err = rows.Scan(&a.UserID, &a.Code, &walkID, &a.AwardedAt); err != nil {
+			return nil, err
+		}
+		if walkID.Valid {
+			a.WalkID = &walkID.UUID
+		}
+		achievements = append(achievements, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return achievements, nil
+}
+
+// Award は実績を付与する
+// 主キー (user_id, code) の競合時は何もしないため、同じ散歩の再送や同時実行でも二重に付与されない
+func (r *AchievementRepository) Award(ctx context.Context, a *achievement.Achievement) (bool, error) {
+	query := `
+		INSERT INTO user_achievements (user_id, code, walk_id, awarded_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, code) DO NOTHING
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, a.UserID, a.Code, a.WalkID, a.AwardedAt)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/achievement"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAchievementRepository_Award(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	walkRepo := NewWalkRepository(db)
+	repo := NewAchievementRepository(db)
+	ctx := context.Background()
+
+	createTestUser(t, db, "user-123")
+	w := walk.NewWalk("user-123", "Walk", "")
+	require.NoError(t, walkRepo.Create(ctx, w))
+
+	// 期待値: 初回は付与される
+	awarded, err := repo.Award(ctx, achievement.NewAchievement("user-123", achievement.CodeFirstWalk, w.ID))
+	require.NoError(t, err)
+	assert.True(t, awarded)
+
+	// 期待値: 同じ実績の2回目は付与されない
+	awarded, err = repo.Award(ctx, achievement.NewAchievement("user-123", achievement.CodeFirstWalk, w.ID))
+	require.NoError(t, err)
+	assert.False(t, awarded)
+
+	found, err := repo.FindByUserID(ctx, "user-123")
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, achievement.CodeFirstWalk, found[0].Code)
+	assert.Equal(t, w.ID, *found[0].WalkID)
+}
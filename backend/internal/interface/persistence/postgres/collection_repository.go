@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/collection"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// collectionSelectColumns はコレクションを取得する際のカラム
+// 含まれる散歩のIDは並び順の配列として同じ行で取得する
+const collectionSelectColumns = `c.id, c.user_id, c.name, c.description, c.created_at, c.updated_at,
+		       ARRAY(
+		         SELECT cw.walk_id::text FROM collection_walks cw
+		         WHERE cw.collection_id = c.id
+		         ORDER BY cw.position
+		       ) AS walk_ids`
+
+// CollectionRepository はPostgreSQLを使用したコレクションのリポジトリ実装
+type CollectionRepository struct {
+	db *sql.DB
+}
+
+// NewCollectionRepository は新しいCollectionRepositoryを生成する
+func NewCollectionRepository(db *sql.DB) collection.Repository {
+	return &CollectionRepository{
+		db: db,
+	}
+}
+
+// ListByUserID はユーザーのコレクションを作成日時の降順で取得する
+func (r *CollectionRepository) ListByUserID(ctx context.Context, userID string) ([]*collection.Collection, error) {
+	query := `
+		SELECT ` + collectionSelectColumns + `
+		FROM collections c
+		WHERE c.user_id = $1
+		ORDER BY c.created_at DESC, c.id DESC
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	collections := make([]*collection.Collection, 0)
+	for rows.Next() {
+		c, err := scanCollection(rows)
+		if err != nil {
+			return nil, err
+		}
+		collections = append(collections, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return collections, nil
+}
+
+// FindByID はIDでコレクションを取得する
+func (r *CollectionRepository) FindByID(ctx context.Context, id uuid.UUID) (*collection.Collection, error) {
+	query := `
+		SELECT ` + collectionSelectColumns + `
+		FROM collections c
+		WHERE c.id = $1
+	`
+
+	return scanCollection(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+}
+
+// Create はコレクションと含まれる散歩を同一トランザクションで保存する
+func (r *CollectionRepository) Create(ctx context.Context, c *collection.Collection) error {
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO collections (id, user_id, name, description, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`
+		if _, err := tx.ExecContext(ctx, query, c.ID, c.UserID, c.Name, c.Description, c.CreatedAt, c.UpdatedAt); err != nil {
+			return err
+		}
+
+		return insertCollectionWalks(ctx, tx, c)
+	})
+}
+
+// Update はコレクションを更新し、含まれる散歩と並び順を置き換える
+func (r *CollectionRepository) Update(ctx context.Context, c *collection.Collection) error {
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		query := `UPDATE collections SET name = $2, description = $3, updated_at = $4 WHERE id = $1`
+		result, err := tx.ExecContext(ctx, query, c.ID, c.Name, c.Description, c.UpdatedAt)
+		if err != nil {
+			return err
+		}
+
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return sql.ErrNoRows
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM collection_walks WHERE collection_id = $1`, c.ID); err != nil {
+			return err
+		}
+		return insertCollectionWalks(ctx, tx, c)
+	})
+}
+
+// Delete はコレクションを削除する（collection_walks は外部キーのカスケードで削除される）
+func (r *CollectionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM collections WHERE id = $1`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// CountOwnedWalks は指定した散歩のうちユーザーが所有するものの数を返す
+func (r *CollectionRepository) CountOwnedWalks(ctx context.Context, userID string, walkIDs []uuid.UUID) (int, error) {
+	if len(walkIDs) == 0 {
+		return 0, nil
+	}
+
+	query := `SELECT COUNT(*) FROM walks WHERE user_id = $1 AND id = ANY($2::uuid[])`
+
+	var count int
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query, userID, pq.Array(uuidStrings(walkIDs))).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// insertCollectionWalks はコレクション内の散歩をWalkIDsの順序をpositionとして一括挿入する
+// 件数は collection.MaxWalks で制限しているため、1文で挿入する
+func insertCollectionWalks(ctx context.Context, tx *sql.Tx, c *collection.Collection) error {
+	if len(c.WalkIDs) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(c.WalkIDs))
+	args := make([]interface{}, 0, len(c.WalkIDs)+1)
+	args = append(args, c.ID)
+	for i, walkID := range c.WalkIDs {
+		args = append(args, walkID)
+		placeholders[i] = fmt.Sprintf("($1, $%d, %d)", len(args), i)
+	}
+
+	query := `INSERT INTO collection_walks (collection_id, walk_id, position) VALUES ` +
+		strings.Join(placeholders, ", ")
+	_, err := tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+// scanCollection は collectionSelectColumns の1行をCollectionに読み取る
+func scanCollection(row rowScanner) (*collection.Collection, error) {
+	c := &collection.Collection{}
+	var walkIDs []string
+	if err := row.Scan(
+		&c.ID, &c.UserID, &c.Name, &c.Description, &c.CreatedAt, &c.UpdatedAt,
+		pq.Array(&walkIDs),
+	); err != nil {
+		return nil, err
+	}
+
+	c.WalkIDs = make([]uuid.UUID, len(walkIDs))
+	for i, id := range walkIDs {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+		c.WalkIDs[i] = parsed
+	}
+
+	return c, nil
+}
+
+// uuidStrings はUUIDの一覧を文字列の一覧に変換する（pq.Arrayで渡すため）
+func uuidStrings(ids []uuid.UUID) []string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = id.String()
+	}
+	return strs
+}
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/collection"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectionRepository_CRUD(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	walkRepo := NewWalkRepository(db)
+	repo := NewCollectionRepository(db)
+	ctx := context.Background()
+
+	createTestUser(t, db, "user-123")
+	createTestUser(t, db, "user-456")
+
+	first := walk.NewWalk("user-123", "Day 1", "")
+	second := walk.NewWalk("user-123", "Day 2", "")
+	other := walk.NewWalk("user-456", "Other", "")
+	for _, w := range []*walk.Walk{first, second, other} {
+		require.NoError(t, walkRepo.Create(ctx, w))
+	}
+
+	// 期待値: 所有する散歩のみ数えられる
+	count, err := repo.CountOwnedWalks(ctx, "user-123", []uuid.UUID{first.ID, second.ID, other.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	c := collection.NewCollection("user-123", "京都旅行", "")
+	c.SetWalks([]uuid.UUID{second.ID, first.ID})
+	require.NoError(t, repo.Create(ctx, c))
+
+	// 期待値: 並び順が保存される
+	found, err := repo.FindByID(ctx, c.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{second.ID, first.ID}, found.WalkIDs)
+
+	// 期待値: 更新で並び順が置き換わる
+	found.Name = "京都旅行 2025"
+	found.SetWalks([]uuid.UUID{first.ID})
+	require.NoError(t, repo.Update(ctx, found))
+
+	list, err := repo.ListByUserID(ctx, "user-123")
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "京都旅行 2025", list[0].Name)
+	assert.Equal(t, []uuid.UUID{first.ID}, list[0].WalkIDs)
+
+	// 期待値: 散歩を削除するとコレクションからも外れる
+	require.NoError(t, walkRepo.Delete(ctx, first))
+	found, err = repo.FindByID(ctx, c.ID)
+	require.NoError(t, err)
+	assert.Empty(t, found.WalkIDs)
+
+	// 期待値: 削除後は取得できない
+	require.NoError(t, repo.Delete(ctx, c.ID))
+	_, err = repo.FindByID(ctx, c.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/job"
+	"github.com/google/uuid"
+)
+
+// JobRepository はPostgreSQLを使用したジョブキューのリポジトリ実装
+type JobRepository struct {
+	db *sql.DB
+}
+
+// NewJobRepository は新しいJobRepositoryを生成する
+func NewJobRepository(db *sql.DB) job.Repository {
+	return &JobRepository{
+		db: db,
+	}
+}
+
+// jobSelectColumns はジョブ取得時のカラム（scanJob と順序を合わせる）
+const jobSelectColumns = `id, type, payload, status, attempts, max_attempts, run_at, last_error, locked_at, created_at, updated_at`
+
+// scanJob は1行分のジョブを読み込む
+func scanJob(row rowScanner) (*job.Job, error) {
+	j := &job.Job{}
+	err := row.Scan(
+		&j.ID, &j.Type, &j.Payload, &j.Status, &j.Attempts, &j.MaxAttempts,
+		&j.RunAt, &j.LastError, &j.LockedAt, &j.CreatedAt, &j.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// Enqueue はジョブを追加する
+func (r *JobRepository) Enqueue(ctx context.Context, j *job.Job) error {
+	query := `
+		INSERT INTO jobs (` + jobSelectColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query,
+		j.ID, j.Type, j.Payload, j.Status, j.Attempts, j.MaxAttempts,
+		j.RunAt, j.LastError, j.LockedAt, j.CreatedAt, j.UpdatedAt,
+	)
+	return err
+}
+
+// ClaimNext は実行日時を過ぎたジョブを1件取得して処理中にし、試行回数を1増やす
+// FOR UPDATE SKIP LOCKED により、複数のワーカーが同時に取得しても同じジョブを取得することはない
+func (r *JobRepository) ClaimNext(ctx context.Context, lease time.Duration) (*job.Job, error) {
+	query := `
+		UPDATE jobs SET
+			status = 'running',
+			attempts = attempts + 1,
+			locked_at = NOW(),
+			updated_at = NOW()
+		WHERE id = (
+			SELECT id FROM jobs
+			WHERE (status = 'pending' AND run_at <= NOW())
+				OR (status = 'running' AND locked_at < NOW() - make_interval(secs => $1))
+			ORDER BY run_at, created_at
+			FOR UPDATE SKIP LOCKED
+			LIMIT 1
+		)
+		RETURNING ` + jobSelectColumns
+
+	return scanJob(conn(ctx, r.db).QueryRowContext(ctx, query, lease.Seconds()))
+}
+
+// Complete は処理が成功したジョブを削除する
+func (r *JobRepository) Complete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM jobs WHERE id = $1`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+	return err
+}
+
+// SaveFailure は失敗したジョブの状態（再試行日時・デッドレター・失敗の内容）を保存する
+func (r *JobRepository) SaveFailure(ctx context.Context, j *job.Job) error {
+	query := `
+		UPDATE jobs SET
+			status = $2,
+			run_at = $3,
+			last_error = $4,
+			locked_at = $5,
+			updated_at = $6
+		WHERE id = $1
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, j.ID, j.Status, j.RunAt, j.LastError, j.LockedAt, j.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
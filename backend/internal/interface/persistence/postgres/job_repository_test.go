@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/job"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobRepository(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer func() {
+		_, err := db.Exec("TRUNCATE TABLE jobs")
+		require.NoError(t, err)
+	}()
+
+	repo := NewJobRepository(db)
+	ctx := context.Background()
+
+	first := job.NewJob("walk.completed", []byte(`{"walk_id":"a"}`), 2)
+	first.RunAt = time.Now().Add(-time.Minute)
+	require.NoError(t, repo.Enqueue(ctx, first))
+	later := job.NewJob("walk.completed", []byte(`{"walk_id":"b"}`), 2)
+	later.RunAt = time.Now().Add(time.Hour)
+	require.NoError(t, repo.Enqueue(ctx, later))
+
+	// 期待値: 実行日時を過ぎたジョブのみ、処理中にして試行回数を増やした状態で取得できる
+	claimed, err := repo.ClaimNext(ctx, job.LeaseDuration)
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, claimed.ID)
+	assert.Equal(t, job.StatusRunning, claimed.Status)
+	assert.Equal(t, 1, claimed.Attempts)
+	assert.JSONEq(t, `{"walk_id":"a"}`, string(claimed.Payload))
+
+	// 期待値: 処理中のジョブと実行日時前のジョブは取得されない
+	_, err = repo.ClaimNext(ctx, job.LeaseDuration)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	// 期待値: 取得期限を過ぎた処理中のジョブは取得し直せる
+	reclaimed, err := repo.ClaimNext(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, reclaimed.ID)
+	assert.Equal(t, 2, reclaimed.Attempts)
+
+	// 期待値: デッドレターは取得されない
+	reclaimed.Fail(errors.New("boom"), time.Now())
+	require.True(t, reclaimed.IsDead())
+	require.NoError(t, repo.SaveFailure(ctx, reclaimed))
+	_, err = repo.ClaimNext(ctx, 0)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	// 期待値: 再試行待ちのジョブは実行日時を過ぎると取得できる
+	later.RunAt = time.Now().Add(-time.Second)
+	later.Status = job.StatusPending
+	require.NoError(t, repo.SaveFailure(ctx, later))
+	claimed, err = repo.ClaimNext(ctx, job.LeaseDuration)
+	require.NoError(t, err)
+	assert.Equal(t, later.ID, claimed.ID)
+
+	// 期待値: 成功したジョブは削除される
+	require.NoError(t, repo.Complete(ctx, claimed.ID))
+	claimed.Fail(errors.New("boom"), time.Now())
+	assert.ErrorIs(t, repo.SaveFailure(ctx, claimed), sql.ErrNoRows)
+}
+
+func TestJobRepository_RunAtTimeZone(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer func() {
+		_, err := db.Exec("TRUNCATE TABLE jobs")
+		require.NoError(t, err)
+	}()
+
+	repo := NewJobRepository(db)
+	ctx := context.Background()
+
+	// アプリケーションとDBセッションのタイムゾーンが異なる場合を再現する
+	ahead := time.FixedZone("UTC+9", 9*60*60)
+	behind := time.FixedZone("UTC-9", -9*60*60)
+
+	due := job.NewJob("walk.completed", []byte(`{}`), 2)
+	due.RunAt = time.Now().Add(-time.Minute).In(ahead)
+	require.NoError(t, repo.Enqueue(ctx, due))
+	notYet := job.NewJob("walk.completed", []byte(`{}`), 2)
+	notYet.RunAt = time.Now().Add(time.Minute).In(behind)
+	require.NoError(t, repo.Enqueue(ctx, notYet))
+
+	// 期待値: 実行日時はタイムゾーンによらず同じ時刻として比較する
+	claimed, err := repo.ClaimNext(ctx, job.LeaseDuration)
+	require.NoError(t, err)
+	assert.Equal(t, due.ID, claimed.ID)
+	_, err = repo.ClaimNext(ctx, job.LeaseDuration)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/outbox"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+)
+
+// OutboxRepository はPostgreSQLを使用したアウトボックスのリポジトリ実装
+type OutboxRepository struct {
+	db *sql.DB
+}
+
+// NewOutboxRepository は新しいOutboxRepositoryを生成する
+func NewOutboxRepository(db *sql.DB) outbox.Repository {
+	return &OutboxRepository{
+		db: db,
+	}
+}
+
+// outboxSelectColumns はイベント取得時のカラム（scanOutboxMessage と順序を合わせる）
+const outboxSelectColumns = `id, walk_id, user_id, event_name, payload, occurred_at, status, attempts, next_attempt_at, last_error, locked_at, created_at`
+
+// scanOutboxMessage は1行分のイベントを読み込む
+func scanOutboxMessage(row rowScanner) (*outbox.Message, error) {
+	m := &outbox.Message{}
+	err := row.Scan(
+		&m.ID, &m.WalkID, &m.UserID, &m.EventName, &m.Payload, &m.OccurredAt, &m.Status,
+		&m.Attempts, &m.NextAttemptAt, &m.LastError, &m.LockedAt, &m.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// insertOutboxEvents は散歩のドメインイベントをアウトボックスに書き込む
+// 散歩の行と同じトランザクションで呼び出す
+func insertOutboxEvents(ctx context.Context, tx *sql.Tx, events []walk.DomainEvent) error {
+	query := `
+		INSERT INTO outbox_events (walk_id, user_id, event_name, payload, occurred_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	for _, e := range events {
+		payload, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s event: %w", e.EventName(), err)
+		}
+		meta := e.Metadata()
+		if _, err := tx.ExecContext(ctx, query, meta.WalkID, meta.UserID, e.EventName(), payload, meta.OccurredAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClaimNext は配信日時を過ぎたイベントを1件取得し、試行回数を1増やす
+// FOR UPDATE SKIP LOCKED により、複数のディスパッチャーが同時に取得しても同じイベントを取得することはない
+func (r *OutboxRepository) ClaimNext(ctx context.Context, lease time.Duration) (*outbox.Message, error) {
+	query := `
+		UPDATE outbox_events SET
+			attempts = attempts + 1,
+			locked_at = NOW()
+		WHERE id = (
+			SELECT e.id FROM outbox_events e
+			WHERE e.status = 'pending'
+				AND e.next_attempt_at <= NOW()
+				AND (e.locked_at IS NULL OR e.locked_at < NOW() - make_interval(secs => $1))
+				AND NOT EXISTS (
+					SELECT 1 FROM outbox_events prev
+					WHERE prev.walk_id = e.walk_id AND prev.status = 'pending' AND prev.id < e.id
+				)
+			ORDER BY e.id
+			FOR UPDATE SKIP LOCKED
+			LIMIT 1
+		)
+		RETURNING ` + outboxSelectColumns
+
+	return scanOutboxMessage(conn(ctx, r.db).QueryRowContext(ctx, query, lease.Seconds()))
+}
+
+// MarkDispatched は配信に成功したイベントを削除する
+func (r *OutboxRepository) MarkDispatched(ctx context.Context, id int64) error {
+	query := `DELETE FROM outbox_events WHERE id = $1`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+	return err
+}
+
+// SaveFailure は配信に失敗したイベントの状態（再配信日時・失敗の内容）を保存する
+func (r *OutboxRepository) SaveFailure(ctx context.Context, m *outbox.Message) error {
+	query := `
+		UPDATE outbox_events SET
+			status = $2,
+			next_attempt_at = $3,
+			last_error = $4,
+			locked_at = $5
+		WHERE id = $1
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, m.ID, m.Status, m.NextAttemptAt, m.LastError, m.LockedAt)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
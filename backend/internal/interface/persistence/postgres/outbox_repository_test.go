@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/outbox"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxRepository(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	walkRepo := NewWalkRepository(db)
+	repo := NewOutboxRepository(db)
+	ctx := context.Background()
+
+	createTestUser(t, db, "user-123")
+
+	// 期待値: Walkの保存と同時にイベントがアウトボックスに書き込まれ、Walkから取り除かれる
+	w := walk.NewWalk("user-123", "Outbox walk", "")
+	require.NoError(t, w.Start())
+	require.NoError(t, walkRepo.Create(ctx, w))
+	assert.Empty(t, w.PendingEvents())
+
+	other := walk.NewWalk("user-123", "Other walk", "")
+	require.NoError(t, walkRepo.Create(ctx, other))
+
+	// 期待値: 散歩ごとに発生順で取得され、前のイベントの配信中は同じ散歩の後続のイベントは取得されない
+	created, err := repo.ClaimNext(ctx, outbox.LeaseDuration)
+	require.NoError(t, err)
+	assert.Equal(t, walk.EventWalkCreated, created.EventName)
+	assert.Equal(t, w.ID, created.WalkID)
+	assert.Equal(t, 1, created.Attempts)
+
+	otherCreated, err := repo.ClaimNext(ctx, outbox.LeaseDuration)
+	require.NoError(t, err)
+	assert.Equal(t, other.ID, otherCreated.WalkID)
+
+	_, err = repo.ClaimNext(ctx, outbox.LeaseDuration)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	// 期待値: 配信に成功すると同じ散歩の次のイベントが取得できる
+	require.NoError(t, repo.MarkDispatched(ctx, created.ID))
+	started, err := repo.ClaimNext(ctx, outbox.LeaseDuration)
+	require.NoError(t, err)
+	assert.Equal(t, walk.EventWalkStarted, started.EventName)
+
+	decoded, err := walk.DecodeEvent(started.EventName, started.Payload)
+	require.NoError(t, err)
+	assert.Equal(t, w.ID, decoded.Metadata().WalkID)
+
+	// 期待値: 再配信待ちのイベントは配信日時まで取得されない
+	require.NoError(t, repo.MarkDispatched(ctx, otherCreated.ID))
+	started.Fail(errors.New("boom"), time.Now())
+	require.NoError(t, repo.SaveFailure(ctx, started))
+	_, err = repo.ClaimNext(ctx, 0)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	// 期待値: 削除した散歩のイベントも書き込まれる
+	other.MarkDeleted()
+	require.NoError(t, walkRepo.Delete(ctx, other))
+	deleted, err := repo.ClaimNext(ctx, outbox.LeaseDuration)
+	require.NoError(t, err)
+	assert.Equal(t, walk.EventWalkDeleted, deleted.EventName)
+
+	// 期待値: 再配信日時はアプリケーションとDBセッションのタイムゾーンによらず同じ時刻として比較する
+	started.NextAttemptAt = time.Now().Add(-time.Minute).In(time.FixedZone("UTC+9", 9*60*60))
+	require.NoError(t, repo.SaveFailure(ctx, started))
+	retried, err := repo.ClaimNext(ctx, outbox.LeaseDuration)
+	require.NoError(t, err)
+	assert.Equal(t, started.ID, retried.ID)
+}
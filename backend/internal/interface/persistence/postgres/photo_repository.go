@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/photo"
+	"github.com/google/uuid"
+)
+
+// PhotoRepository はPostgreSQLを使用した写真のリポジトリ実装
+type PhotoRepository struct {
+	db *sql.DB
+}
+
+// NewPhotoRepository は新しいPhotoRepositoryを生成する
+func NewPhotoRepository(db *sql.DB) photo.Repository {
+	return &PhotoRepository{
+		db: db,
+	}
+}
+
+// photoSelectColumns は写真取得時のカラム（scanPhoto と順序を合わせる）
+const photoSelectColumns = `id, walk_id, user_id, storage_path, url, content_type, captured_at, latitude, longitude, created_at`
+
+// scanPhoto は1行分の写真を読み込む
+func scanPhoto(row rowScanner) (*photo.Photo, error) {
+	p := &photo.Photo{}
+	err := row.Scan(
+		&p.ID, &p.WalkID, &p.UserID, &p.StoragePath, &p.URL, &p.ContentType,
+		&p.CapturedAt, &p.Latitude, &p.Longitude, &p.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Create は写真を作成する
+func (r *PhotoRepository) Create(ctx context.Context, p *photo.Photo) error {
+	query := `
+		INSERT INTO photos (` + photoSelectColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query,
+		p.ID, p.WalkID, p.UserID, p.StoragePath, p.URL, p.ContentType,
+		p.CapturedAt, p.Latitude, p.Longitude, p.CreatedAt,
+	)
+	return err
+}
+
+// FindByID はIDで写真を取得する
+func (r *PhotoRepository) FindByID(ctx context.Context, id uuid.UUID) (*photo.Photo, error) {
+	query := `SELECT ` + photoSelectColumns + ` FROM photos WHERE id = $1`
+
+	return scanPhoto(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+}
+
+// ListByWalkID は散歩の写真を撮影日時（ない場合は作成日時）の昇順で取得する
+func (r *PhotoRepository) ListByWalkID(ctx context.Context, walkID uuid.UUID) ([]*photo.Photo, error) {
+	query := `
+		SELECT ` + photoSelectColumns + `
+		FROM photos
+		WHERE walk_id = $1
+		ORDER BY COALESCE(captured_at, created_at), id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, walkID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	photos := make([]*photo.Photo, 0)
+	for rows.Next() {
+		p, err := scanPhoto(rows)
+		if err != nil {
+			return nil, err
+		}
+		photos = append(photos, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return photos, nil
+}
+
+// Delete は写真を削除する
+func (r *PhotoRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM photos WHERE id = $1`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/photo"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhotoRepository(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	walkRepo := NewWalkRepository(db)
+	photoRepo := NewPhotoRepository(db)
+	ctx := context.Background()
+
+	createTestUser(t, db, "user-123")
+
+	w := walk.NewWalk("user-123", "Photo walk", "")
+	require.NoError(t, walkRepo.Create(ctx, w))
+
+	capturedAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Microsecond)
+	lat, lng := 35.68, 139.76
+	located := photo.NewPhoto(w.ID, "user-123", "image/jpeg", &capturedAt, &lat, &lng)
+	located.URL = "https://example.com/" + located.StoragePath
+	require.NoError(t, photoRepo.Create(ctx, located))
+	plain := photo.NewPhoto(w.ID, "user-123", "image/png", nil, nil, nil)
+	plain.URL = "https://example.com/" + plain.StoragePath
+	require.NoError(t, photoRepo.Create(ctx, plain))
+
+	found, err := photoRepo.FindByID(ctx, located.ID)
+	require.NoError(t, err)
+	assert.Equal(t, located.StoragePath, found.StoragePath)
+	assert.Equal(t, located.URL, found.URL)
+	require.NotNil(t, found.CapturedAt)
+	assert.True(t, capturedAt.Equal(*found.CapturedAt))
+	require.True(t, found.HasLocation())
+	assert.Equal(t, lat, *found.Latitude)
+
+	// 期待値: 撮影日時（ない場合は作成日時）の順に取得できる
+	photos, err := photoRepo.ListByWalkID(ctx, w.ID)
+	require.NoError(t, err)
+	require.Len(t, photos, 2)
+	assert.Equal(t, located.ID, photos[0].ID)
+	assert.False(t, photos[1].HasLocation())
+
+	// 期待値: 削除後は取得できず、再度の削除は sql.ErrNoRows
+	require.NoError(t, photoRepo.Delete(ctx, located.ID))
+	_, err = photoRepo.FindByID(ctx, located.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.ErrorIs(t, photoRepo.Delete(ctx, located.ID), sql.ErrNoRows)
+
+	// 期待値: 散歩を削除すると写真の行も削除される
+	require.NoError(t, walkRepo.Delete(ctx, w))
+	_, err = photoRepo.FindByID(ctx, plain.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
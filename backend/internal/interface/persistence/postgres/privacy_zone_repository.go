@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/privacy"
+	"github.com/google/uuid"
+)
+
+// PrivacyZoneRepository はPostgreSQLを使用したプライバシーゾーンのリポジトリ実装
+type PrivacyZoneRepository struct {
+	db *sql.DB
+}
+
+// NewPrivacyZoneRepository は新しいPrivacyZoneRepositoryを生成する
+func NewPrivacyZoneRepository(db *sql.DB) privacy.Repository {
+	return &PrivacyZoneRepository{
+		db: db,
+	}
+}
+
+// ListByUserID はユーザーのプライバシーゾーンを作成日時の昇順で取得する
+func (r *PrivacyZoneRepository) ListByUserID(ctx context.Context, userID string) ([]*privacy.Zone, error) {
+	query := `
+		SELECT id, user_id, name, latitude, longitude, radius_meters, created_at
+		FROM privacy_zones
+		WHERE user_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	zones := make([]*privacy.Zone, 0)
+	for rows.Next() {
+		z := &privacy.Zone{}
+		if err = rows.Scan(&z.ID, &z.UserID, &z.Name, &z.Latitude, &z.Longitude, &z.RadiusMeters, &z.CreatedAt); err != nil {
+			return nil, err
+		}
+		zones = append(zones, z)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return zones, nil
+}
+
+// Create はプライバシーゾーンを作成する
+func (r *PrivacyZoneRepository) Create(ctx context.Context, z *privacy.Zone) error {
+	query := `
+		INSERT INTO privacy_zones (id, user_id, name, latitude, longitude, radius_meters, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, z.ID, z.UserID, z.Name, z.Latitude, z.Longitude, z.RadiusMeters, z.CreatedAt)
+	return err
+}
+
+// Delete はユーザーのプライバシーゾーンを削除する
+func (r *PrivacyZoneRepository) Delete(ctx context.Context, id uuid.UUID, userID string) error {
+	query := `DELETE FROM privacy_zones WHERE id = $1 AND user_id = $2`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/privacy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivacyZoneRepository(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	repo := NewPrivacyZoneRepository(db)
+	ctx := context.Background()
+
+	createTestUser(t, db, "user-123")
+	createTestUser(t, db, "user-456")
+
+	home := privacy.NewZone("user-123", "自宅", 35.68, 139.76, 200)
+	require.NoError(t, repo.Create(ctx, home))
+	office := privacy.NewZone("user-123", "職場", 35.66, 139.70, 300)
+	office.CreatedAt = home.CreatedAt.Add(time.Second)
+	require.NoError(t, repo.Create(ctx, office))
+
+	// 期待値: 作成日時の昇順で取得できる
+	zones, err := repo.ListByUserID(ctx, "user-123")
+	require.NoError(t, err)
+	require.Len(t, zones, 2)
+	assert.Equal(t, home.ID, zones[0].ID)
+	assert.Equal(t, 200.0, zones[0].RadiusMeters)
+	assert.Equal(t, office.ID, zones[1].ID)
+
+	// 期待値: 他のユーザーのゾーンは削除できない
+	assert.ErrorIs(t, repo.Delete(ctx, home.ID, "user-456"), sql.ErrNoRows)
+
+	require.NoError(t, repo.Delete(ctx, home.ID, "user-123"))
+	zones, err = repo.ListByUserID(ctx, "user-123")
+	require.NoError(t, err)
+	require.Len(t, zones, 1)
+	assert.Equal(t, office.ID, zones[0].ID)
+}
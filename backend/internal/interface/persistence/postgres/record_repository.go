@@ -0,0 +1,186 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/record"
+	"github.com/google/uuid"
+)
+
+// RecordRepository はPostgreSQLを使用した連続記録・自己ベストのリポジトリ実装
+type RecordRepository struct {
+	db *sql.DB
+}
+
+// NewRecordRepository は新しいRecordRepositoryを生成する
+func NewRecordRepository(db *sql.DB) record.Repository {
+	return &RecordRepository{
+		db: db,
+	}
+}
+
+// personalRecordsColumns はpersonal_recordsテーブルの取得カラム
+const personalRecordsColumns = `
+	user_id, current_streak, longest_streak, last_walk_date,
+	longest_walk_id, longest_walk_distance, fastest_km_walk_id, fastest_km_pace,
+	most_steps_date, most_steps_in_day, updated_at
+`
+
+// FindByUserID はユーザーの記録を取得する
+func (r *RecordRepository) FindByUserID(ctx context.Context, userID string) (*record.PersonalRecords, error) {
+	query := `SELECT ` + personalRecordsColumns + ` FROM personal_records WHERE user_id = $1`
+
+	return scanPersonalRecords(conn(ctx, r.db).QueryRowContext(ctx, query, userID))
+}
+
+// UpdateByUserID はユーザーの記録を行ロックして取得し、update で変更した結果を保存する
+func (r *RecordRepository) UpdateByUserID(ctx context.Context, userID string, update func(*record.PersonalRecords) error) error {
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		// 記録がまだないユーザーでも行ロックで直列化できるよう、記録なしの行を先に作成する
+		insertQuery := `INSERT INTO personal_records (user_id) VALUES ($1) ON CONFLICT (user_id) DO NOTHING`
+		if _, err := tx.ExecContext(ctx, insertQuery, userID); err != nil {
+			return err
+		}
+
+		selectQuery := `SELECT ` + personalRecordsColumns + ` FROM personal_records WHERE user_id = $1 FOR UPDATE`
+		pr, err := scanPersonalRecords(tx.QueryRowContext(ctx, selectQuery, userID))
+		if err != nil {
+			return err
+		}
+
+		if err := update(pr); err != nil {
+			return err
+		}
+
+		updateQuery := `
+			UPDATE personal_records SET
+				current_streak = $2,
+				longest_streak = $3,
+				last_walk_date = $4,
+				longest_walk_id = $5,
+				longest_walk_distance = $6,
+				fastest_km_walk_id = $7,
+				fastest_km_pace = $8,
+				most_steps_date = $9,
+				most_steps_in_day = $10,
+				updated_at = $11
+			WHERE user_id = $1
+		`
+		_, err = tx.ExecContext(
+			ctx, updateQuery,
+			pr.UserID, pr.CurrentStreak, pr.LongestStreak, formatDate(pr.LastWalkDate),
+			pr.LongestWalkID, pr.LongestWalkDistance, pr.FastestKmWalkID, pr.FastestKmPace,
+			formatDate(pr.MostStepsDate), pr.MostStepsInDay, pr.UpdatedAt,
+		)
+		return err
+	})
+}
+
+// scanPersonalRecords は personalRecordsColumns の1行をPersonalRecordsに変換する
+func scanPersonalRecords(row *sql.Row) (*record.PersonalRecords, error) {
+	pr := &record.PersonalRecords{}
+	var lastWalkDate, mostStepsDate sql.NullTime
+	var longestWalkID, fastestKmWalkID uuid.NullUUID
+	var fastestKmPace sql.NullFloat64
+	err := row.Scan(
+		&pr.UserID, &pr.CurrentStreak, &pr.LongestStreak, &lastWalkDate,
+		&longestWalkID, &pr.LongestWalkDistance, &fastestKmWalkID, &fastestKmPace,
+		&mostStepsDate, &pr.MostStepsInDay, &pr.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pr.LastWalkDate = scanDate(lastWalkDate)
+	pr.MostStepsDate = scanDate(mostStepsDate)
+	if longestWalkID.Valid {
+		pr.LongestWalkID = &longestWalkID.UUID
+	}
+	if fastestKmWalkID.Valid {
+		pr.FastestKmWalkID = &fastestKmWalkID.UUID
+	}
+	if fastestKmPace.Valid {
+		pr.FastestKmPace = &fastestKmPace.Float64
+	}
+
+	return pr, nil
+}
+
+// FindWalkDays はユーザーが散歩を完了した暦日を昇順・重複なしで返す
+// walks.start_time はUTCで保存されているため、ユーザーのタイムゾーンに変換してから日付にする
+func (r *RecordRepository) FindWalkDays(ctx context.Context, userID string, loc *time.Location) ([]time.Time, error) {
+	query := `
+		SELECT DISTINCT ((start_time AT TIME ZONE 'UTC') AT TIME ZONE $2)::date AS day
+		FROM walks
+		WHERE user_id = $1
+		  AND status = 'completed'
+		  AND start_time IS NOT NULL
+		ORDER BY day
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID, loc.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	days := make([]time.Time, 0)
+	for rows.Next() {
+		var day sql.NullTime
+		if err = rows.Scan(&day); err != nil {
+			return nil, err
+		}
+		days = append(days, *scanDate(day))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return days, nil
+}
+
+// SumStepsOnDay は loc のタイムゾーンでの暦日 day に開始した完了済みの散歩の合計歩数を返す
+func (r *RecordRepository) SumStepsOnDay(ctx context.Context, userID string, day time.Time, loc *time.Location) (int, error) {
+	query := `
+		SELECT COALESCE(SUM(total_steps), 0)
+		FROM walks
+		WHERE user_id = $1
+		  AND status = 'completed'
+		  AND start_time >= $2
+		  AND start_time < $3
+	`
+
+	// 暦日の範囲をUTCの時刻に変換してインデックスを使えるようにする
+	y, m, d := day.Date()
+	from := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	to := from.AddDate(0, 0, 1)
+
+	var steps int
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query, userID, from.UTC(), to.UTC()).Scan(&steps); err != nil {
+		return 0, err
+	}
+
+	return steps, nil
+}
+
+// scanDate はDATE列の値をその日のUTCの0時に揃えて返す
+func scanDate(v sql.NullTime) *time.Time {
+	if !v.Valid {
+		return nil
+	}
+	y, m, d := v.Time.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	return &day
+}
+
+// formatDate はDATE列に書き込む日付を YYYY-MM-DD 形式にする
+func formatDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(time.DateOnly)
+	return &s
+}
@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/record"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordRepository_UpdateAndFind(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	walkRepo := NewWalkRepository(db)
+	repo := NewRecordRepository(db)
+	ctx := context.Background()
+
+	createTestUser(t, db, "user-123")
+	w := walk.NewWalk("user-123", "Long Walk", "")
+	require.NoError(t, walkRepo.Create(ctx, w))
+
+	// 記録がない場合
+	_, err := repo.FindByUserID(ctx, "user-123")
+	assert.Error(t, err)
+
+	// 期待値: 記録がない場合は記録なしの値が渡され、変更が保存される
+	err = repo.UpdateByUserID(ctx, "user-123", func(pr *record.PersonalRecords) error {
+		assert.Equal(t, 0, pr.LongestStreak)
+		assert.Nil(t, pr.LastWalkDate)
+		pr.ApplyWalkDay(time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC))
+		pace := 540.0
+		pr.ApplyWalk(w.ID, 5200, &pace)
+		pr.ApplyDailySteps(time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), 9000)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// 2回目は保存済みの記録に対する更新になる
+	err = repo.UpdateByUserID(ctx, "user-123", func(pr *record.PersonalRecords) error {
+		pr.ApplyWalkDay(time.Date(2025, 1, 16, 0, 0, 0, 0, time.UTC))
+		return nil
+	})
+	require.NoError(t, err)
+
+	// 期待値: update がエラーを返した場合は保存しない
+	err = repo.UpdateByUserID(ctx, "user-123", func(pr *record.PersonalRecords) error {
+		pr.ApplyWalkDay(time.Date(2025, 1, 17, 0, 0, 0, 0, time.UTC))
+		return assert.AnError
+	})
+	require.ErrorIs(t, err, assert.AnError)
+
+	// 期待値: 保存した記録がそのまま取得できる
+	found, err := repo.FindByUserID(ctx, "user-123")
+	require.NoError(t, err)
+	assert.Equal(t, 2, found.CurrentStreak)
+	assert.Equal(t, 2, found.LongestStreak)
+	assert.Equal(t, "2025-01-16", found.LastWalkDate.Format(time.DateOnly))
+	assert.Equal(t, w.ID, *found.LongestWalkID)
+	assert.Equal(t, 5200.0, found.LongestWalkDistance)
+	assert.Equal(t, 540.0, *found.FastestKmPace)
+	assert.Equal(t, "2025-01-15", found.MostStepsDate.Format(time.DateOnly))
+	assert.Equal(t, 9000, found.MostStepsInDay)
+}
+
+func TestRecordRepository_WalkDaysAndSteps(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	walkRepo := NewWalkRepository(db)
+	repo := NewRecordRepository(db)
+	ctx := context.Background()
+
+	createTestUser(t, db, "user-123")
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	// 2025-01-15 20:00 UTC と 2025-01-16 01:00 UTC はどちらも東京では1月16日
+	for _, tc := range []struct {
+		start  time.Time
+		steps  int
+		status walk.WalkStatus
+	}{
+		{start: time.Date(2025, 1, 14, 3, 0, 0, 0, time.UTC), steps: 4000, status: walk.StatusCompleted},
+		{start: time.Date(2025, 1, 15, 20, 0, 0, 0, time.UTC), steps: 3000, status: walk.StatusCompleted},
+		{start: time.Date(2025, 1, 16, 1, 0, 0, 0, time.UTC), steps: 2500, status: walk.StatusCompleted},
+		{start: time.Date(2025, 1, 16, 2, 0, 0, 0, time.UTC), steps: 9999, status: walk.StatusInProgress},
+	} {
+		w := walk.NewWalk("user-123", "Walk", "")
+		start := tc.start
+		w.StartTime = &start
+		w.TotalSteps = tc.steps
+		w.Status = tc.status
+		require.NoError(t, walkRepo.Create(ctx, w))
+	}
+
+	// 期待値: 完了済みの散歩の暦日が東京時間で重複なく昇順に返る
+	days, err := repo.FindWalkDays(ctx, "user-123", tokyo)
+	require.NoError(t, err)
+	require.Len(t, days, 2)
+	assert.Equal(t, "2025-01-14", days[0].Format(time.DateOnly))
+	assert.Equal(t, "2025-01-16", days[1].Format(time.DateOnly))
+
+	// 期待値: 東京時間の1月16日に開始した完了済みの散歩の歩数のみ合計される
+	steps, err := repo.SumStepsOnDay(ctx, "user-123", days[1], tokyo)
+	require.NoError(t, err)
+	assert.Equal(t, 5500, steps)
+}
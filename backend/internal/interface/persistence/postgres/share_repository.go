@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/share"
+	"github.com/google/uuid"
+)
+
+// ShareRepository はPostgreSQLを使用した共有リンクのリポジトリ実装
+type ShareRepository struct {
+	db *sql.DB
+}
+
+// NewShareRepository は新しいShareRepositoryを生成する
+func NewShareRepository(db *sql.DB) share.Repository {
+	return &ShareRepository{
+		db: db,
+	}
+}
+
+// Create は共有リンクを作成する
+func (r *ShareRepository) Create(ctx context.Context, link *share.Link) error {
+	query := `
+		INSERT INTO share_links (id, walk_id, user_id, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, link.ID, link.WalkID, link.UserID, link.ExpiresAt, link.CreatedAt)
+	return err
+}
+
+// FindByID はIDで共有リンクを取得する
+func (r *ShareRepository) FindByID(ctx context.Context, id uuid.UUID) (*share.Link, error) {
+	query := `
+		SELECT id, walk_id, user_id, expires_at, created_at
+		FROM share_links
+		WHERE id = $1
+	`
+
+	link := &share.Link{}
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
+		&link.ID, &link.WalkID, &link.UserID, &link.ExpiresAt, &link.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+// ListByWalkID は散歩の共有リンクを作成日時の降順で取得する
+func (r *ShareRepository) ListByWalkID(ctx context.Context, walkID uuid.UUID) ([]*share.Link, error) {
+	query := `
+		SELECT id, walk_id, user_id, expires_at, created_at
+		FROM share_links
+		WHERE walk_id = $1
+		ORDER BY created_at DESC, id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, walkID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	links := make([]*share.Link, 0)
+	for rows.Next() {
+		link := &share.Link{}
+		if err = rows.Scan(&link.ID, &link.WalkID, &link.UserID, &link.ExpiresAt, &link.CreatedAt); err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+// Delete は散歩の共有リンクを削除する
+func (r *ShareRepository) Delete(ctx context.Context, walkID, id uuid.UUID) error {
+	query := `DELETE FROM share_links WHERE walk_id = $1 AND id = $2`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, walkID, id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/share"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShareRepository(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	walkRepo := NewWalkRepository(db)
+	shareRepo := NewShareRepository(db)
+	ctx := context.Background()
+
+	createTestUser(t, db, "user-123")
+
+	w := walk.NewWalk("user-123", "Shared walk", "")
+	require.NoError(t, walkRepo.Create(ctx, w))
+
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Microsecond)
+	expiring, err := share.NewLink(w.ID, "user-123", &expiresAt)
+	require.NoError(t, err)
+	require.NoError(t, shareRepo.Create(ctx, expiring))
+	permanent, err := share.NewLink(w.ID, "user-123", nil)
+	require.NoError(t, err)
+	permanent.CreatedAt = expiring.CreatedAt.Add(time.Second)
+	require.NoError(t, shareRepo.Create(ctx, permanent))
+
+	found, err := shareRepo.FindByID(ctx, expiring.ID)
+	require.NoError(t, err)
+	assert.Equal(t, w.ID, found.WalkID)
+	require.NotNil(t, found.ExpiresAt)
+	assert.True(t, expiresAt.Equal(*found.ExpiresAt))
+
+	// 期待値: 新しい順に取得できる
+	links, err := shareRepo.ListByWalkID(ctx, w.ID)
+	require.NoError(t, err)
+	require.Len(t, links, 2)
+	assert.Equal(t, permanent.ID, links[0].ID)
+	assert.Nil(t, links[0].ExpiresAt)
+
+	// 期待値: 削除後は取得できず、再度の削除は sql.ErrNoRows
+	require.NoError(t, shareRepo.Delete(ctx, w.ID, expiring.ID))
+	_, err = shareRepo.FindByID(ctx, expiring.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.ErrorIs(t, shareRepo.Delete(ctx, w.ID, expiring.ID), sql.ErrNoRows)
+
+	// 期待値: 散歩を削除すると共有リンクも削除される
+	require.NoError(t, walkRepo.Delete(ctx, w))
+	_, err = shareRepo.FindByID(ctx, permanent.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,303 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/social"
+)
+
+// friendsCTE は相互に承認済みのフォロー関係（友達）の共通テーブル式
+// user_id と friend_id の組を両方向とも含み、since は後から承認された側の日時
+const friendsCTE = `
+	WITH friends AS (
+		SELECT out_f.follower_id AS user_id,
+		       out_f.followee_id AS friend_id,
+		       GREATEST(out_f.updated_at, in_f.updated_at) AS since
+		FROM follows out_f
+		JOIN follows in_f
+		  ON in_f.follower_id = out_f.followee_id
+		 AND in_f.followee_id = out_f.follower_id
+		WHERE out_f.status = 'accepted'
+		  AND in_f.status = 'accepted'
+	)
+`
+
+// notBlockedCondition は users.id（エイリアス u）と指定ユーザーの間にブロック関係がない条件を返す
+// ブロック時にフォロー関係は削除されるが、ブロックされたユーザーが一覧に現れないことをクエリでも保証する
+func notBlockedCondition(placeholder string) string {
+	return fmt.Sprintf(`NOT EXISTS (
+		SELECT 1 FROM blocks b
+		WHERE (b.blocker_id = %[1]s AND b.blocked_id = u.id)
+		   OR (b.blocker_id = u.id AND b.blocked_id = %[1]s)
+	)`, placeholder)
+}
+
+// SocialRepository はPostgreSQLを使用したフォロー・ブロック関係のリポジトリ実装
+type SocialRepository struct {
+	db *sql.DB
+}
+
+// NewSocialRepository は新しいSocialRepositoryを生成する
+func NewSocialRepository(db *sql.DB) social.Repository {
+	return &SocialRepository{
+		db: db,
+	}
+}
+
+// FindFollow はフォロー関係を取得する
+func (r *SocialRepository) FindFollow(ctx context.Context, followerID, followeeID string) (*social.Follow, error) {
+	query := `
+		SELECT follower_id, followee_id, status, created_at, updated_at
+		FROM follows
+		WHERE follower_id = $1 AND followee_id = $2
+	`
+
+	f := &social.Follow{}
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, followerID, followeeID).Scan(
+		&f.FollowerID, &f.FolloweeID, &f.Status, &f.CreatedAt, &f.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// SaveFollow はフォロー関係を作成または更新する
+func (r *SocialRepository) SaveFollow(ctx context.Context, f *social.Follow) error {
+	query := `
+		INSERT INTO follows (follower_id, followee_id, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (follower_id, followee_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, f.FollowerID, f.FolloweeID, f.Status, f.CreatedAt, f.UpdatedAt)
+	return err
+}
+
+// DeleteFollows は2人の間のフォロー関係を両方向とも削除し、削除した件数を返す
+func (r *SocialRepository) DeleteFollows(ctx context.Context, userID, otherID string) (int, error) {
+	query := `
+		DELETE FROM follows
+		WHERE (follower_id = $1 AND followee_id = $2)
+		   OR (follower_id = $2 AND followee_id = $1)
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, userID, otherID)
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rows), nil
+}
+
+// DeleteFollow はフォロー関係を削除する
+func (r *SocialRepository) DeleteFollow(ctx context.Context, followerID, followeeID string) error {
+	query := `DELETE FROM follows WHERE follower_id = $1 AND followee_id = $2`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, followerID, followeeID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// ListFriends は相互に承認済みのフォロー関係にあるユーザーを友達になった日時の降順で返す
+func (r *SocialRepository) ListFriends(ctx context.Context, userID string) ([]*social.Connection, error) {
+	query := friendsCTE + `
+		SELECT u.id, COALESCE(u.display_name, ''), f.since
+		FROM friends f
+		JOIN users u ON u.id = f.friend_id
+		WHERE f.user_id = $1
+		  AND ` + notBlockedCondition("$1") + `
+		ORDER BY f.since DESC, u.id
+	`
+
+	return r.queryConnections(ctx, query, userID)
+}
+
+// AreFriends は2人が相互に承認済みのフォロー関係にあるかどうかを返す
+func (r *SocialRepository) AreFriends(ctx context.Context, userID, otherID string) (bool, error) {
+	query := friendsCTE + `
+		SELECT EXISTS (
+			SELECT 1 FROM friends
+			WHERE user_id = $1 AND friend_id = $2
+		)
+	`
+
+	var friends bool
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query, userID, otherID).Scan(&friends); err != nil {
+		return false, err
+	}
+
+	return friends, nil
+}
+
+// ListMutualFriends は2人の共通の友達を userID と友達になった日時の降順で返す
+// どちらかとブロック関係にあるユーザーは含めない
+func (r *SocialRepository) ListMutualFriends(ctx context.Context, userID, otherID string) ([]*social.Connection, error) {
+	query := friendsCTE + `
+		SELECT u.id, COALESCE(u.display_name, ''), mine.since
+		FROM friends mine
+		JOIN friends theirs ON theirs.friend_id = mine.friend_id
+		JOIN users u ON u.id = mine.friend_id
+		WHERE mine.user_id = $1
+		  AND theirs.user_id = $2
+		  AND ` + notBlockedCondition("$1") + `
+		  AND ` + notBlockedCondition("$2") + `
+		ORDER BY mine.since DESC, u.id
+	`
+
+	return r.queryConnections(ctx, query, userID, otherID)
+}
+
+// ListIncomingRequests はユーザー宛ての承認待ちのフォローリクエストの送信者を新しい順に返す
+func (r *SocialRepository) ListIncomingRequests(ctx context.Context, userID string) ([]*social.Connection, error) {
+	query := `
+		SELECT u.id, COALESCE(u.display_name, ''), f.created_at
+		FROM follows f
+		JOIN users u ON u.id = f.follower_id
+		WHERE f.followee_id = $1
+		  AND f.status = 'pending'
+		  AND ` + notBlockedCondition("$1") + `
+		ORDER BY f.created_at DESC, u.id
+	`
+
+	return r.queryConnections(ctx, query, userID)
+}
+
+// ListOutgoingRequests はユーザーが送信した承認待ちのフォローリクエストの宛先を新しい順に返す
+func (r *SocialRepository) ListOutgoingRequests(ctx context.Context, userID string) ([]*social.Connection, error) {
+	query := `
+		SELECT u.id, COALESCE(u.display_name, ''), f.created_at
+		FROM follows f
+		JOIN users u ON u.id = f.followee_id
+		WHERE f.follower_id = $1
+		  AND f.status = 'pending'
+		  AND ` + notBlockedCondition("$1") + `
+		ORDER BY f.created_at DESC, u.id
+	`
+
+	return r.queryConnections(ctx, query, userID)
+}
+
+// IsBlocked は2人のどちらかがもう一方をブロックしているかどうかを返す
+func (r *SocialRepository) IsBlocked(ctx context.Context, userID, otherID string) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM blocks
+			WHERE (blocker_id = $1 AND blocked_id = $2)
+			   OR (blocker_id = $2 AND blocked_id = $1)
+		)
+	`
+
+	var blocked bool
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query, userID, otherID).Scan(&blocked); err != nil {
+		return false, err
+	}
+
+	return blocked, nil
+}
+
+// Block はブロックを作成し、2人の間のフォロー関係を両方向とも削除する
+// ブロックとフォロー解除が片方だけ反映されないよう、同一トランザクションで実行する
+func (r *SocialRepository) Block(ctx context.Context, b *social.Block) error {
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		insertQuery := `
+			INSERT INTO blocks (blocker_id, blocked_id, created_at)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (blocker_id, blocked_id) DO NOTHING
+		`
+		if _, err := tx.ExecContext(ctx, insertQuery, b.BlockerID, b.BlockedID, b.CreatedAt); err != nil {
+			return err
+		}
+
+		deleteQuery := `
+			DELETE FROM follows
+			WHERE (follower_id = $1 AND followee_id = $2)
+			   OR (follower_id = $2 AND followee_id = $1)
+		`
+		if _, err := tx.ExecContext(ctx, deleteQuery, b.BlockerID, b.BlockedID); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// Unblock はブロックを解除する
+func (r *SocialRepository) Unblock(ctx context.Context, blockerID, blockedID string) error {
+	query := `DELETE FROM blocks WHERE blocker_id = $1 AND blocked_id = $2`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, blockerID, blockedID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// ListBlocked はユーザーがブロックしているユーザーをブロックした日時の降順で返す
+func (r *SocialRepository) ListBlocked(ctx context.Context, blockerID string) ([]*social.Connection, error) {
+	query := `
+		SELECT u.id, COALESCE(u.display_name, ''), b.created_at
+		FROM blocks b
+		JOIN users u ON u.id = b.blocked_id
+		WHERE b.blocker_id = $1
+		ORDER BY b.created_at DESC, u.id
+	`
+
+	return r.queryConnections(ctx, query, blockerID)
+}
+
+// queryConnections は (ユーザーID, 表示名, 日時) を返すクエリを実行してConnectionの一覧にする
+func (r *SocialRepository) queryConnections(ctx context.Context, query string, args ...interface{}) ([]*social.Connection, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	connections := make([]*social.Connection, 0)
+	for rows.Next() {
+		c := &social.Connection{}
+		if err = rows.Scan(&c.UserID, &c.DisplayName, &c.Since); err != nil {
+			return nil, err
+		}
+		connections = append(connections, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return connections, nil
+}
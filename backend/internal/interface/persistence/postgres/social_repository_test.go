@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/social"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// makeFriends はテスト用に2人を相互フォロー（友達）にする
+func makeFriends(t *testing.T, repo social.Repository, a, b string) {
+	for _, pair := range [][2]string{{a, b}, {b, a}} {
+		f, err := social.NewFollowRequest(pair[0], pair[1])
+		require.NoError(t, err)
+		require.NoError(t, f.Accept())
+		require.NoError(t, repo.SaveFollow(context.Background(), f))
+	}
+}
+
+func TestSocialRepository_FriendsAndRequests(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	repo := NewSocialRepository(db)
+	ctx := context.Background()
+
+	for _, id := range []string{"alice", "bob", "carol", "dave"} {
+		createTestUser(t, db, id)
+	}
+
+	makeFriends(t, repo, "alice", "bob")
+	makeFriends(t, repo, "alice", "carol")
+	makeFriends(t, repo, "dave", "bob")
+
+	// 片方向のみ承認済みは友達ではない
+	oneWay, err := social.NewFollowRequest("carol", "dave")
+	require.NoError(t, err)
+	require.NoError(t, oneWay.Accept())
+	require.NoError(t, repo.SaveFollow(ctx, oneWay))
+
+	pending, err := social.NewFollowRequest("dave", "alice")
+	require.NoError(t, err)
+	require.NoError(t, repo.SaveFollow(ctx, pending))
+
+	// 期待値: 相互に承認済みのユーザーのみ友達
+	friends, err := repo.ListFriends(ctx, "alice")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"bob", "carol"}, connectionIDs(friends))
+
+	// 期待値: 友達かどうかはどちらの順序で問い合わせても同じ
+	areFriends, err := repo.AreFriends(ctx, "bob", "alice")
+	require.NoError(t, err)
+	assert.True(t, areFriends)
+	areFriends, err = repo.AreFriends(ctx, "carol", "dave")
+	require.NoError(t, err)
+	assert.False(t, areFriends)
+
+	// 期待値: aliceとdaveの共通の友達はbob
+	mutual, err := repo.ListMutualFriends(ctx, "alice", "dave")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bob"}, connectionIDs(mutual))
+
+	// 期待値: 承認待ちのリクエストは宛先・送信者の両方から参照できる
+	incoming, err := repo.ListIncomingRequests(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dave"}, connectionIDs(incoming))
+	outgoing, err := repo.ListOutgoingRequests(ctx, "dave")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice"}, connectionIDs(outgoing))
+}
+
+func TestSocialRepository_Block(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	repo := NewSocialRepository(db)
+	ctx := context.Background()
+
+	for _, id := range []string{"alice", "bob"} {
+		createTestUser(t, db, id)
+	}
+	makeFriends(t, repo, "alice", "bob")
+
+	b, err := social.NewBlock("bob", "alice")
+	require.NoError(t, err)
+	require.NoError(t, repo.Block(ctx, b))
+	// 2回目のブロックはエラーにならない
+	require.NoError(t, repo.Block(ctx, b))
+
+	// 期待値: ブロックはどちらの方向からも判定でき、フォロー関係は削除される
+	blocked, err := repo.IsBlocked(ctx, "alice", "bob")
+	require.NoError(t, err)
+	assert.True(t, blocked)
+
+	_, err = repo.FindFollow(ctx, "alice", "bob")
+	assert.Error(t, err)
+
+	friends, err := repo.ListFriends(ctx, "alice")
+	require.NoError(t, err)
+	assert.Empty(t, friends)
+
+	blockedUsers, err := repo.ListBlocked(ctx, "bob")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice"}, connectionIDs(blockedUsers))
+
+	// 期待値: ブロックした側のみ解除でき、存在しない場合はエラー
+	assert.Error(t, repo.Unblock(ctx, "alice", "bob"))
+	require.NoError(t, repo.Unblock(ctx, "bob", "alice"))
+}
+
+func connectionIDs(connections []*social.Connection) []string {
+	ids := make([]string, len(connections))
+	for i, c := range connections {
+		ids[i] = c.UserID
+	}
+	return ids
+}
@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/stats"
+)
+
+// StatsRepository はPostgreSQLを使用した集計リポジトリ実装
+type StatsRepository struct {
+	db *sql.DB
+}
+
+// NewStatsRepository は新しいStatsRepositoryを生成する
+func NewStatsRepository(db *sql.DB) stats.Repository {
+	return &StatsRepository{
+		db: db,
+	}
+}
+
+// AggregateWalks はユーザーの完了済みの散歩を開始時刻で期間ごとに集計する
+// walks.start_time はUTCで保存されているため、ユーザーのタイムゾーンに変換してから期間を切り詰める
+func (r *StatsRepository) AggregateWalks(ctx context.Context, userID string, g stats.Granularity, from, to time.Time, loc *time.Location) ([]stats.Bucket, error) {
+	query := `
+		SELECT date_trunc($2, (start_time AT TIME ZONE 'UTC') AT TIME ZONE $3) AS period_start,
+		       COUNT(*),
+		       COALESCE(SUM(total_distance), 0),
+		       COALESCE(SUM(total_steps), 0),
+		       COALESCE(SUM(moving_time), 0)
+		FROM walks
+		WHERE user_id = $1
+		  AND status = 'completed'
+		  AND start_time >= $4
+		  AND start_time < $5
+		GROUP BY period_start
+		ORDER BY period_start
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID, string(g), loc.String(), from.UTC(), to.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	buckets := make([]stats.Bucket, 0)
+	for rows.Next() {
+		var b stats.Bucket
+		var periodStart time.Time
+		if err = rows.Scan(&periodStart, &b.WalkCount, &b.TotalDistance, &b.TotalSteps, &b.MovingTime); err != nil {
+			return nil, err
+		}
+
+		// タイムゾーンなしのローカル時刻として返るため、ユーザーのタイムゾーンの時刻に付け替える
+		y, m, d := periodStart.Date()
+		b.PeriodStart = time.Date(y, m, d, 0, 0, 0, 0, loc)
+		b.CalculatePace()
+
+		buckets = append(buckets, b)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return buckets, nil
+}
+
+// SummarizeWalks はユーザーの完了済みの全散歩の合計値を返す
+func (r *StatsRepository) SummarizeWalks(ctx context.Context, userID string) (stats.Bucket, error) {
+	query := `
+		SELECT COUNT(*),
+		       COALESCE(SUM(total_distance), 0),
+		       COALESCE(SUM(total_steps), 0),
+		       COALESCE(SUM(moving_time), 0)
+		FROM walks
+		WHERE user_id = $1
+		  AND status = 'completed'
+	`
+
+	var b stats.Bucket
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(&b.WalkCount, &b.TotalDistance, &b.TotalSteps, &b.MovingTime)
+	if err != nil {
+		return stats.Bucket{}, err
+	}
+	b.CalculatePace()
+
+	return b, nil
+}
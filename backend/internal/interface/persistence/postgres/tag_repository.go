@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/tag"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// uniqueViolation は一意制約違反のSQLSTATE
+const uniqueViolation = "23505"
+
+// isUniqueViolation は一意制約違反のエラーかどうかを返す
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation
+}
+
+// TagRepository はPostgreSQLを使用したタグのリポジトリ実装
+type TagRepository struct {
+	db *sql.DB
+}
+
+// NewTagRepository は新しいTagRepositoryを生成する
+func NewTagRepository(db *sql.DB) tag.Repository {
+	return &TagRepository{
+		db: db,
+	}
+}
+
+// ListByUserID はユーザーのタグを名前順に、散歩の数とあわせて取得する
+func (r *TagRepository) ListByUserID(ctx context.Context, userID string) ([]*tag.Tag, error) {
+	query := `
+		SELECT t.id, t.user_id, t.name, COUNT(wt.walk_id), t.created_at, t.updated_at
+		FROM tags t
+		LEFT JOIN walk_tags wt ON wt.tag_id = t.id
+		WHERE t.user_id = $1
+		GROUP BY t.id
+		ORDER BY LOWER(t.name), t.id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make([]*tag.Tag, 0)
+	for rows.Next() {
+		t := &tag.Tag{}
+		if err = rows.Scan(&t.ID, &t.UserID, &t.Name, &t.WalkCount, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// FindByID はIDでタグを取得する
+func (r *TagRepository) FindByID(ctx context.Context, id uuid.UUID) (*tag.Tag, error) {
+	query := `
+		SELECT t.id, t.user_id, t.name,
+		       (SELECT COUNT(*) FROM walk_tags wt WHERE wt.tag_id = t.id),
+		       t.created_at, t.updated_at
+		FROM tags t
+		WHERE t.id = $1
+	`
+
+	t := &tag.Tag{}
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
+		&t.ID, &t.UserID, &t.Name, &t.WalkCount, &t.CreatedAt, &t.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Create はタグを作成する
+func (r *TagRepository) Create(ctx context.Context, t *tag.Tag) error {
+	query := `
+		INSERT INTO tags (id, user_id, name, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, t.ID, t.UserID, t.Name, t.CreatedAt, t.UpdatedAt)
+	if isUniqueViolation(err) {
+		return tag.ErrDuplicateName
+	}
+	return err
+}
+
+// Update はタグ名を更新する
+func (r *TagRepository) Update(ctx context.Context, t *tag.Tag) error {
+	query := `UPDATE tags SET name = $2, updated_at = $3 WHERE id = $1`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, t.ID, t.Name, t.UpdatedAt)
+	if isUniqueViolation(err) {
+		return tag.ErrDuplicateName
+	}
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Delete はタグを削除する（walk_tags は外部キーのカスケードで削除される）
+func (r *TagRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM tags WHERE id = $1`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/tag"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagRepository_WalkTags(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	walkRepo := NewWalkRepository(db)
+	tagRepo := NewTagRepository(db)
+	ctx := context.Background()
+
+	createTestUser(t, db, "user-123")
+
+	existing, err := tag.NewTag("user-123", "Kyoto")
+	require.NoError(t, err)
+	require.NoError(t, tagRepo.Create(ctx, existing))
+
+	tagged := walk.NewWalk("user-123", "Temple walk", "")
+	untagged := walk.NewWalk("user-123", "Park walk", "")
+	require.NoError(t, walkRepo.Create(ctx, tagged))
+	require.NoError(t, walkRepo.Create(ctx, untagged))
+
+	// 期待値: 既存のタグは大文字小文字を区別せず照合され、登録済みの表記になる
+	tagged.Tags = []string{"kyoto", "morning"}
+	require.NoError(t, walkRepo.ReplaceTags(ctx, tagged))
+	assert.Equal(t, []string{"Kyoto", "morning"}, tagged.Tags)
+
+	found, err := walkRepo.FindByID(ctx, tagged.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Kyoto", "morning"}, found.Tags)
+
+	// 期待値: タグで絞り込める
+	walks, err := walkRepo.FindByCriteria(ctx, walk.ListCriteria{
+		UserID: "user-123",
+		Filter: walk.WalkFilter{Tag: "KYOTO"},
+		Limit:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, walks, 1)
+	assert.Equal(t, tagged.ID, walks[0].ID)
+
+	// 期待値: 一覧には散歩の数が含まれる
+	tags, err := tagRepo.ListByUserID(ctx, "user-123")
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+	assert.Equal(t, "Kyoto", tags[0].Name)
+	assert.Equal(t, 1, tags[0].WalkCount)
+
+	// 期待値: 同じ名前（大文字小文字違い）のタグは作成できない
+	duplicate, err := tag.NewTag("user-123", "MORNING")
+	require.NoError(t, err)
+	assert.ErrorIs(t, tagRepo.Create(ctx, duplicate), tag.ErrDuplicateName)
+
+	// 期待値: タグを削除すると散歩からも外れる
+	require.NoError(t, tagRepo.Delete(ctx, existing.ID))
+	found, err = walkRepo.FindByID(ctx, tagged.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"morning"}, found.Tags)
+
+	// 期待値: 空の一覧で置き換えるとタグがすべて外れる
+	tagged.Tags = []string{}
+	require.NoError(t, walkRepo.ReplaceTags(ctx, tagged))
+	assert.Empty(t, tagged.Tags)
+}
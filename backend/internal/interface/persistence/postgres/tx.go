@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+)
+
+// txKey はコンテキストにトランザクションを持たせるためのキー
+type txKey struct{}
+
+// dbtx は *sql.DB と *sql.Tx に共通するクエリ実行インターフェース
+type dbtx interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// TxManager はリポジトリの複数の読み書きを1つのトランザクションにまとめる
+// トランザクションはコンテキストで受け渡し、各リポジトリはコンテキストにトランザクションがあればそれを使う
+type TxManager struct {
+	db *sql.DB
+}
+
+// NewTxManager は新しいTxManagerを生成する
+func NewTxManager(db *sql.DB) *TxManager {
+	return &TxManager{
+		db: db,
+	}
+}
+
+// WithinTx は fn を1つのトランザクションで実行し、fn がエラーを返した場合はロールバックする
+// fn に渡すコンテキストを使ったリポジトリの読み書きは全てこのトランザクションで行う
+// ctx が既にトランザクションを持つ場合はそれに参加し、コミットは外側の WithinTx に任せる
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return withTx(ctx, m.db, func(tx *sql.Tx) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// conn はコンテキストのトランザクションを返す。トランザクションがない場合は db を返す
+func conn(ctx context.Context, db *sql.DB) dbtx {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+// withTx は fn をトランザクションで実行し、成功した場合はコミットする
+// ctx がトランザクションを持つ場合はそのトランザクションで実行し、コミット・ロールバックは呼び出し元に任せる
+func withTx(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(tx)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxManager_WithinTx(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	txManager := NewTxManager(db)
+	walkRepo := NewWalkRepository(db)
+	locationRepo := NewWalkLocationRepository(db)
+	ctx := context.Background()
+
+	createTestUser(t, db, "user-123")
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	newLocations := func(w *walk.Walk) []*walk.WalkLocation {
+		return []*walk.WalkLocation{
+			walk.NewWalkLocationWithOptionals(w.ID, 35.0, 139.0, nil, start, nil, nil, nil, nil, 0),
+			walk.NewWalkLocationWithOptionals(w.ID, 35.0, 139.001, nil, start.Add(time.Minute), nil, nil, nil, nil, 1),
+		}
+	}
+	countOutbox := func(w *walk.Walk) int {
+		var count int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM outbox_events WHERE walk_id = $1`, w.ID).Scan(&count))
+		return count
+	}
+
+	t.Run("fnが成功した場合は全ての書き込みをコミットする", func(t *testing.T) {
+		w := walk.NewWalk("user-123", "Committed", "")
+
+		err := txManager.WithinTx(ctx, func(ctx context.Context) error {
+			if err := walkRepo.Create(ctx, w); err != nil {
+				return err
+			}
+			if err := locationRepo.BatchCreate(ctx, newLocations(w)); err != nil {
+				return err
+			}
+			// 期待値: トランザクション内の読み取りはコミット前の書き込みを参照する
+			found, err := locationRepo.FindByWalkID(ctx, w.ID)
+			require.NoError(t, err)
+			assert.Len(t, found, 2)
+			return nil
+		})
+		require.NoError(t, err)
+
+		_, err = walkRepo.FindByID(ctx, w.ID)
+		require.NoError(t, err)
+		found, err := locationRepo.FindByWalkID(ctx, w.ID)
+		require.NoError(t, err)
+		assert.Len(t, found, 2)
+		assert.Equal(t, 1, countOutbox(w))
+	})
+
+	t.Run("fnがエラーを返した場合は全ての書き込みをロールバックする", func(t *testing.T) {
+		w := walk.NewWalk("user-123", "Rolled back", "")
+		failure := errors.New("boom")
+
+		err := txManager.WithinTx(ctx, func(ctx context.Context) error {
+			if err := walkRepo.Create(ctx, w); err != nil {
+				return err
+			}
+			if err := locationRepo.BatchCreate(ctx, newLocations(w)); err != nil {
+				return err
+			}
+			return failure
+		})
+		require.ErrorIs(t, err, failure)
+
+		// 期待値: Walk・位置情報・アウトボックスのいずれも残らない
+		_, err = walkRepo.FindByID(ctx, w.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		found, err := locationRepo.FindByWalkID(ctx, w.ID)
+		require.NoError(t, err)
+		assert.Empty(t, found)
+		assert.Equal(t, 0, countOutbox(w))
+	})
+
+	t.Run("入れ子のWithinTxは外側のトランザクションに参加する", func(t *testing.T) {
+		w := walk.NewWalk("user-123", "Nested", "")
+		failure := errors.New("boom")
+
+		err := txManager.WithinTx(ctx, func(ctx context.Context) error {
+			if err := txManager.WithinTx(ctx, func(ctx context.Context) error {
+				return walkRepo.Create(ctx, w)
+			}); err != nil {
+				return err
+			}
+			return failure
+		})
+		require.ErrorIs(t, err, failure)
+
+		// 期待値: 内側で成功した書き込みも外側のロールバックで取り消される
+		_, err = walkRepo.FindByID(ctx, w.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
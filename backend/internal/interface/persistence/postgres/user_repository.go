@@ -25,7 +25,7 @@ func (r *UserRepository) FindByID(ctx context.Context, id string) (*user.User, e
 	`
 
 	u := &user.User{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
 		&u.ID, &u.DisplayName, &u.AuthProvider, &u.CreatedAt, &u.UpdatedAt,
 	)
 	if err != nil {
@@ -42,7 +42,7 @@ func (r *UserRepository) Create(ctx context.Context, u *user.User) error {
 		VALUES ($1, $2, $3, $4, $5)
 	`
 
-	_, err := r.db.ExecContext(
+	_, err := conn(ctx, r.db).ExecContext(
 		ctx, query,
 		u.ID, u.DisplayName, u.AuthProvider, u.CreatedAt, u.UpdatedAt,
 	)
@@ -57,7 +57,7 @@ func (r *UserRepository) CreateIfNotExists(ctx context.Context, u *user.User) er
 		ON CONFLICT (id) DO NOTHING
 	`
 
-	_, err := r.db.ExecContext(
+	_, err := conn(ctx, r.db).ExecContext(
 		ctx, query,
 		u.ID, u.DisplayName, u.AuthProvider, u.CreatedAt, u.UpdatedAt,
 	)
@@ -22,19 +22,42 @@ func NewWalkLocationRepository(db *sql.DB) walk.LocationRepository {
 	}
 }
 
+// locationColumns は1件の位置情報をINSERTする際のバインドパラメータ数
+const locationColumns = 10
+
+// locationBatchSize は1回のINSERT文で書き込む位置情報の最大件数
+// PostgreSQLの1文あたりのバインドパラメータ上限（65535）を超えないようにする
+const locationBatchSize = 65535 / locationColumns
+
 // BatchCreate は複数のWalkLocationを一括作成する（Upsert）
 // ON CONFLICT を使用して既存のレコードは更新する
+// 件数が多い場合は locationBatchSize 件ずつに分割し、同一トランザクションで書き込む
 func (r *WalkLocationRepository) BatchCreate(ctx context.Context, locations []*walk.WalkLocation) error {
 	if len(locations) == 0 {
 		return nil
 	}
 
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		for start := 0; start < len(locations); start += locationBatchSize {
+			end := min(start+locationBatchSize, len(locations))
+			if err := insertLocations(ctx, tx, locations[start:end]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// insertLocations は位置情報を1回のINSERT文で書き込む
+// 件数は locationBatchSize 以下であること
+func insertLocations(ctx context.Context, tx *sql.Tx, locations []*walk.WalkLocation) error {
 	// バッチInsertクエリを構築
 	valueStrings := make([]string, 0, len(locations))
-	valueArgs := make([]interface{}, 0, len(locations)*10)
+	valueArgs := make([]interface{}, 0, len(locations)*locationColumns)
 
 	for i, loc := range locations {
-		base := i * 10
+		base := i * locationColumns
 		valueStrings = append(valueStrings, fmt.Sprintf(
 			"($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
 			base+1, base+2, base+3, base+4, base+5,
@@ -71,12 +94,26 @@ func (r *WalkLocationRepository) BatchCreate(ctx context.Context, locations []*w
 			course = EXCLUDED.course
 	`, strings.Join(valueStrings, ","))
 
-	_, err := r.db.ExecContext(ctx, query, valueArgs...)
+	_, err := tx.ExecContext(ctx, query, valueArgs...)
 	return err
 }
 
 // FindByWalkID はWalkIDで位置情報を取得する（sequence_number順）
 func (r *WalkLocationRepository) FindByWalkID(ctx context.Context, walkID uuid.UUID) ([]*walk.WalkLocation, error) {
+	locations := make([]*walk.WalkLocation, 0)
+	err := r.StreamByWalkID(ctx, walkID, func(loc *walk.WalkLocation) error {
+		locations = append(locations, loc)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return locations, nil
+}
+
+// StreamByWalkID はWalkIDの位置情報を1件ずつコールバックに渡す（sequence_number順）
+func (r *WalkLocationRepository) StreamByWalkID(ctx context.Context, walkID uuid.UUID, fn func(*walk.WalkLocation) error) error {
 	query := `
 		SELECT id, walk_id, latitude, longitude, altitude, timestamp,
 		       horizontal_accuracy, vertical_accuracy, speed, course, sequence_number
@@ -85,13 +122,12 @@ func (r *WalkLocationRepository) FindByWalkID(ctx context.Context, walkID uuid.U
 		ORDER BY sequence_number ASC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, walkID)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, walkID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer rows.Close()
 
-	locations := make([]*walk.WalkLocation, 0)
 	for rows.Next() {
 		loc := &walk.WalkLocation{}
 		if err = rows.Scan(
@@ -107,21 +143,19 @@ func (r *WalkLocationRepository) FindByWalkID(ctx context.Context, walkID uuid.U
 			&loc.Course,
 			&loc.SequenceNumber,
 		); err != nil {
-			return nil, err
+			return err
+		}
+		if err = fn(loc); err != nil {
+			return err
 		}
-		locations = append(locations, loc)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, err
 	}
 
-	return locations, nil
+	return rows.Err()
 }
 
 // DeleteByWalkID はWalkIDに紐づく全ての位置情報を削除する
 func (r *WalkLocationRepository) DeleteByWalkID(ctx context.Context, walkID uuid.UUID) error {
 	query := `DELETE FROM walk_locations WHERE walk_id = $1`
-	_, err := r.db.ExecContext(ctx, query, walkID)
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, walkID)
 	return err
 }
@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalkLocationRepository_BatchCreate_SplitsLargeBatches(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	walkRepo := NewWalkRepository(db)
+	repo := NewWalkLocationRepository(db)
+	ctx := context.Background()
+
+	createTestUser(t, db, "user-123")
+	w := walk.NewWalk("user-123", "Long Walk", "")
+	require.NoError(t, walkRepo.Create(ctx, w))
+
+	// 1文のバインドパラメータ上限（65535）を超える件数
+	count := locationBatchSize*2 + 1
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	locations := make([]*walk.WalkLocation, count)
+	for i := range locations {
+		locations[i] = walk.NewWalkLocationWithOptionals(
+			w.ID, 35.0, 139.0+float64(i)*0.00001, nil, start.Add(time.Duration(i)*time.Second), nil, nil, nil, nil, i,
+		)
+	}
+
+	// 期待値: 分割して書き込まれ、全件が保存される
+	require.NoError(t, repo.BatchCreate(ctx, locations))
+
+	found, err := repo.FindByWalkID(ctx, w.ID)
+	require.NoError(t, err)
+	require.Len(t, found, count)
+	assert.Equal(t, count-1, found[count-1].SequenceNumber)
+}
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/lib/pq"
+)
+
+// walkSortColumns は並び替え項目とカラムの対応
+// ORDER BY句にはこの表の値のみを埋め込み、リクエスト値を直接SQLに含めない
+var walkSortColumns = map[walk.SortField]string{
+	walk.SortByCreatedAt: "created_at",
+	walk.SortByStartTime: "start_time",
+	walk.SortByDistance:  "total_distance",
+	walk.SortByDuration:  "moving_time",
+}
+
+// likeEscaper はLIKEパターンのメタ文字をエスケープする
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// walkQueryBuilder は検索条件からWHERE句とプレースホルダー引数を組み立てる
+// 条件値はすべてプレースホルダー経由で渡す
+type walkQueryBuilder struct {
+	conditions []string
+	args       []interface{}
+}
+
+// newWalkQueryBuilder はユーザーIDと絞り込み条件からビルダーを生成する
+func newWalkQueryBuilder(userID string, filter walk.WalkFilter) *walkQueryBuilder {
+	b := &walkQueryBuilder{}
+	b.where("user_id = %s", userID)
+
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, len(filter.Statuses))
+		for i, s := range filter.Statuses {
+			statuses[i] = string(s)
+		}
+		b.where("status = ANY(%s::walk_status[])", pq.Array(statuses))
+	}
+	if filter.StartFrom != nil {
+		b.where("start_time >= %s", *filter.StartFrom)
+	}
+	if filter.StartTo != nil {
+		b.where("start_time < %s", *filter.StartTo)
+	}
+	if filter.MinDistance != nil {
+		b.where("total_distance >= %s", *filter.MinDistance)
+	}
+	if filter.MaxDistance != nil {
+		b.where("total_distance <= %s", *filter.MaxDistance)
+	}
+	if filter.Query != "" {
+		pattern := "%" + likeEscaper.Replace(filter.Query) + "%"
+		b.where("(title ILIKE %[1]s OR description ILIKE %[1]s)", pattern)
+	}
+	if filter.Tag != "" {
+		b.where("EXISTS (SELECT 1 FROM walk_tags wt JOIN tags t ON t.id = wt.tag_id"+
+			" WHERE wt.walk_id = walks.id AND LOWER(t.name) = LOWER(%s))", filter.Tag)
+	}
+
+	return b
+}
+
+// where は条件を追加する。format中の %s は引数のプレースホルダーに置き換える
+func (b *walkQueryBuilder) where(format string, arg interface{}) {
+	b.args = append(b.args, arg)
+	b.conditions = append(b.conditions, fmt.Sprintf(format, fmt.Sprintf("$%d", len(b.args))))
+}
+
+// after はカーソルより後ろの行に限定する条件を追加する
+// キーセットページネーションは作成日時順の場合のみ対応する
+func (b *walkQueryBuilder) after(cursor *walk.ListCursor, sort walk.SortOption) error {
+	if cursor == nil {
+		return nil
+	}
+	if sort.Field != walk.SortByCreatedAt {
+		return fmt.Errorf("cursor pagination is not supported for sort field %q", sort.Field)
+	}
+
+	op := "<"
+	if sort.Order == walk.SortAsc {
+		op = ">"
+	}
+	b.args = append(b.args, cursor.CreatedAt, cursor.ID)
+	b.conditions = append(b.conditions,
+		fmt.Sprintf("(created_at, id) %s ($%d, $%d)", op, len(b.args)-1, len(b.args)))
+	return nil
+}
+
+// whereClause はWHERE句を返す
+func (b *walkQueryBuilder) whereClause() string {
+	return "WHERE " + strings.Join(b.conditions, " AND ")
+}
+
+// limitClause はLIMIT/OFFSET句を返す（offsetが0の場合はOFFSETを省略する）
+func (b *walkQueryBuilder) limitClause(limit, offset int) string {
+	b.args = append(b.args, limit)
+	clause := fmt.Sprintf("LIMIT $%d", len(b.args))
+	if offset > 0 {
+		b.args = append(b.args, offset)
+		clause += fmt.Sprintf(" OFFSET $%d", len(b.args))
+	}
+	return clause
+}
+
+// walkOrderByClause は並び替え条件からORDER BY句を返す
+// 同値の行の順序を安定させるため、常にidを第2キーにする
+func walkOrderByClause(sort walk.SortOption) (string, error) {
+	column, ok := walkSortColumns[sort.Field]
+	if !ok {
+		return "", fmt.Errorf("unsupported sort field %q", sort.Field)
+	}
+
+	direction := "DESC"
+	if sort.Order == walk.SortAsc {
+		direction = "ASC"
+	}
+
+	// 未開始の散歩（start_timeがNULL）は並び順によらず末尾にする
+	nulls := ""
+	if sort.Field == walk.SortByStartTime {
+		nulls = " NULLS LAST"
+	}
+
+	return fmt.Sprintf("ORDER BY %s %s%s, id %s", column, direction, nulls, direction), nil
+}
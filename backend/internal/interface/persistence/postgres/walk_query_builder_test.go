@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalkQueryBuilder_Filter(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	minDistance := 1000.0
+	maxDistance := 5000.0
+
+	b := newWalkQueryBuilder("user-123", walk.WalkFilter{
+		Statuses:    []walk.WalkStatus{walk.StatusCompleted, walk.StatusPaused},
+		StartFrom:   &from,
+		StartTo:     &to,
+		MinDistance: &minDistance,
+		MaxDistance: &maxDistance,
+		Query:       `50%_off\`,
+	})
+
+	// 期待値: すべての条件がプレースホルダー付きでANDされる
+	assert.Equal(t,
+		"WHERE user_id = $1 AND status = ANY($2::walk_status[]) AND start_time >= $3 AND start_time < $4"+
+			" AND total_distance >= $5 AND total_distance <= $6 AND (title ILIKE $7 OR description ILIKE $7)",
+		b.whereClause())
+
+	// 期待値: 条件値は引数として渡され、LIKEのメタ文字はエスケープされる
+	require.Len(t, b.args, 7)
+	assert.Equal(t, "user-123", b.args[0])
+	assert.Equal(t, pq.Array([]string{"completed", "paused"}), b.args[1])
+	assert.Equal(t, `%50\%\_off\\%`, b.args[6])
+}
+
+func TestWalkQueryBuilder_NoFilter(t *testing.T) {
+	// 期待値: 絞り込み条件がなければユーザーIDのみ
+	b := newWalkQueryBuilder("user-123", walk.WalkFilter{})
+	assert.Equal(t, "WHERE user_id = $1", b.whereClause())
+
+	// 期待値: OFFSETが0の場合は省略される
+	assert.Equal(t, "LIMIT $2", b.limitClause(20, 0))
+	assert.Equal(t, []interface{}{"user-123", 20}, b.args)
+}
+
+func TestWalkQueryBuilder_Tag(t *testing.T) {
+	// 期待値: タグ名は大文字小文字を区別せず、walk_tags の存在で絞り込む
+	b := newWalkQueryBuilder("user-123", walk.WalkFilter{Tag: "Kyoto"})
+	assert.Equal(t,
+		"WHERE user_id = $1 AND EXISTS (SELECT 1 FROM walk_tags wt JOIN tags t ON t.id = wt.tag_id"+
+			" WHERE wt.walk_id = walks.id AND LOWER(t.name) = LOWER($2))",
+		b.whereClause())
+	assert.Equal(t, []interface{}{"user-123", "Kyoto"}, b.args)
+}
+
+func TestWalkQueryBuilder_After(t *testing.T) {
+	cursor := &walk.ListCursor{CreatedAt: time.Now(), ID: uuid.New()}
+
+	// 期待値: 降順では (created_at, id) がカーソルより小さい行に限定する
+	b := newWalkQueryBuilder("user-123", walk.WalkFilter{})
+	require.NoError(t, b.after(cursor, walk.SortOption{Field: walk.SortByCreatedAt, Order: walk.SortDesc}))
+	assert.Equal(t, "WHERE user_id = $1 AND (created_at, id) < ($2, $3)", b.whereClause())
+
+	// 期待値: 昇順では大きい行に限定する
+	b = newWalkQueryBuilder("user-123", walk.WalkFilter{})
+	require.NoError(t, b.after(cursor, walk.SortOption{Field: walk.SortByCreatedAt, Order: walk.SortAsc}))
+	assert.Equal(t, "WHERE user_id = $1 AND (created_at, id) > ($2, $3)", b.whereClause())
+
+	// 期待値: 作成日時以外の並び替えではエラー
+	b = newWalkQueryBuilder("user-123", walk.WalkFilter{})
+	assert.Error(t, b.after(cursor, walk.SortOption{Field: walk.SortByDistance, Order: walk.SortDesc}))
+}
+
+func TestWalkOrderByClause(t *testing.T) {
+	tests := []struct {
+		name string
+		sort walk.SortOption
+		want string
+	}{
+		{
+			// 期待値: 作成日時の降順
+			name: "created_at desc",
+			sort: walk.SortOption{Field: walk.SortByCreatedAt, Order: walk.SortDesc},
+			want: "ORDER BY created_at DESC, id DESC",
+		},
+		{
+			// 期待値: 開始時刻は未開始の散歩を末尾にする
+			name: "start_time asc",
+			sort: walk.SortOption{Field: walk.SortByStartTime, Order: walk.SortAsc},
+			want: "ORDER BY start_time ASC NULLS LAST, id ASC",
+		},
+		{
+			// 期待値: 距離はtotal_distanceカラム
+			name: "distance desc",
+			sort: walk.SortOption{Field: walk.SortByDistance, Order: walk.SortDesc},
+			want: "ORDER BY total_distance DESC, id DESC",
+		},
+		{
+			// 期待値: 所要時間はmoving_timeカラム
+			name: "duration asc",
+			sort: walk.SortOption{Field: walk.SortByDuration, Order: walk.SortAsc},
+			want: "ORDER BY moving_time ASC, id ASC",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := walkOrderByClause(tt.sort)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	// 期待値: 未定義の並び替え項目はSQLに埋め込まずエラーにする
+	_, err := walkOrderByClause(walk.SortOption{Field: "title; DROP TABLE walks", Order: walk.SortDesc})
+	assert.Error(t, err)
+}
@@ -3,11 +3,48 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+// walkSelectColumns はWalkを取得する際のカラム（scanWalkの読み取り順と一致させる）
+// タグ名は名前順の配列として同じ行で取得する
+const walkSelectColumns = `id, user_id, title, description, start_time, end_time,
+		       total_distance, total_steps, polyline_data, thumbnail_image_url,
+		       status, paused_at, total_paused_duration,
+		       moving_time, average_pace, max_speed, visibility, created_at, updated_at, version,
+		       ARRAY(
+		         SELECT t.name FROM walk_tags wt JOIN tags t ON t.id = wt.tag_id
+		         WHERE wt.walk_id = walks.id
+		         ORDER BY LOWER(t.name)
+		       ) AS tags`
+
+// rowScanner は *sql.Row と *sql.Rows に共通する読み取りインターフェース
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanWalk は walkSelectColumns の1行をWalkに読み取る
+func scanWalk(row rowScanner) (*walk.Walk, error) {
+	w := &walk.Walk{}
+	var tags []string
+	if err := row.Scan(
+		&w.ID, &w.UserID, &w.Title, &w.Description, &w.StartTime, &w.EndTime,
+		&w.TotalDistance, &w.TotalSteps, &w.PolylineData, &w.ThumbnailImageURL,
+		&w.Status, &w.PausedAt, &w.TotalPausedDuration,
+		&w.MovingTime, &w.AveragePace, &w.MaxSpeed, &w.Visibility, &w.CreatedAt, &w.UpdatedAt, &w.Version,
+		pq.Array(&tags),
+	); err != nil {
+		return nil, err
+	}
+	w.Tags = tags
+	return w, nil
+}
+
 // WalkRepository はPostgreSQLを使用したWalkリポジトリ実装
 type WalkRepository struct {
 	db *sql.DB
@@ -20,47 +57,65 @@ func NewWalkRepository(db *sql.DB) walk.Repository {
 	}
 }
 
-// Create は新しいWalkを作成する
+// saveWithEvents はWalkの行の書き込みと、保存されていないドメインイベントのアウトボックスへの書き込みを
+// 同じトランザクションで行い、書き込み後にイベントをWalkから取り除く
+// ctx がトランザクションを持つ場合はそのトランザクションで書き込み、コミットは呼び出し元に任せる
+// イベントがない場合はトランザクションを使わずに書き込む
+func (r *WalkRepository) saveWithEvents(ctx context.Context, w *walk.Walk, write func(db dbtx) error) error {
+	events := w.PendingEvents()
+	if len(events) == 0 {
+		return write(conn(ctx, r.db))
+	}
+
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		if err := write(tx); err != nil {
+			return err
+		}
+		return insertOutboxEvents(ctx, tx, events)
+	})
+	if err != nil {
+		return err
+	}
+
+	// 同じトランザクションで再度保存した場合にイベントを重複して書き込まないよう、ここで取り除く
+	w.ClearEvents()
+	return nil
+}
+
+// Create は新しいWalkを作成する（版数は1から始まる）
 func (r *WalkRepository) Create(ctx context.Context, w *walk.Walk) error {
 	query := `
 		INSERT INTO walks (
 			id, user_id, title, description, start_time, end_time,
 			total_distance, total_steps, polyline_data, thumbnail_image_url,
-			status, paused_at, total_paused_duration, created_at, updated_at
+			status, paused_at, total_paused_duration,
+			moving_time, average_pace, max_speed, visibility, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19
 		)
+		RETURNING version
 	`
 
-	_, err := r.db.ExecContext(
-		ctx, query,
-		w.ID, w.UserID, w.Title, w.Description, w.StartTime, w.EndTime,
-		w.TotalDistance, w.TotalSteps, w.PolylineData, w.ThumbnailImageURL,
-		w.Status, w.PausedAt, w.TotalPausedDuration, w.CreatedAt, w.UpdatedAt,
-	)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return r.saveWithEvents(ctx, w, func(db dbtx) error {
+		return db.QueryRowContext(
+			ctx, query,
+			w.ID, w.UserID, w.Title, w.Description, w.StartTime, w.EndTime,
+			w.TotalDistance, w.TotalSteps, w.PolylineData, w.ThumbnailImageURL,
+			w.Status, w.PausedAt, w.TotalPausedDuration,
+			w.MovingTime, w.AveragePace, w.MaxSpeed, w.Visibility, w.CreatedAt, w.UpdatedAt,
+		).Scan(&w.Version)
+	})
 }
 
 // FindByID はIDでWalkを取得する
 func (r *WalkRepository) FindByID(ctx context.Context, id uuid.UUID) (*walk.Walk, error) {
 	query := `
-		SELECT id, user_id, title, description, start_time, end_time,
-		       total_distance, total_steps, polyline_data, thumbnail_image_url,
-		       status, paused_at, total_paused_duration, created_at, updated_at
+		SELECT ` + walkSelectColumns + `
 		FROM walks
 		WHERE id = $1
 	`
 
-	w := &walk.Walk{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&w.ID, &w.UserID, &w.Title, &w.Description, &w.StartTime, &w.EndTime,
-		&w.TotalDistance, &w.TotalSteps, &w.PolylineData, &w.ThumbnailImageURL,
-		&w.Status, &w.PausedAt, &w.TotalPausedDuration, &w.CreatedAt, &w.UpdatedAt,
-	)
+	w, err := scanWalk(conn(ctx, r.db).QueryRowContext(ctx, query, id))
 	if err == sql.ErrNoRows {
 		return nil, sql.ErrNoRows
 	}
@@ -74,16 +129,14 @@ func (r *WalkRepository) FindByID(ctx context.Context, id uuid.UUID) (*walk.Walk
 // FindByUserID はユーザーIDでWalkの一覧を取得する
 func (r *WalkRepository) FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*walk.Walk, error) {
 	query := `
-		SELECT id, user_id, title, description, start_time, end_time,
-		       total_distance, total_steps, polyline_data, thumbnail_image_url,
-		       status, paused_at, total_paused_duration, created_at, updated_at
+		SELECT ` + walkSelectColumns + `
 		FROM walks
 		WHERE user_id = $1
-		ORDER BY created_at DESC
+		ORDER BY created_at DESC, id DESC
 		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID, limit, offset)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -91,12 +144,56 @@ func (r *WalkRepository) FindByUserID(ctx context.Context, userID string, limit,
 
 	walks := make([]*walk.Walk, 0)
 	for rows.Next() {
-		w := &walk.Walk{}
-		if err = rows.Scan(
-			&w.ID, &w.UserID, &w.Title, &w.Description, &w.StartTime, &w.EndTime,
-			&w.TotalDistance, &w.TotalSteps, &w.PolylineData, &w.ThumbnailImageURL,
-			&w.Status, &w.PausedAt, &w.TotalPausedDuration, &w.CreatedAt, &w.UpdatedAt,
-		); err != nil {
+		w, err := scanWalk(rows)
+		if err != nil {
+			return nil, err
+		}
+		walks = append(walks, w)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return walks, nil
+}
+
+// FindByCriteria は検索条件に一致するWalkの一覧を取得する
+func (r *WalkRepository) FindByCriteria(ctx context.Context, criteria walk.ListCriteria) ([]*walk.Walk, error) {
+	sort := criteria.Sort.Normalize()
+
+	orderBy, err := walkOrderByClause(sort)
+	if err != nil {
+		return nil, err
+	}
+
+	b := newWalkQueryBuilder(criteria.UserID, criteria.Filter)
+	offset := criteria.Offset
+	if criteria.After != nil {
+		if err := b.after(criteria.After, sort); err != nil {
+			return nil, err
+		}
+		offset = 0
+	}
+
+	query := fmt.Sprintf(`
+		SELECT `+walkSelectColumns+`
+		FROM walks
+		%s
+		%s
+		%s
+	`, b.whereClause(), orderBy, b.limitClause(criteria.Limit, offset))
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, b.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	walks := make([]*walk.Walk, 0)
+	for rows.Next() {
+		w, err := scanWalk(rows)
+		if err != nil {
 			return nil, err
 		}
 		walks = append(walks, w)
@@ -110,6 +207,7 @@ func (r *WalkRepository) FindByUserID(ctx context.Context, userID string, limit,
 }
 
 // Update はWalkを更新する
+// 保存済みの版数が w.Version と一致する場合のみ更新し、版数を1増やす
 func (r *WalkRepository) Update(ctx context.Context, w *walk.Walk) error {
 	query := `
 		UPDATE walks SET
@@ -125,40 +223,49 @@ func (r *WalkRepository) Update(ctx context.Context, w *walk.Walk) error {
 			status = $11,
 			paused_at = $12,
 			total_paused_duration = $13,
-			updated_at = $14
-		WHERE id = $1
+			moving_time = $14,
+			average_pace = $15,
+			max_speed = $16,
+			visibility = $17,
+			updated_at = $18,
+			version = version + 1
+		WHERE id = $1 AND version = $19
+		RETURNING version
 	`
 
-	result, err := r.db.ExecContext(
-		ctx, query,
-		w.ID, w.UserID, w.Title, w.Description, w.StartTime, w.EndTime,
-		w.TotalDistance, w.TotalSteps, w.PolylineData, w.ThumbnailImageURL,
-		w.Status, w.PausedAt, w.TotalPausedDuration, w.UpdatedAt,
-	)
-	if err != nil {
-		return err
-	}
-
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return err
-	}
-
-	if rowsAffected == 0 {
-		return sql.ErrNoRows
-	}
+	return r.saveWithEvents(ctx, w, func(db dbtx) error {
+		var version int
+		err := db.QueryRowContext(
+			ctx, query,
+			w.ID, w.UserID, w.Title, w.Description, w.StartTime, w.EndTime,
+			w.TotalDistance, w.TotalSteps, w.PolylineData, w.ThumbnailImageURL,
+			w.Status, w.PausedAt, w.TotalPausedDuration,
+			w.MovingTime, w.AveragePace, w.MaxSpeed, w.Visibility, w.UpdatedAt,
+			w.Version,
+		).Scan(&version)
+		if err == sql.ErrNoRows {
+			return versionConflictOrNotFound(ctx, db, w.ID)
+		}
+		if err != nil {
+			return err
+		}
 
-	return nil
+		w.Version = version
+		return nil
+	})
 }
 
 // Upsert はWalkを作成または更新する（存在しなければ作成、存在すれば更新）
+// 存在する場合は保存済みの版数が w.Version と一致する場合のみ更新し、版数を1増やす
+// 未保存のWalk（w.Version が0）と同じIDの行が既にある場合は ErrVersionMismatch を返す
 func (r *WalkRepository) Upsert(ctx context.Context, w *walk.Walk) error {
 	query := `
 		INSERT INTO walks (
 			id, user_id, title, description, start_time, end_time,
 			total_distance, total_steps, polyline_data, thumbnail_image_url,
-			status, paused_at, total_paused_duration, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+			status, paused_at, total_paused_duration,
+			moving_time, average_pace, max_speed, visibility, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
 		ON CONFLICT (id) DO UPDATE SET
 			user_id = EXCLUDED.user_id,
 			title = EXCLUDED.title,
@@ -172,23 +279,46 @@ func (r *WalkRepository) Upsert(ctx context.Context, w *walk.Walk) error {
 			status = EXCLUDED.status,
 			paused_at = EXCLUDED.paused_at,
 			total_paused_duration = EXCLUDED.total_paused_duration,
-			updated_at = EXCLUDED.updated_at
+			moving_time = EXCLUDED.moving_time,
+			average_pace = EXCLUDED.average_pace,
+			max_speed = EXCLUDED.max_speed,
+			visibility = EXCLUDED.visibility,
+			updated_at = EXCLUDED.updated_at,
+			version = walks.version + 1
+		WHERE walks.version = $20
+		RETURNING version
 	`
 
-	_, err := r.db.ExecContext(
-		ctx, query,
-		w.ID, w.UserID, w.Title, w.Description, w.StartTime, w.EndTime,
-		w.TotalDistance, w.TotalSteps, w.PolylineData, w.ThumbnailImageURL,
-		w.Status, w.PausedAt, w.TotalPausedDuration, w.CreatedAt, w.UpdatedAt,
-	)
-	return err
+	return r.saveWithEvents(ctx, w, func(db dbtx) error {
+		var version int
+		err := db.QueryRowContext(
+			ctx, query,
+			w.ID, w.UserID, w.Title, w.Description, w.StartTime, w.EndTime,
+			w.TotalDistance, w.TotalSteps, w.PolylineData, w.ThumbnailImageURL,
+			w.Status, w.PausedAt, w.TotalPausedDuration,
+			w.MovingTime, w.AveragePace, w.MaxSpeed, w.Visibility, w.CreatedAt, w.UpdatedAt,
+			w.Version,
+		).Scan(&version)
+		// 行が返らないのは既存の行の版数が一致せず、更新されなかった場合のみ
+		if err == sql.ErrNoRows {
+			return walk.ErrVersionMismatch
+		}
+		if err != nil {
+			return err
+		}
+
+		w.Version = version
+		return nil
+	})
 }
 
-// Delete はWalkを削除する
-func (r *WalkRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM walks WHERE id = $1`
+// UpdateThumbnailURL はWalkのサムネイル画像のURLのみを更新する
+// 散歩の完了後に生成するため、他の項目は上書きしない
+// Update・Upsert が読み込み前のサムネイルURLで上書きしないよう、版数を1増やす
+func (r *WalkRepository) UpdateThumbnailURL(ctx context.Context, id uuid.UUID, url string) error {
+	query := `UPDATE walks SET thumbnail_image_url = $2, updated_at = NOW(), version = version + 1 WHERE id = $1`
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, url)
 	if err != nil {
 		return err
 	}
@@ -205,12 +335,115 @@ func (r *WalkRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// ReplaceTags はWalkのタグを w.Tags の名前で置き換える
+// タグ名は大文字小文字を区別せずに既存のタグと照合し、存在しないものは作成する
+func (r *WalkRepository) ReplaceTags(ctx context.Context, w *walk.Walk) error {
+	lowerNames := make([]string, len(w.Tags))
+	for i, name := range w.Tags {
+		lowerNames[i] = strings.ToLower(name)
+	}
+
+	var tags []string
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		if len(w.Tags) > 0 {
+			createQuery := `
+				INSERT INTO tags (id, user_id, name)
+				SELECT gen_random_uuid(), $1, name FROM UNNEST($2::text[]) AS name
+				ON CONFLICT (user_id, LOWER(name)) DO NOTHING
+			`
+			if _, err := tx.ExecContext(ctx, createQuery, w.UserID, pq.Array(w.Tags)); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM walk_tags WHERE walk_id = $1`, w.ID); err != nil {
+			return err
+		}
+
+		linkQuery := `
+			INSERT INTO walk_tags (walk_id, tag_id)
+			SELECT $1, id FROM tags
+			WHERE user_id = $2 AND LOWER(name) = ANY($3::text[])
+		`
+		if _, err := tx.ExecContext(ctx, linkQuery, w.ID, w.UserID, pq.Array(lowerNames)); err != nil {
+			return err
+		}
+
+		// 既存のタグと照合した結果の表記を、取得時と同じ名前順で反映する
+		namesQuery := `
+			SELECT ARRAY(
+				SELECT t.name FROM walk_tags wt JOIN tags t ON t.id = wt.tag_id
+				WHERE wt.walk_id = $1
+				ORDER BY LOWER(t.name)
+			)
+		`
+		return tx.QueryRowContext(ctx, namesQuery, w.ID).Scan(pq.Array(&tags))
+	})
+	if err != nil {
+		return err
+	}
+
+	w.Tags = tags
+	return nil
+}
+
+// Delete はWalkを削除する
+// 保存済みの版数が w.Version と一致する場合のみ削除する
+func (r *WalkRepository) Delete(ctx context.Context, w *walk.Walk) error {
+	query := `DELETE FROM walks WHERE id = $1 AND version = $2`
+
+	return r.saveWithEvents(ctx, w, func(db dbtx) error {
+		result, err := db.ExecContext(ctx, query, w.ID, w.Version)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return versionConflictOrNotFound(ctx, db, w.ID)
+		}
+
+		return nil
+	})
+}
+
+// versionConflictOrNotFound は版数を指定した書き込みが0件だった理由を返す
+// 行が存在する場合は版数が一致しなかったため ErrVersionMismatch、存在しない場合は sql.ErrNoRows を返す
+func versionConflictOrNotFound(ctx context.Context, db dbtx, id uuid.UUID) error {
+	var exists bool
+	if err := db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM walks WHERE id = $1)`, id).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return walk.ErrVersionMismatch
+	}
+	return sql.ErrNoRows
+}
+
 // Count はユーザーのWalk総数を取得する
 func (r *WalkRepository) Count(ctx context.Context, userID string) (int, error) {
 	query := `SELECT COUNT(*) FROM walks WHERE user_id = $1`
 
 	var count int
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(&count)
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// CountByCriteria は絞り込み条件に一致するユーザーのWalk総数を取得する
+func (r *WalkRepository) CountByCriteria(ctx context.Context, userID string, filter walk.WalkFilter) (int, error) {
+	b := newWalkQueryBuilder(userID, filter)
+	query := `SELECT COUNT(*) FROM walks ` + b.whereClause()
+
+	var count int
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, b.args...).Scan(&count)
 	if err != nil {
 		return 0, err
 	}
@@ -44,7 +44,7 @@ func setupTestDB(t *testing.T) *sql.DB {
 
 // cleanupTestDB はテストデータをクリーンアップする
 func cleanupTestDB(t *testing.T, db *sql.DB) {
-	_, err := db.Exec("TRUNCATE TABLE walks, users CASCADE")
+	_, err := db.Exec("TRUNCATE TABLE walks, users, outbox_events CASCADE")
 	require.NoError(t, err)
 }
 
@@ -105,6 +105,7 @@ func TestWalkRepository_FindByID(t *testing.T) {
 	assert.Equal(t, w.ID, found.ID)
 	assert.Equal(t, w.UserID, found.UserID)
 	assert.Equal(t, w.Title, found.Title)
+	assert.Equal(t, walk.VisibilityPrivate, found.Visibility)
 }
 
 func TestWalkRepository_FindByID_NotFound(t *testing.T) {
@@ -186,6 +187,119 @@ func TestWalkRepository_FindByUserID_Pagination(t *testing.T) {
 	assert.Len(t, walks, 2)
 }
 
+func TestWalkRepository_FindByCriteria_Cursor(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	repo := NewWalkRepository(db)
+	ctx := context.Background()
+
+	userID := "user-123"
+
+	// テスト用ユーザー作成
+	createTestUser(t, db, userID)
+
+	// 5つのWalkを作成
+	for i := 0; i < 5; i++ {
+		w := walk.NewWalk(userID, fmt.Sprintf("Walk %d", i), "Description")
+		require.NoError(t, repo.Create(ctx, w))
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// 1ページ目: カーソルなし
+	first, err := repo.FindByCriteria(ctx, walk.ListCriteria{UserID: userID, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, first, 2)
+	assert.Equal(t, "Walk 4", first[0].Title)
+
+	// 1ページ目の後に作成されたWalkは2ページ目に影響しない
+	require.NoError(t, repo.Create(ctx, walk.NewWalk(userID, "Walk 5", "Description")))
+
+	// 2ページ目: 1ページ目の最後の要素をカーソルにする
+	cursor := walk.CursorOf(first[1])
+	second, err := repo.FindByCriteria(ctx, walk.ListCriteria{UserID: userID, Limit: 2, After: &cursor})
+	require.NoError(t, err)
+	require.Len(t, second, 2)
+	assert.Equal(t, "Walk 2", second[0].Title)
+	assert.Equal(t, "Walk 1", second[1].Title)
+}
+
+func TestWalkRepository_FindByCriteria_FilterAndSort(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	repo := NewWalkRepository(db)
+	ctx := context.Background()
+
+	userID := "user-123"
+	createTestUser(t, db, userID)
+
+	base := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	fixtures := []struct {
+		title    string
+		status   walk.WalkStatus
+		distance float64
+		start    *time.Time
+	}{
+		{title: "公園を一周", status: walk.StatusCompleted, distance: 1500, start: &base},
+		{title: "駅まで", status: walk.StatusCompleted, distance: 800, start: timePtr(base.Add(48 * time.Hour))},
+		{title: "100%の散歩", status: walk.StatusCompleted, distance: 3000, start: timePtr(base.Add(24 * time.Hour))},
+		{title: "未開始", status: walk.StatusNotStarted, distance: 0, start: nil},
+	}
+	for _, f := range fixtures {
+		w := walk.NewWalk(userID, f.title, "")
+		w.Status = f.status
+		w.TotalDistance = f.distance
+		w.StartTime = f.start
+		require.NoError(t, repo.Create(ctx, w))
+	}
+
+	// 期待値: ステータスと距離で絞り込み、距離の昇順で返す
+	minDistance := 1000.0
+	walks, err := repo.FindByCriteria(ctx, walk.ListCriteria{
+		UserID: userID,
+		Filter: walk.WalkFilter{Statuses: []walk.WalkStatus{walk.StatusCompleted}, MinDistance: &minDistance},
+		Sort:   walk.SortOption{Field: walk.SortByDistance, Order: walk.SortAsc},
+		Limit:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, walks, 2)
+	assert.Equal(t, "公園を一周", walks[0].Title)
+	assert.Equal(t, "100%の散歩", walks[1].Title)
+
+	// 期待値: 開始時刻の降順では未開始の散歩が末尾になる
+	walks, err = repo.FindByCriteria(ctx, walk.ListCriteria{
+		UserID: userID,
+		Sort:   walk.SortOption{Field: walk.SortByStartTime, Order: walk.SortDesc},
+		Limit:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, walks, 4)
+	assert.Equal(t, "駅まで", walks[0].Title)
+	assert.Equal(t, "未開始", walks[3].Title)
+
+	// 期待値: LIKEのメタ文字はリテラルとして扱われる
+	walks, err = repo.FindByCriteria(ctx, walk.ListCriteria{
+		UserID: userID,
+		Filter: walk.WalkFilter{Query: "%"},
+		Limit:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, walks, 1)
+	assert.Equal(t, "100%の散歩", walks[0].Title)
+
+	// 期待値: 件数も同じ絞り込み条件で数える
+	count, err := repo.CountByCriteria(ctx, userID, walk.WalkFilter{Statuses: []walk.WalkStatus{walk.StatusCompleted}})
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
 func TestWalkRepository_Update(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
@@ -206,6 +320,7 @@ func TestWalkRepository_Update(t *testing.T) {
 	w.Description = "Updated Description"
 	w.TotalDistance = 1500.5
 	w.TotalSteps = 2000
+	w.Visibility = walk.VisibilityFriends
 	w.UpdatedAt = time.Now()
 
 	err := repo.Update(ctx, w)
@@ -218,6 +333,7 @@ func TestWalkRepository_Update(t *testing.T) {
 	assert.Equal(t, "Updated Description", found.Description)
 	assert.Equal(t, 1500.5, found.TotalDistance)
 	assert.Equal(t, 2000, found.TotalSteps)
+	assert.Equal(t, walk.VisibilityFriends, found.Visibility)
 }
 
 func TestWalkRepository_Update_NotFound(t *testing.T) {
@@ -234,6 +350,86 @@ func TestWalkRepository_Update_NotFound(t *testing.T) {
 	assert.ErrorIs(t, err, sql.ErrNoRows)
 }
 
+func TestWalkRepository_Version(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	repo := NewWalkRepository(db)
+	ctx := context.Background()
+
+	createTestUser(t, db, "user-123")
+
+	w := walk.NewWalk("user-123", "Original Title", "")
+	require.NoError(t, repo.Create(ctx, w))
+	assert.Equal(t, 1, w.Version)
+
+	stale, err := repo.FindByID(ctx, w.ID)
+	require.NoError(t, err)
+
+	// 期待値: 更新のたびに版数が1増える
+	w.Title = "Updated Title"
+	require.NoError(t, repo.Update(ctx, w))
+	assert.Equal(t, 2, w.Version)
+	require.NoError(t, repo.Upsert(ctx, w))
+	assert.Equal(t, 3, w.Version)
+
+	// 期待値: サムネイルの更新でも版数が増え、読み込み済みのWalkでは上書きできない
+	require.NoError(t, repo.UpdateThumbnailURL(ctx, w.ID, "https://example.com/thumb.png"))
+	found, err := repo.FindByID(ctx, w.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 4, found.Version)
+	assert.ErrorIs(t, repo.Update(ctx, w), walk.ErrVersionMismatch)
+	require.NoError(t, repo.Update(ctx, found))
+	assert.Equal(t, "https://example.com/thumb.png", *found.ThumbnailImageURL)
+
+	// 期待値: 古い版数のWalkは更新・削除できない
+	stale.Title = "Stale Title"
+	assert.ErrorIs(t, repo.Update(ctx, stale), walk.ErrVersionMismatch)
+	assert.ErrorIs(t, repo.Upsert(ctx, stale), walk.ErrVersionMismatch)
+	assert.ErrorIs(t, repo.Delete(ctx, stale), walk.ErrVersionMismatch)
+	assert.Equal(t, 1, stale.Version)
+
+	// 期待値: 未保存のWalkと同じIDの行がある場合は作成しない
+	duplicate := walk.NewWalk("user-123", "Duplicate", "")
+	duplicate.ID = w.ID
+	assert.ErrorIs(t, repo.Upsert(ctx, duplicate), walk.ErrVersionMismatch)
+
+	found, err = repo.FindByID(ctx, w.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Updated Title", found.Title)
+}
+
+func TestWalkRepository_UpdateThumbnailURL(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	defer cleanupTestDB(t, db)
+
+	repo := NewWalkRepository(db)
+	ctx := context.Background()
+
+	// テスト用ユーザー作成
+	createTestUser(t, db, "user-123")
+
+	w := walk.NewWalk("user-123", "Test Walk", "Test Description")
+	require.NoError(t, repo.Create(ctx, w))
+
+	// サムネイルURLを更新
+	err := repo.UpdateThumbnailURL(ctx, w.ID, "https://example.com/thumb.png")
+	require.NoError(t, err)
+
+	// 検証: サムネイルURLのみが更新されている
+	found, err := repo.FindByID(ctx, w.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found.ThumbnailImageURL)
+	assert.Equal(t, "https://example.com/thumb.png", *found.ThumbnailImageURL)
+	assert.Equal(t, "Test Walk", found.Title)
+
+	// 検証: 存在しないWalkはsql.ErrNoRows
+	err = repo.UpdateThumbnailURL(ctx, walk.NewWalk("user-123", "Test", "Test").ID, "https://example.com/thumb.png")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
 func TestWalkRepository_Delete(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
@@ -250,7 +446,7 @@ func TestWalkRepository_Delete(t *testing.T) {
 	require.NoError(t, repo.Create(ctx, w))
 
 	// 削除
-	err := repo.Delete(ctx, w.ID)
+	err := repo.Delete(ctx, w)
 	require.NoError(t, err)
 
 	// 検証: 削除されたことを確認
@@ -268,7 +464,7 @@ func TestWalkRepository_Delete_NotFound(t *testing.T) {
 
 	// 存在しないWalkを削除
 	w := walk.NewWalk("user-123", "Test", "Test")
-	err := repo.Delete(ctx, w.ID)
+	err := repo.Delete(ctx, w)
 	assert.ErrorIs(t, err, sql.ErrNoRows)
 }
 
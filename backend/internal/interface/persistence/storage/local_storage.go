@@ -0,0 +1,187 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// metadataSuffix はContent-Typeを保存するサイドカーファイルの拡張子
+const metadataSuffix = ".meta.json"
+
+var (
+	// ErrInvalidPath はストレージのディレクトリの外を指すなど、パスが不正であることを表す
+	ErrInvalidPath = errors.New("invalid storage path")
+	// ErrObjectNotFound はファイルが存在しないことを表す
+	ErrObjectNotFound = errors.New("storage object not found")
+)
+
+// metadata はサイドカーファイルに保存するファイルの属性
+type metadata struct {
+	ContentType string `json:"content_type"`
+}
+
+// LocalStorage はローカルのディレクトリを使用したストレージ実装（開発用）
+// ファイルのContent-Typeは同じディレクトリのサイドカーファイル（{name}.meta.json）に保存する
+type LocalStorage struct {
+	baseDir string
+	baseURL string
+}
+
+// NewLocalStorage は新しいLocalStorageを生成する
+// baseDir は存在しない場合に作成する。baseURL は GET /files/*path を提供するAPIのURL
+func NewLocalStorage(baseDir, baseURL string) (*LocalStorage, error) {
+	absDir, err := filepath.Abs(baseDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve storage directory: %w", err)
+	}
+	if err := os.MkdirAll(absDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	return &LocalStorage{
+		baseDir: absDir,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}, nil
+}
+
+// Upload はファイルを保存し、ファイルのURLを返す
+// 書き込み途中のファイルが読まれないよう、一時ファイルに書き込んでから置き換える
+func (s *LocalStorage) Upload(ctx context.Context, path string, content io.Reader, contentType string) (string, error) {
+	fullPath, err := s.resolve(path)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	meta, err := json.Marshal(metadata{ContentType: contentType})
+	if err != nil {
+		return "", err
+	}
+	if err := writeFileAtomic(fullPath+metadataSuffix, bytes.NewReader(meta)); err != nil {
+		return "", err
+	}
+	if err := writeFileAtomic(fullPath, content); err != nil {
+		return "", err
+	}
+
+	return s.GetURL(ctx, path)
+}
+
+// Download はファイルを読み込む。存在しない場合は ErrObjectNotFound を返す
+func (s *LocalStorage) Download(ctx context.Context, path string) (io.ReadCloser, error) {
+	fullPath, err := s.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(fullPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrObjectNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	return f, nil
+}
+
+// ContentType は保存時に指定されたファイルのContent-Typeを返す。存在しない場合は ErrObjectNotFound を返す
+func (s *LocalStorage) ContentType(ctx context.Context, path string) (string, error) {
+	fullPath, err := s.resolve(path)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := os.ReadFile(fullPath + metadataSuffix)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", ErrObjectNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read metadata: %w", err)
+	}
+
+	var meta metadata
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return "", fmt.Errorf("failed to parse metadata: %w", err)
+	}
+	return meta.ContentType, nil
+}
+
+// Delete はファイルとサイドカーファイルを削除する（存在しない場合は何もしない）
+func (s *LocalStorage) Delete(ctx context.Context, path string) error {
+	fullPath, err := s.resolve(path)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range []string{fullPath, fullPath + metadataSuffix} {
+		if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to delete file: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetURL はファイルのURL（{baseURL}/files/{path}）を返す
+func (s *LocalStorage) GetURL(ctx context.Context, path string) (string, error) {
+	if _, err := s.resolve(path); err != nil {
+		return "", err
+	}
+
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		segments[i] = url.PathEscape(seg)
+	}
+	return s.baseURL + "/files/" + strings.Join(segments, "/"), nil
+}
+
+// resolve はストレージ上のパスをディレクトリ内の絶対パスに変換する
+// 絶対パス・".." を含むパス・サイドカーファイルを指すパスは ErrInvalidPath を返す
+func (s *LocalStorage) resolve(path string) (string, error) {
+	if path == "" || strings.HasPrefix(path, "/") || strings.Contains(path, "\\") ||
+		strings.HasSuffix(path, metadataSuffix) {
+		return "", ErrInvalidPath
+	}
+	for _, seg := range strings.Split(path, "/") {
+		if seg == "" || seg == "." || seg == ".." {
+			return "", ErrInvalidPath
+		}
+	}
+
+	fullPath := filepath.Join(s.baseDir, filepath.FromSlash(path))
+	rel, err := filepath.Rel(s.baseDir, fullPath)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return "", ErrInvalidPath
+	}
+	return fullPath, nil
+}
+
+// writeFileAtomic は同じディレクトリの一時ファイルに書き込んでから置き換える
+func writeFileAtomic(path string, content io.Reader) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // 置き換え後は存在しないため失敗は無視する
+
+	if _, err := io.Copy(tmp, content); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to save file: %w", err)
+	}
+	return nil
+}
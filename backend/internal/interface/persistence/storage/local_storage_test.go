@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupLocalStorage(t *testing.T) (*LocalStorage, string) {
+	dir := t.TempDir()
+	s, err := NewLocalStorage(filepath.Join(dir, "storage"), "http://localhost:8080/")
+	require.NoError(t, err)
+	return s, dir
+}
+
+func TestLocalStorage_UploadDownloadDelete(t *testing.T) {
+	s, _ := setupLocalStorage(t)
+	ctx := context.Background()
+	path := "walk_photos/user-1/walk-1/photo 1.png"
+
+	url, err := s.Upload(ctx, path, strings.NewReader("png-bytes"), "image/png")
+
+	// 期待値: パスの各要素をエスケープしたURLを返す
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/files/walk_photos/user-1/walk-1/photo%201.png", url)
+
+	r, err := s.Download(ctx, path)
+	require.NoError(t, err)
+	content, err := io.ReadAll(r)
+	require.NoError(t, r.Close())
+	require.NoError(t, err)
+	assert.Equal(t, "png-bytes", string(content))
+
+	// 期待値: 保存時のContent-Typeをサイドカーファイルから取得できる
+	contentType, err := s.ContentType(ctx, path)
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", contentType)
+
+	// 期待値: 削除後は存在せず、再度の削除もエラーにならない
+	require.NoError(t, s.Delete(ctx, path))
+	_, err = s.Download(ctx, path)
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+	_, err = s.ContentType(ctx, path)
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+	assert.NoError(t, s.Delete(ctx, path))
+}
+
+func TestLocalStorage_Upload_Overwrite(t *testing.T) {
+	s, _ := setupLocalStorage(t)
+	ctx := context.Background()
+
+	_, err := s.Upload(ctx, "a.txt", strings.NewReader("old"), "text/plain")
+	require.NoError(t, err)
+	_, err = s.Upload(ctx, "a.txt", strings.NewReader("new"), "application/json")
+	require.NoError(t, err)
+
+	r, err := s.Download(ctx, "a.txt")
+	require.NoError(t, err)
+	defer r.Close()
+	content, _ := io.ReadAll(r)
+	assert.Equal(t, "new", string(content))
+	contentType, _ := s.ContentType(ctx, "a.txt")
+	assert.Equal(t, "application/json", contentType)
+}
+
+func TestLocalStorage_PathTraversal(t *testing.T) {
+	s, dir := setupLocalStorage(t)
+	ctx := context.Background()
+	secret := filepath.Join(dir, "secret.txt")
+	require.NoError(t, os.WriteFile(secret, []byte("secret"), 0o600))
+
+	paths := []string{
+		"",
+		"../secret.txt",
+		"walk_photos/../../secret.txt",
+		"/etc/passwd",
+		"walk_photos//photo.png",
+		"./photo.png",
+		`..\secret.txt`,
+		"photo.png.meta.json",
+	}
+
+	for _, path := range paths {
+		t.Run(path, func(t *testing.T) {
+			// 期待値: ディレクトリの外やサイドカーファイルを指すパスはすべて拒否する
+			_, err := s.Upload(ctx, path, strings.NewReader("x"), "text/plain")
+			assert.ErrorIs(t, err, ErrInvalidPath)
+			_, err = s.Download(ctx, path)
+			assert.ErrorIs(t, err, ErrInvalidPath)
+			assert.ErrorIs(t, s.Delete(ctx, path), ErrInvalidPath)
+			_, err = s.GetURL(ctx, path)
+			assert.ErrorIs(t, err, ErrInvalidPath)
+		})
+	}
+
+	// 期待値: ディレクトリの外のファイルは変更されない
+	content, err := os.ReadFile(secret)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", string(content))
+}
@@ -7,19 +7,21 @@ import (
 
 // エラーコード定義
 const (
-	CodeInvalidRequest = "INVALID_REQUEST"
-	CodeUnauthorized   = "UNAUTHORIZED"
-	CodeForbidden      = "FORBIDDEN"
-	CodeNotFound       = "NOT_FOUND"
-	CodeConflict       = "CONFLICT"
-	CodeInternalError  = "INTERNAL_ERROR"
+	CodeInvalidRequest     = "INVALID_REQUEST"
+	CodeUnauthorized       = "UNAUTHORIZED"
+	CodeForbidden          = "FORBIDDEN"
+	CodeNotFound           = "NOT_FOUND"
+	CodeConflict           = "CONFLICT"
+	CodePreconditionFailed = "PRECONDITION_FAILED"
+	CodeInternalError      = "INTERNAL_ERROR"
 )
 
 // AppError はアプリケーション固有のエラー型
 type AppError struct {
-	Code    string // エラーコード
-	Message string // エラーメッセージ
-	Err     error  // 元のエラー
+	Code    string      // エラーコード
+	Message string      // エラーメッセージ
+	Details interface{} // エラー詳細（フィールド単位のバリデーションエラーなど）
+	Err     error       // 元のエラー
 }
 
 // Error は error インターフェースの実装
@@ -51,6 +53,16 @@ func NewInvalidRequestError(message string) *AppError {
 	return NewAppError(CodeInvalidRequest, message, nil)
 }
 
+// NewValidationError は詳細付きの不正なリクエストエラーを生成する
+func NewValidationError(message string, details interface{}, err error) *AppError {
+	return &AppError{
+		Code:    CodeInvalidRequest,
+		Message: message,
+		Details: details,
+		Err:     err,
+	}
+}
+
 // NewUnauthorizedError は認証エラーを生成する
 func NewUnauthorizedError(message string) *AppError {
 	return NewAppError(CodeUnauthorized, message, nil)
@@ -71,6 +83,11 @@ func NewConflictError(message string) *AppError {
 	return NewAppError(CodeConflict, message, nil)
 }
 
+// NewPreconditionFailedError は前提条件（If-Matchなど）の不一致エラーを生成する
+func NewPreconditionFailedError(message string) *AppError {
+	return NewAppError(CodePreconditionFailed, message, nil)
+}
+
 // NewInternalError は内部エラーを生成する
 func NewInternalError(message string, err error) *AppError {
 	return NewAppError(CodeInternalError, message, err)
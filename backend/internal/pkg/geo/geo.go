@@ -0,0 +1,5 @@
+package geo
+
+// EarthRadiusMeters は地球の平均半径（メートル）
+// 距離計算とポリライン簡略化で同じ値を使うよう、ここで一元管理する
+const EarthRadiusMeters = 6371000.0
@@ -0,0 +1,32 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidCursor は解析できないカーソルを表すエラー
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// EncodeCursor はカーソル値をクライアント向けの不透明な文字列に変換する
+// クライアントは中身に依存せず、そのまま次のリクエストに渡す
+func EncodeCursor(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// DecodeCursor はEncodeCursorで生成した文字列をカーソル値 v に復元する
+func DecodeCursor(s string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return ErrInvalidCursor
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package pagination
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+}
+
+func TestEncodeDecodeCursor(t *testing.T) {
+	// 期待値: エンコードしたカーソルを同じ値に復元できる
+	want := testCursor{
+		CreatedAt: time.Date(2025, 1, 1, 9, 0, 0, 123456000, time.UTC),
+		ID:        "9b2f6c1e-0000-4000-8000-000000000001",
+	}
+
+	encoded, err := EncodeCursor(want)
+	require.NoError(t, err)
+	assert.NotContains(t, encoded, "=", "URLにそのまま埋め込めること")
+
+	var got testCursor
+	require.NoError(t, DecodeCursor(encoded, &got))
+	assert.True(t, want.CreatedAt.Equal(got.CreatedAt))
+	assert.Equal(t, want.ID, got.ID)
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		// 期待値: base64として不正な文字列はErrInvalidCursor
+		{name: "not base64", cursor: "!!!"},
+		// 期待値: JSONとして不正な内容はErrInvalidCursor
+		{name: "not json", cursor: "bm90LWpzb24"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got testCursor
+			assert.ErrorIs(t, DecodeCursor(tt.cursor, &got), ErrInvalidCursor)
+		})
+	}
+}
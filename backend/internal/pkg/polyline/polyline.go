@@ -0,0 +1,186 @@
+package polyline
+
+import (
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/geo"
+)
+
+const (
+	// precision はGoogleエンコード済みポリラインの座標精度（小数点以下5桁）
+	precision = 1e5
+
+	// DefaultTolerance はDouglas–Peucker簡略化のデフォルト許容誤差（メートル）
+	DefaultTolerance = 5.0
+)
+
+// ErrInvalidPolyline は不正なエンコード文字列を表すエラー
+var ErrInvalidPolyline = errors.New("invalid encoded polyline")
+
+// Point は緯度経度の座標
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// Encode は座標の配列をGoogleエンコード済みポリライン形式に変換する
+// https://developers.google.com/maps/documentation/utilities/polylinealgorithm
+func Encode(points []Point) string {
+	var sb strings.Builder
+	var prevLat, prevLng int64
+
+	for _, p := range points {
+		lat := int64(math.Round(p.Lat * precision))
+		lng := int64(math.Round(p.Lng * precision))
+
+		encodeValue(&sb, lat-prevLat)
+		encodeValue(&sb, lng-prevLng)
+
+		prevLat, prevLng = lat, lng
+	}
+
+	return sb.String()
+}
+
+// encodeValue は1つの差分値をエンコードして書き込む
+func encodeValue(sb *strings.Builder, v int64) {
+	// 符号ビットを最下位に移す（負数はビット反転）
+	u := uint64(v) << 1
+	if v < 0 {
+		u = ^u
+	}
+
+	// 5ビットずつ下位から出力し、続きがある場合は0x20を立てる
+	for u >= 0x20 {
+		sb.WriteByte(byte((0x20 | (u & 0x1f)) + 63))
+		u >>= 5
+	}
+	sb.WriteByte(byte(u + 63))
+}
+
+// Decode はGoogleエンコード済みポリライン形式を座標の配列に変換する
+func Decode(encoded string) ([]Point, error) {
+	points := make([]Point, 0, len(encoded)/4)
+	var lat, lng int64
+
+	for i := 0; i < len(encoded); {
+		dLat, next, err := decodeValue(encoded, i)
+		if err != nil {
+			return nil, err
+		}
+		dLng, next, err := decodeValue(encoded, next)
+		if err != nil {
+			return nil, err
+		}
+		i = next
+
+		lat += dLat
+		lng += dLng
+		points = append(points, Point{
+			Lat: float64(lat) / precision,
+			Lng: float64(lng) / precision,
+		})
+	}
+
+	return points, nil
+}
+
+// decodeValue は位置 start から1つの差分値を読み取り、値と次の読み取り位置を返す
+func decodeValue(encoded string, start int) (int64, int, error) {
+	var result uint64
+	var shift uint
+
+	for i := start; i < len(encoded); i++ {
+		b := int64(encoded[i]) - 63
+		if b < 0 || b > 0x3f || shift > 60 {
+			return 0, 0, ErrInvalidPolyline
+		}
+
+		result |= uint64(b&0x1f) << shift
+		shift += 5
+
+		if b < 0x20 {
+			v := int64(result >> 1)
+			if result&1 != 0 {
+				v = ^v
+			}
+			return v, i + 1, nil
+		}
+	}
+
+	return 0, 0, ErrInvalidPolyline
+}
+
+// Simplify はDouglas–Peuckerアルゴリズムで座標を間引く
+// tolerance はメートル単位の許容誤差。始点と終点は常に保持する
+func Simplify(points []Point, tolerance float64) []Point {
+	if tolerance <= 0 || len(points) < 3 {
+		return points
+	}
+
+	keep := make([]bool, len(points))
+	keep[0] = true
+	keep[len(points)-1] = true
+
+	// 再帰の代わりに区間スタックで処理する（長い散歩でのスタック溢れを防ぐ）
+	type span struct{ first, last int }
+	stack := []span{{0, len(points) - 1}}
+
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		maxDist := 0.0
+		index := -1
+		for i := s.first + 1; i < s.last; i++ {
+			d := perpendicularDistance(points[i], points[s.first], points[s.last])
+			if d > maxDist {
+				maxDist = d
+				index = i
+			}
+		}
+
+		if index != -1 && maxDist > tolerance {
+			keep[index] = true
+			stack = append(stack, span{s.first, index}, span{index, s.last})
+		}
+	}
+
+	simplified := make([]Point, 0, len(points))
+	for i, p := range points {
+		if keep[i] {
+			simplified = append(simplified, p)
+		}
+	}
+	return simplified
+}
+
+// DistanceToSegment は点pと線分abとの最短距離（メートル）を返す
+func DistanceToSegment(p, a, b Point) float64 {
+	return perpendicularDistance(p, a, b)
+}
+
+// perpendicularDistance は点pと線分abとの距離（メートル）を返す
+// 散歩程度の範囲では正距円筒図法による平面近似で十分な精度が得られる
+func perpendicularDistance(p, a, b Point) float64 {
+	cosLat := math.Cos(a.Lat * math.Pi / 180)
+	toXY := func(q Point) (float64, float64) {
+		x := (q.Lng - a.Lng) * math.Pi / 180 * geo.EarthRadiusMeters * cosLat
+		y := (q.Lat - a.Lat) * math.Pi / 180 * geo.EarthRadiusMeters
+		return x, y
+	}
+
+	px, py := toXY(p)
+	bx, by := toXY(b)
+
+	lengthSq := bx*bx + by*by
+	if lengthSq == 0 {
+		return math.Hypot(px, py)
+	}
+
+	// 線分上への射影位置（0〜1にクランプ）
+	t := math.Max(0, math.Min(1, (px*bx+py*by)/lengthSq))
+	return math.Hypot(px-t*bx, py-t*by)
+}
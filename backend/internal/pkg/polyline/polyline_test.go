@@ -0,0 +1,112 @@
+package polyline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// googleExample はGoogle公式ドキュメントのエンコード例
+var googleExample = struct {
+	points  []Point
+	encoded string
+}{
+	points: []Point{
+		{Lat: 38.5, Lng: -120.2},
+		{Lat: 40.7, Lng: -120.95},
+		{Lat: 43.252, Lng: -126.453},
+	},
+	encoded: "_p~iF~ps|U_ulLnnqC_mqNvxq`@",
+}
+
+func TestEncode(t *testing.T) {
+	// 期待値: 公式ドキュメントの例と同じ文字列にエンコードされる
+	assert.Equal(t, googleExample.encoded, Encode(googleExample.points))
+
+	// 期待値: 空配列は空文字列
+	assert.Equal(t, "", Encode(nil))
+}
+
+func TestDecode(t *testing.T) {
+	// 期待値: 公式ドキュメントの例を元の座標に復元できる
+	points, err := Decode(googleExample.encoded)
+	require.NoError(t, err)
+	require.Len(t, points, len(googleExample.points))
+	for i, p := range points {
+		assert.InDelta(t, googleExample.points[i].Lat, p.Lat, 1e-5)
+		assert.InDelta(t, googleExample.points[i].Lng, p.Lng, 1e-5)
+	}
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		encoded string
+	}{
+		// 期待値: 途中で途切れた文字列はエラー
+		{name: "truncated", encoded: "_p~iF~ps|U_ulLnnqC_mqNvxq"},
+		// 期待値: 経度が欠けた文字列はエラー
+		{name: "missing longitude", encoded: "_p~iF"},
+		// 期待値: 範囲外の文字を含む場合はエラー
+		{name: "out of range character", encoded: "_p~iF\x01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode(tt.encoded)
+			assert.ErrorIs(t, err, ErrInvalidPolyline)
+		})
+	}
+}
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	// 期待値: 負の座標や微小な移動を含んでも往復変換で精度内に一致する
+	points := []Point{
+		{Lat: 35.68123, Lng: 139.76712},
+		{Lat: 35.68124, Lng: 139.76713},
+		{Lat: -33.86785, Lng: 151.20732},
+		{Lat: 0, Lng: 0},
+		{Lat: -89.99999, Lng: -179.99999},
+	}
+
+	decoded, err := Decode(Encode(points))
+	require.NoError(t, err)
+	require.Len(t, decoded, len(points))
+	for i, p := range decoded {
+		assert.InDelta(t, points[i].Lat, p.Lat, 1e-5)
+		assert.InDelta(t, points[i].Lng, p.Lng, 1e-5)
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	// 赤道上を東へ直線的に進み、途中で北へ約111m逸れる経路
+	points := []Point{
+		{Lat: 0, Lng: 0.000},
+		{Lat: 0.00001, Lng: 0.001}, // 直線から約1mのずれ
+		{Lat: 0, Lng: 0.002},
+		{Lat: 0.001, Lng: 0.003}, // 直線から約111mのずれ
+		{Lat: 0, Lng: 0.004},
+	}
+
+	t.Run("許容誤差以内の点は間引かれる", func(t *testing.T) {
+		// 期待値: 約1mのずれは5mの許容誤差で除去され、経路の屈曲点は保持される
+		got := Simplify(points, 5)
+		assert.Equal(t, []Point{points[0], points[2], points[3], points[4]}, got)
+	})
+
+	t.Run("許容誤差0では簡略化しない", func(t *testing.T) {
+		got := Simplify(points, 0)
+		assert.Equal(t, points, got)
+	})
+
+	t.Run("大きな許容誤差では始点と終点のみ残る", func(t *testing.T) {
+		got := Simplify(points, 1000)
+		assert.Equal(t, []Point{points[0], points[4]}, got)
+	})
+
+	t.Run("2点以下はそのまま返す", func(t *testing.T) {
+		got := Simplify(points[:2], 5)
+		assert.Equal(t, points[:2], got)
+	})
+}
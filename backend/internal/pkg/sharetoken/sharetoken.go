@@ -0,0 +1,70 @@
+package sharetoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// separator はトークン内のIDと署名の区切り文字（base64urlの文字集合に含まれない）
+const separator = "."
+
+// ErrInvalidToken は形式が不正、または署名が一致しないトークンを表すエラー
+var ErrInvalidToken = errors.New("invalid share token")
+
+// Signer は共有リンクのIDにHMAC-SHA256の署名を付けたトークンを発行・検証する
+// 署名により、DBに問い合わせる前に推測・改ざんされたトークンを拒否できる
+type Signer struct {
+	key []byte
+}
+
+// NewSigner は署名鍵を使う Signer を生成する
+func NewSigner(key []byte) *Signer {
+	return &Signer{key: key}
+}
+
+// Sign は共有リンクのIDからトークンを発行する
+// 形式: base64url(ID) + "." + base64url(HMAC-SHA256(鍵, ID))
+func (s *Signer) Sign(id uuid.UUID) string {
+	return encode(id[:]) + separator + encode(s.mac(id))
+}
+
+// Parse はトークンの署名を検証し、共有リンクのIDを返す
+func (s *Signer) Parse(token string) (uuid.UUID, error) {
+	encodedID, encodedMAC, ok := strings.Cut(token, separator)
+	if !ok {
+		return uuid.Nil, ErrInvalidToken
+	}
+
+	rawID, err := base64.RawURLEncoding.DecodeString(encodedID)
+	if err != nil {
+		return uuid.Nil, ErrInvalidToken
+	}
+	id, err := uuid.FromBytes(rawID)
+	if err != nil {
+		return uuid.Nil, ErrInvalidToken
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil || !hmac.Equal(mac, s.mac(id)) {
+		return uuid.Nil, ErrInvalidToken
+	}
+
+	return id, nil
+}
+
+// mac はIDのHMAC-SHA256を計算する
+func (s *Signer) mac(id uuid.UUID) []byte {
+	h := hmac.New(sha256.New, s.key)
+	h.Write(id[:])
+	return h.Sum(nil)
+}
+
+// encode はパディングなしのbase64url形式にエンコードする（URLのパスにそのまま使える）
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,56 @@
+package sharetoken
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner_RoundTrip(t *testing.T) {
+	s := NewSigner([]byte("test-key"))
+	id := uuid.New()
+
+	token := s.Sign(id)
+
+	// 期待値: URLのパスにそのまま使える文字のみで構成される
+	assert.NotContains(t, token, "/")
+	assert.NotContains(t, token, "+")
+	assert.NotContains(t, token, "=")
+
+	got, err := s.Parse(token)
+	require.NoError(t, err)
+	assert.Equal(t, id, got)
+}
+
+func TestSigner_Parse_Invalid(t *testing.T) {
+	s := NewSigner([]byte("test-key"))
+	id := uuid.New()
+	token := s.Sign(id)
+	encodedID, encodedMAC, _ := strings.Cut(token, separator)
+	other := uuid.New()
+	otherID := encode(other[:])
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "empty", token: ""},
+		{name: "no separator", token: encodedID + encodedMAC},
+		{name: "malformed id", token: "not-base64!" + separator + encodedMAC},
+		{name: "short id", token: encode(id[:8]) + separator + encodedMAC},
+		{name: "other id", token: otherID + separator + encodedMAC},
+		{name: "tampered signature", token: encodedID + separator + encode([]byte("forged"))},
+		{name: "signed with another key", token: NewSigner([]byte("other-key")).Sign(id)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 期待値: 形式の誤り・署名の不一致はいずれも ErrInvalidToken
+			_, err := s.Parse(tt.token)
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
@@ -0,0 +1,206 @@
+// Package thumbnail は散歩のルートをPNG画像に描画する
+// 地図タイルを使わずにオフラインで描画し、同じ入力からは常に同じ画像を生成する
+package thumbnail
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+)
+
+// Point は緯度経度の座標
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// Options は描画の設定
+type Options struct {
+	Width     int  // 画像の幅（ピクセル）
+	Height    int  // 画像の高さ（ピクセル）
+	Padding   int  // ルートと画像の端との余白（ピクセル）
+	LineWidth int  // ルートの線の太さ（ピクセル）
+	Grid      bool // 背景に方眼を描くかどうか
+}
+
+// DefaultOptions はサムネイルのデフォルト設定
+var DefaultOptions = Options{
+	Width:     600,
+	Height:    400,
+	Padding:   32,
+	LineWidth: 5,
+	Grid:      true,
+}
+
+const (
+	// gridSpacing は方眼の間隔（ピクセル）
+	gridSpacing = 40
+	// markerRadius は開始・終了地点のマーカーの半径（ピクセル）
+	markerRadius = 9
+	// markerBorder はマーカーの縁取りの太さ（ピクセル）
+	markerBorder = 3
+)
+
+var (
+	backgroundColor = color.RGBA{R: 0xF5, G: 0xF2, B: 0xEB, A: 0xFF}
+	gridColor       = color.RGBA{R: 0xE3, G: 0xDE, B: 0xD3, A: 0xFF}
+	routeColor      = color.RGBA{R: 0x2F, G: 0x80, B: 0xED, A: 0xFF}
+	startColor      = color.RGBA{R: 0x2E, G: 0xA0, B: 0x43, A: 0xFF}
+	endColor        = color.RGBA{R: 0xE5, G: 0x39, B: 0x35, A: 0xFF}
+	borderColor     = color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}
+)
+
+// Render はルートを描画した画像を返す
+// ルート全体が余白の内側に収まるよう縦横比を保って拡大し、中央に配置する
+// 点がない場合は背景のみを描画する
+func Render(points []Point, opts Options) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, opts.Width, opts.Height))
+	fill(img, backgroundColor)
+	if opts.Grid {
+		drawGrid(img)
+	}
+	if len(points) == 0 {
+		return img
+	}
+
+	pixels := fitToImage(points, opts)
+	radius := opts.LineWidth / 2
+	for i := 1; i < len(pixels); i++ {
+		drawLine(img, pixels[i-1], pixels[i], radius, routeColor)
+	}
+
+	// 終了地点が開始地点と重なる周回ルートでも開始地点が見えるよう、終了地点を先に描く
+	drawMarker(img, pixels[len(pixels)-1], endColor)
+	drawMarker(img, pixels[0], startColor)
+	return img
+}
+
+// EncodePNG はルートを描画したPNG画像を書き出す
+func EncodePNG(w io.Writer, points []Point, opts Options) error {
+	return png.Encode(w, Render(points, opts))
+}
+
+// fitToImage は座標をWebメルカトル図法で投影し、画像のピクセル座標に変換する
+// 結果を整数に丸めてから描画するため、浮動小数点の誤差で描画結果が変わることはない
+func fitToImage(points []Point, opts Options) []image.Point {
+	xs := make([]float64, len(points))
+	ys := make([]float64, len(points))
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for i, p := range points {
+		xs[i], ys[i] = project(p)
+		minX, maxX = math.Min(minX, xs[i]), math.Max(maxX, xs[i])
+		minY, maxY = math.Min(minY, ys[i]), math.Max(maxY, ys[i])
+	}
+
+	innerW := float64(opts.Width - 2*opts.Padding)
+	innerH := float64(opts.Height - 2*opts.Padding)
+	spanX, spanY := maxX-minX, maxY-minY
+
+	// 1点のみ・同じ地点のみの場合は拡大せず中央に置く
+	scale := 0.0
+	if spanX > 0 || spanY > 0 {
+		scale = math.Min(innerW/math.Max(spanX, math.SmallestNonzeroFloat64), innerH/math.Max(spanY, math.SmallestNonzeroFloat64))
+	}
+	offsetX := float64(opts.Padding) + (innerW-spanX*scale)/2
+	offsetY := float64(opts.Padding) + (innerH-spanY*scale)/2
+
+	pixels := make([]image.Point, len(points))
+	for i := range points {
+		pixels[i] = image.Point{
+			X: int(math.Round(offsetX + (xs[i]-minX)*scale)),
+			Y: int(math.Round(offsetY + (ys[i]-minY)*scale)),
+		}
+	}
+	return pixels
+}
+
+// project は緯度経度をWebメルカトル図法の0〜1の平面座標に変換する（yは南向き）
+func project(p Point) (float64, float64) {
+	lat := math.Max(-85.05112878, math.Min(85.05112878, p.Lat)) * math.Pi / 180
+	x := (p.Lng + 180) / 360
+	y := (1 - math.Log(math.Tan(lat)+1/math.Cos(lat))/math.Pi) / 2
+	return x, y
+}
+
+// fill は画像全体を塗りつぶす
+func fill(img *image.RGBA, c color.RGBA) {
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			img.SetRGBA(x, y, c)
+		}
+	}
+}
+
+// drawGrid は背景に方眼を描く
+func drawGrid(img *image.RGBA) {
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if x%gridSpacing == 0 || y%gridSpacing == 0 {
+				img.SetRGBA(x, y, gridColor)
+			}
+		}
+	}
+}
+
+// drawLine はブレゼンハムのアルゴリズムで太さのある線分を描く
+func drawLine(img *image.RGBA, from, to image.Point, radius int, c color.RGBA) {
+	dx := abs(to.X - from.X)
+	dy := -abs(to.Y - from.Y)
+	sx, sy := 1, 1
+	if from.X > to.X {
+		sx = -1
+	}
+	if from.Y > to.Y {
+		sy = -1
+	}
+
+	x, y := from.X, from.Y
+	e := dx + dy
+	for {
+		drawDisc(img, image.Point{X: x, Y: y}, radius, c)
+		if x == to.X && y == to.Y {
+			return
+		}
+		e2 := 2 * e
+		if e2 >= dy {
+			e += dy
+			x += sx
+		}
+		if e2 <= dx {
+			e += dx
+			y += sy
+		}
+	}
+}
+
+// drawMarker は白く縁取った円のマーカーを描く
+func drawMarker(img *image.RGBA, center image.Point, c color.RGBA) {
+	drawDisc(img, center, markerRadius, borderColor)
+	drawDisc(img, center, markerRadius-markerBorder, c)
+}
+
+// drawDisc は塗りつぶした円を描く（画像の外にはみ出す部分は描かない）
+func drawDisc(img *image.RGBA, center image.Point, radius int, c color.RGBA) {
+	b := img.Bounds()
+	for y := center.Y - radius; y <= center.Y+radius; y++ {
+		for x := center.X - radius; x <= center.X+radius; x++ {
+			ddx, ddy := x-center.X, y-center.Y
+			if ddx*ddx+ddy*ddy > radius*radius || !(image.Point{X: x, Y: y}).In(b) {
+				continue
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,127 @@
+package thumbnail
+
+import (
+	"bytes"
+	"flag"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// update を指定するとゴールデン画像を現在の描画結果で更新する
+// go test ./internal/pkg/thumbnail -update
+var update = flag.Bool("update", false, "update golden images")
+
+// loopRoute は皇居の周りを一周するルート
+var loopRoute = []Point{
+	{Lat: 35.68525, Lng: 139.75180},
+	{Lat: 35.69220, Lng: 139.75360},
+	{Lat: 35.69240, Lng: 139.75730},
+	{Lat: 35.68860, Lng: 139.76070},
+	{Lat: 35.68190, Lng: 139.76040},
+	{Lat: 35.67720, Lng: 139.75820},
+	{Lat: 35.67800, Lng: 139.75260},
+	{Lat: 35.68200, Lng: 139.75050},
+	{Lat: 35.68510, Lng: 139.75170},
+}
+
+// straightRoute は東西にまっすぐ進むルート
+var straightRoute = []Point{
+	{Lat: 35.0, Lng: 139.000},
+	{Lat: 35.0, Lng: 139.005},
+	{Lat: 35.0, Lng: 139.010},
+}
+
+func TestEncodePNG_Golden(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []Point
+		opts   Options
+	}{
+		{name: "loop", points: loopRoute, opts: DefaultOptions},
+		{name: "loop_no_grid", points: loopRoute, opts: Options{Width: 300, Height: 300, Padding: 16, LineWidth: 3}},
+		{name: "straight", points: straightRoute, opts: DefaultOptions},
+		{name: "single_point", points: loopRoute[:1], opts: DefaultOptions},
+		{name: "empty", points: nil, opts: DefaultOptions},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, EncodePNG(&buf, tt.points, tt.opts))
+
+			golden := filepath.Join("testdata", tt.name+".png")
+			if *update {
+				require.NoError(t, os.WriteFile(golden, buf.Bytes(), 0o644))
+			}
+			want, err := os.ReadFile(golden)
+			require.NoError(t, err)
+
+			// 期待値: ゴールデン画像とバイト単位で一致する
+			assert.True(t, bytes.Equal(want, buf.Bytes()), "rendered image differs from %s (run with -update to regenerate)", golden)
+		})
+	}
+}
+
+func TestRender_Deterministic(t *testing.T) {
+	// 期待値: 同じ入力からは常に同じ画像が生成される
+	first := Render(loopRoute, DefaultOptions)
+	second := Render(loopRoute, DefaultOptions)
+	assert.Equal(t, first.Pix, second.Pix)
+}
+
+func TestRender_Size(t *testing.T) {
+	img := Render(loopRoute, Options{Width: 120, Height: 80, Padding: 8, LineWidth: 2})
+
+	// 期待値: 指定したサイズの画像が生成される
+	assert.Equal(t, image.Rect(0, 0, 120, 80), img.Bounds())
+}
+
+func TestRender_Markers(t *testing.T) {
+	img := Render(straightRoute, DefaultOptions)
+	pixels := fitToImage(straightRoute, DefaultOptions)
+
+	// 期待値: 開始地点に開始マーカー、終了地点に終了マーカーが描かれる
+	assert.Equal(t, startColor, img.RGBAAt(pixels[0].X, pixels[0].Y))
+	assert.Equal(t, endColor, img.RGBAAt(pixels[2].X, pixels[2].Y))
+	// 期待値: 中間地点にはルートが描かれる
+	assert.Equal(t, routeColor, img.RGBAAt(pixels[1].X, pixels[1].Y))
+}
+
+func TestFitToImage(t *testing.T) {
+	opts := Options{Width: 200, Height: 100, Padding: 10}
+
+	t.Run("縦横比を保って余白の内側に収める", func(t *testing.T) {
+		pixels := fitToImage(straightRoute, opts)
+
+		// 期待値: 東西のルートは横幅いっぱいに広がり、縦方向は中央に置かれる
+		assert.Equal(t, image.Point{X: 10, Y: 50}, pixels[0])
+		assert.Equal(t, image.Point{X: 100, Y: 50}, pixels[1])
+		assert.Equal(t, image.Point{X: 190, Y: 50}, pixels[2])
+	})
+
+	t.Run("1点のみの場合は中央に置く", func(t *testing.T) {
+		pixels := fitToImage(straightRoute[:1], opts)
+		assert.Equal(t, []image.Point{{X: 100, Y: 50}}, pixels)
+	})
+
+	t.Run("北が上になる", func(t *testing.T) {
+		pixels := fitToImage([]Point{{Lat: 35.0, Lng: 139.0}, {Lat: 35.01, Lng: 139.0}}, opts)
+		assert.Greater(t, pixels[0].Y, pixels[1].Y)
+	})
+}
+
+func TestEncodePNG_Decodable(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, EncodePNG(&buf, loopRoute, DefaultOptions))
+
+	// 期待値: PNGとしてデコードできる
+	img, err := png.Decode(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultOptions.Width, img.Bounds().Dx())
+}
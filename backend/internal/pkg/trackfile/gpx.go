@@ -0,0 +1,84 @@
+package trackfile
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// gpxFile はGPXファイルの読み取り用構造
+// 名前空間はGPX 1.0/1.1で異なるため、ローカル名のみで照合する
+type gpxFile struct {
+	Metadata struct {
+		Name string `xml:"name"`
+		Desc string `xml:"desc"`
+	} `xml:"metadata"`
+	Tracks []struct {
+		Name     string `xml:"name"`
+		Desc     string `xml:"desc"`
+		Segments []struct {
+			Points []gpxPoint `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+}
+
+// gpxPoint はGPXのtrkpt要素
+type gpxPoint struct {
+	Lat        float64  `xml:"lat,attr"`
+	Lon        float64  `xml:"lon,attr"`
+	Ele        *float64 `xml:"ele"`
+	Time       string   `xml:"time"`
+	Extensions struct {
+		TrackPointExtension struct {
+			Speed  *float64 `xml:"speed"`
+			Course *float64 `xml:"course"`
+		} `xml:"TrackPointExtension"`
+	} `xml:"extensions"`
+}
+
+// parseGPX はGPXファイルを解析する
+// 速度・方位はGarmin TrackPointExtensionがある場合のみ読み取る
+func parseGPX(r io.Reader) (*Track, error) {
+	var file gpxFile
+	if err := xml.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+
+	track := &Track{
+		Name:        strings.TrimSpace(file.Metadata.Name),
+		Description: strings.TrimSpace(file.Metadata.Desc),
+	}
+
+	for _, trk := range file.Tracks {
+		if track.Name == "" {
+			track.Name = strings.TrimSpace(trk.Name)
+		}
+		if track.Description == "" {
+			track.Description = strings.TrimSpace(trk.Desc)
+		}
+
+		for _, seg := range trk.Segments {
+			for _, p := range seg.Points {
+				if p.Time == "" {
+					return nil, fmt.Errorf("%w: track point %d has no time", ErrInvalidFile, len(track.Points))
+				}
+				t, err := parseTime(p.Time)
+				if err != nil {
+					return nil, err
+				}
+
+				track.Points = append(track.Points, Point{
+					Lat:    p.Lat,
+					Lon:    p.Lon,
+					Ele:    p.Ele,
+					Time:   t,
+					Speed:  p.Extensions.TrackPointExtension.Speed,
+					Course: p.Extensions.TrackPointExtension.Course,
+				})
+			}
+		}
+	}
+
+	return track, nil
+}
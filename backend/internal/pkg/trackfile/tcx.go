@@ -0,0 +1,76 @@
+package trackfile
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// tcxFile はTCXファイルの読み取り用構造
+type tcxFile struct {
+	Activities []struct {
+		Notes string `xml:"Notes"`
+		Laps  []struct {
+			Tracks []struct {
+				Points []tcxPoint `xml:"Trackpoint"`
+			} `xml:"Track"`
+		} `xml:"Lap"`
+	} `xml:"Activities>Activity"`
+}
+
+// tcxPoint はTCXのTrackpoint要素
+type tcxPoint struct {
+	Time     string `xml:"Time"`
+	Position *struct {
+		Lat float64 `xml:"LatitudeDegrees"`
+		Lon float64 `xml:"LongitudeDegrees"`
+	} `xml:"Position"`
+	Altitude   *float64 `xml:"AltitudeMeters"`
+	Extensions struct {
+		TPX struct {
+			Speed *float64 `xml:"Speed"`
+		} `xml:"TPX"`
+	} `xml:"Extensions"`
+}
+
+// parseTCX はTCXファイルを解析する
+// 位置を持たないTrackpoint（心拍のみの記録など）は読み飛ばす
+func parseTCX(r io.Reader) (*Track, error) {
+	var file tcxFile
+	if err := xml.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+
+	track := &Track{}
+
+	for _, activity := range file.Activities {
+		if track.Description == "" {
+			track.Description = strings.TrimSpace(activity.Notes)
+		}
+
+		for _, lap := range activity.Laps {
+			for _, trk := range lap.Tracks {
+				for _, p := range trk.Points {
+					if p.Position == nil {
+						continue
+					}
+					t, err := parseTime(p.Time)
+					if err != nil {
+						return nil, err
+					}
+
+					track.Points = append(track.Points, Point{
+						Lat:   p.Position.Lat,
+						Lon:   p.Position.Lon,
+						Ele:   p.Altitude,
+						Time:  t,
+						Speed: p.Extensions.TPX.Speed,
+					})
+				}
+			}
+		}
+	}
+
+	return track, nil
+}
@@ -0,0 +1,91 @@
+package trackfile
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Format はトラックファイルの形式
+type Format string
+
+const (
+	// FormatGPX はGPX 1.0/1.1形式
+	FormatGPX Format = "gpx"
+	// FormatTCX はGarmin Training Center XML形式
+	FormatTCX Format = "tcx"
+)
+
+var (
+	// ErrUnsupportedFormat は未対応のファイル形式を表すエラー
+	ErrUnsupportedFormat = errors.New("unsupported track file format")
+	// ErrInvalidFile は解析できないファイルを表すエラー
+	ErrInvalidFile = errors.New("invalid track file")
+	// ErrNoTrackPoints はトラックポイントを含まないファイルを表すエラー
+	ErrNoTrackPoints = errors.New("track file contains no track points")
+)
+
+// Point はトラックファイルから読み取った1件の位置情報
+type Point struct {
+	Lat    float64
+	Lon    float64
+	Ele    *float64 // 標高（メートル）
+	Time   time.Time
+	Speed  *float64 // 速度（m/s）
+	Course *float64 // 方位（度）
+}
+
+// Track はトラックファイルから読み取った経路
+type Track struct {
+	Name        string
+	Description string
+	Points      []Point // ファイル内の出現順
+}
+
+// DetectFormat はファイル名の拡張子から形式を判定する
+func DetectFormat(filename string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".gpx":
+		return FormatGPX, nil
+	case ".tcx":
+		return FormatTCX, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnsupportedFormat, filepath.Ext(filename))
+}
+
+// Parse は指定形式のトラックファイルを解析する
+// 複数のトラック・セグメント・ラップは出現順に1つの経路として連結する
+func Parse(r io.Reader, format Format) (*Track, error) {
+	var (
+		track *Track
+		err   error
+	)
+	switch format {
+	case FormatGPX:
+		track, err = parseGPX(r)
+	case FormatTCX:
+		track, err = parseTCX(r)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(track.Points) == 0 {
+		return nil, ErrNoTrackPoints
+	}
+	return track, nil
+}
+
+// parseTime はXMLスキーマのdateTime（RFC3339）を解析する
+func parseTime(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(s))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid time %q", ErrInvalidFile, s)
+	}
+	return t, nil
+}
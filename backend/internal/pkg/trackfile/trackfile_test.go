@@ -0,0 +1,212 @@
+package trackfile
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleGPX = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="OtherApp" xmlns="http://www.topografix.com/GPX/1/1"
+     xmlns:gpxtpx="http://www.garmin.com/xmlschemas/TrackPointExtension/v2">
+  <metadata>
+    <name>朝の散歩</name>
+    <desc>川沿いを一周</desc>
+  </metadata>
+  <trk>
+    <name>Track 1</name>
+    <trkseg>
+      <trkpt lat="35.6812" lon="139.7671">
+        <ele>10.5</ele>
+        <time>2025-01-01T00:00:00Z</time>
+        <extensions>
+          <gpxtpx:TrackPointExtension>
+            <gpxtpx:speed>1.4</gpxtpx:speed>
+            <gpxtpx:course>90</gpxtpx:course>
+          </gpxtpx:TrackPointExtension>
+        </extensions>
+      </trkpt>
+      <trkpt lat="35.6813" lon="139.7672">
+        <time>2025-01-01T00:01:00Z</time>
+      </trkpt>
+    </trkseg>
+    <trkseg>
+      <trkpt lat="35.6814" lon="139.7673">
+        <time>2025-01-01T09:02:00+09:00</time>
+      </trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+
+const sampleTCX = `<?xml version="1.0" encoding="UTF-8"?>
+<TrainingCenterDatabase xmlns="http://www.garmin.com/xmlschemas/TrainingCenterDatabase/v2"
+    xmlns:ns3="http://www.garmin.com/xmlschemas/ActivityExtension/v2">
+  <Activities>
+    <Activity Sport="Other">
+      <Id>2025-01-01T00:00:00Z</Id>
+      <Lap StartTime="2025-01-01T00:00:00Z">
+        <Track>
+          <Trackpoint>
+            <Time>2025-01-01T00:00:00Z</Time>
+            <Position>
+              <LatitudeDegrees>35.6812</LatitudeDegrees>
+              <LongitudeDegrees>139.7671</LongitudeDegrees>
+            </Position>
+            <AltitudeMeters>10.5</AltitudeMeters>
+            <Extensions>
+              <ns3:TPX><ns3:Speed>1.4</ns3:Speed></ns3:TPX>
+            </Extensions>
+          </Trackpoint>
+          <Trackpoint>
+            <Time>2025-01-01T00:00:30Z</Time>
+            <HeartRateBpm><Value>90</Value></HeartRateBpm>
+          </Trackpoint>
+        </Track>
+      </Lap>
+      <Lap StartTime="2025-01-01T00:01:00Z">
+        <Track>
+          <Trackpoint>
+            <Time>2025-01-01T00:01:00Z</Time>
+            <Position>
+              <LatitudeDegrees>35.6813</LatitudeDegrees>
+              <LongitudeDegrees>139.7672</LongitudeDegrees>
+            </Position>
+          </Trackpoint>
+        </Track>
+      </Lap>
+      <Notes>公園まで</Notes>
+    </Activity>
+  </Activities>
+</TrainingCenterDatabase>`
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		filename string
+		want     Format
+		wantErr  bool
+	}{
+		{filename: "walk.gpx", want: FormatGPX},
+		{filename: "WALK.GPX", want: FormatGPX},
+		{filename: "activity.tcx", want: FormatTCX},
+		{filename: "route.kml", wantErr: true},
+		{filename: "noext", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			got, err := DetectFormat(tt.filename)
+			if tt.wantErr {
+				// 期待値: 未対応の拡張子はErrUnsupportedFormat
+				assert.ErrorIs(t, err, ErrUnsupportedFormat)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParse_GPX(t *testing.T) {
+	track, err := Parse(strings.NewReader(sampleGPX), FormatGPX)
+	require.NoError(t, err)
+
+	// 期待値: metadataの名前・説明がトラック名より優先される
+	assert.Equal(t, "朝の散歩", track.Name)
+	assert.Equal(t, "川沿いを一周", track.Description)
+
+	// 期待値: 複数セグメントの点が出現順に連結される
+	require.Len(t, track.Points, 3)
+
+	first := track.Points[0]
+	assert.Equal(t, 35.6812, first.Lat)
+	assert.Equal(t, 139.7671, first.Lon)
+	require.NotNil(t, first.Ele)
+	assert.Equal(t, 10.5, *first.Ele)
+	assert.True(t, first.Time.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.NotNil(t, first.Speed)
+	assert.Equal(t, 1.4, *first.Speed)
+	require.NotNil(t, first.Course)
+	assert.Equal(t, 90.0, *first.Course)
+
+	// 期待値: 任意要素がない点はnilになる
+	assert.Nil(t, track.Points[1].Ele)
+	assert.Nil(t, track.Points[1].Speed)
+
+	// 期待値: タイムゾーン付きの時刻も正しく解析される
+	assert.True(t, track.Points[2].Time.Equal(time.Date(2025, 1, 1, 0, 2, 0, 0, time.UTC)))
+}
+
+func TestParse_TCX(t *testing.T) {
+	track, err := Parse(strings.NewReader(sampleTCX), FormatTCX)
+	require.NoError(t, err)
+
+	assert.Equal(t, "公園まで", track.Description)
+
+	// 期待値: 位置を持たないTrackpointは読み飛ばし、複数ラップを連結する
+	require.Len(t, track.Points, 2)
+
+	first := track.Points[0]
+	assert.Equal(t, 35.6812, first.Lat)
+	assert.Equal(t, 139.7671, first.Lon)
+	require.NotNil(t, first.Ele)
+	assert.Equal(t, 10.5, *first.Ele)
+	require.NotNil(t, first.Speed)
+	assert.Equal(t, 1.4, *first.Speed)
+
+	assert.True(t, track.Points[1].Time.Equal(time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC)))
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		format  Format
+		wantErr error
+	}{
+		{
+			// 期待値: XMLとして不正な場合はErrInvalidFile
+			name:    "malformed xml",
+			content: `<gpx><trk>`,
+			format:  FormatGPX,
+			wantErr: ErrInvalidFile,
+		},
+		{
+			// 期待値: 時刻がない点はErrInvalidFile
+			name:    "missing time",
+			content: `<gpx><trk><trkseg><trkpt lat="35" lon="139"></trkpt></trkseg></trk></gpx>`,
+			format:  FormatGPX,
+			wantErr: ErrInvalidFile,
+		},
+		{
+			// 期待値: 時刻の形式が不正な場合はErrInvalidFile
+			name:    "invalid time",
+			content: `<gpx><trk><trkseg><trkpt lat="35" lon="139"><time>yesterday</time></trkpt></trkseg></trk></gpx>`,
+			format:  FormatGPX,
+			wantErr: ErrInvalidFile,
+		},
+		{
+			// 期待値: トラックポイントがない場合はErrNoTrackPoints
+			name:    "no track points",
+			content: `<TrainingCenterDatabase><Activities><Activity></Activity></Activities></TrainingCenterDatabase>`,
+			format:  FormatTCX,
+			wantErr: ErrNoTrackPoints,
+		},
+		{
+			// 期待値: 未対応の形式はErrUnsupportedFormat
+			name:    "unsupported format",
+			content: sampleGPX,
+			format:  Format("kml"),
+			wantErr: ErrUnsupportedFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.content), tt.format)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
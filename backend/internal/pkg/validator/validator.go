@@ -3,6 +3,8 @@ package validator
 import (
 	"fmt"
 	"regexp"
+	"strings"
+	"unicode/utf8"
 )
 
 var (
@@ -21,6 +23,43 @@ func (e ValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// ValidationErrors は複数フィールドのバリデーションエラー
+type ValidationErrors []ValidationError
+
+// Error はエラーメッセージを返す
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, ve := range e {
+		messages[i] = ve.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Add はバリデーション関数の結果を追加する（nilの場合は何もしない）
+func (e *ValidationErrors) Add(err error) {
+	if err == nil {
+		return
+	}
+	if ve, ok := err.(ValidationError); ok {
+		*e = append(*e, ve)
+		return
+	}
+	*e = append(*e, ValidationError{Message: err.Error()})
+}
+
+// AddField はフィールドとメッセージを指定してエラーを追加する
+func (e *ValidationErrors) AddField(field, message string) {
+	*e = append(*e, ValidationError{Field: field, Message: message})
+}
+
+// Err はエラーが1件以上ある場合のみerrorとして返す
+func (e ValidationErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
 // ValidateEmail はメールアドレスをバリデーションする
 func ValidateEmail(email string) error {
 	if email == "" {
@@ -49,9 +88,10 @@ func ValidateRequired(field, value string) error {
 	return nil
 }
 
-// ValidateMaxLength は最大長をバリデーションする
+// ValidateMaxLength は最大文字数をバリデーションする
+// DBのVARCHAR(n)と同じく、バイト数ではなく文字数（rune数）で数える
 func ValidateMaxLength(field, value string, maxLength int) error {
-	if len(value) > maxLength {
+	if utf8.RuneCountInString(value) > maxLength {
 		return ValidationError{
 			Field:   field,
 			Message: fmt.Sprintf("%s must be at most %d characters", field, maxLength),
@@ -70,3 +110,14 @@ func ValidateMinLength(field, value string, minLength int) error {
 	}
 	return nil
 }
+
+// ValidateNonNegative は数値が0以上であることをバリデーションする
+func ValidateNonNegative(field string, value float64) error {
+	if value < 0 {
+		return ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("%s must be greater than or equal to 0", field),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package achievement
+
+import (
+	"context"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/achievement"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+)
+
+// AwardedAchievement は獲得した実績と獲得条件の定義をまとめた構造体
+type AwardedAchievement struct {
+	Achievement *achievement.Achievement
+	Rule        achievement.Rule
+}
+
+// Usecase は実績のユースケースインターフェース
+type Usecase interface {
+	// ListAchievements はユーザーが獲得した実績を獲得日時の昇順で取得する
+	ListAchievements(ctx context.Context, userID string) ([]AwardedAchievement, error)
+	// RecordCompletedWalk は完了した散歩をもとに実績を判定して付与する
+	// 連続記録・自己ベストの更新後に呼び出すこと
+	RecordCompletedWalk(ctx context.Context, w *walk.Walk, locations []*walk.WalkLocation) error
+}
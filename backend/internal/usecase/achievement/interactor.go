@@ -0,0 +1,89 @@
+package achievement
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/achievement"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/record"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/stats"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+)
+
+// interactor は実績Usecaseの実装
+type interactor struct {
+	achievementRepo achievement.Repository
+	recordRepo      record.Repository
+	statsRepo       stats.Repository
+	rules           []achievement.Rule
+}
+
+// NewInteractor は新しい実績Interactorを生成する
+func NewInteractor(achievementRepo achievement.Repository, recordRepo record.Repository, statsRepo stats.Repository) Usecase {
+	return &interactor{
+		achievementRepo: achievementRepo,
+		recordRepo:      recordRepo,
+		statsRepo:       statsRepo,
+		rules:           achievement.Rules(),
+	}
+}
+
+// ListAchievements はユーザーが獲得した実績を獲得日時の昇順で取得する
+// 定義が削除された実績は返さない
+func (i *interactor) ListAchievements(ctx context.Context, userID string) ([]AwardedAchievement, error) {
+	achievements, err := i.achievementRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list achievements: %w", err)
+	}
+
+	awarded := make([]AwardedAchievement, 0, len(achievements))
+	for _, a := range achievements {
+		rule, ok := achievement.FindRule(a.Code)
+		if !ok {
+			continue
+		}
+		awarded = append(awarded, AwardedAchievement{Achievement: a, Rule: rule})
+	}
+
+	return awarded, nil
+}
+
+// RecordCompletedWalk は完了した散歩をもとに実績を判定して付与する
+// 獲得済みの実績は判定せず、付与もリポジトリ側で一意に保つため、同じ散歩が再送されても二重に付与しない
+func (i *interactor) RecordCompletedWalk(ctx context.Context, w *walk.Walk, _ []*walk.WalkLocation) error {
+	if !w.IsCompleted() {
+		return nil
+	}
+
+	achievements, err := i.achievementRepo.FindByUserID(ctx, w.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to list achievements: %w", err)
+	}
+	awarded := make(map[achievement.Code]bool, len(achievements))
+	for _, a := range achievements {
+		awarded[a.Code] = true
+	}
+
+	records, err := i.recordRepo.FindByUserID(ctx, w.UserID)
+	if errors.Is(err, sql.ErrNoRows) {
+		records = record.NewPersonalRecords(w.UserID)
+	} else if err != nil {
+		return fmt.Errorf("failed to get personal records: %w", err)
+	}
+
+	totals, err := i.statsRepo.SummarizeWalks(ctx, w.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to summarize walks: %w", err)
+	}
+
+	facts := achievement.Facts{Walk: w, Records: records, Totals: totals}
+	for _, rule := range achievement.Evaluate(i.rules, facts, awarded) {
+		if _, err := i.achievementRepo.Award(ctx, achievement.NewAchievement(w.UserID, rule.Code, w.ID)); err != nil {
+			return fmt.Errorf("failed to award achievement %s: %w", rule.Code, err)
+		}
+	}
+
+	return nil
+}
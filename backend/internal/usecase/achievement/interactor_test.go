@@ -0,0 +1,168 @@
+package achievement
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/achievement"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/record"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/stats"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAchievementRepository はachievement.Repositoryのモック
+type MockAchievementRepository struct {
+	mock.Mock
+}
+
+func (m *MockAchievementRepository) FindByUserID(ctx context.Context, userID string) ([]*achievement.Achievement, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*achievement.Achievement), args.Error(1)
+}
+
+func (m *MockAchievementRepository) Award(ctx context.Context, a *achievement.Achievement) (bool, error) {
+	args := m.Called(ctx, a)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockRecordRepository はrecord.Repositoryのモック
+type MockRecordRepository struct {
+	mock.Mock
+}
+
+func (m *MockRecordRepository) FindByUserID(ctx context.Context, userID string) (*record.PersonalRecords, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*record.PersonalRecords), args.Error(1)
+}
+
+func (m *MockRecordRepository) UpdateByUserID(ctx context.Context, userID string, update func(*record.PersonalRecords) error) error {
+	return m.Called(ctx, userID).Error(0)
+}
+
+func (m *MockRecordRepository) FindWalkDays(ctx context.Context, userID string, loc *time.Location) ([]time.Time, error) {
+	args := m.Called(ctx, userID, loc)
+	return args.Get(0).([]time.Time), args.Error(1)
+}
+
+func (m *MockRecordRepository) SumStepsOnDay(ctx context.Context, userID string, day time.Time, loc *time.Location) (int, error) {
+	args := m.Called(ctx, userID, day, loc)
+	return args.Int(0), args.Error(1)
+}
+
+// MockStatsRepository はstats.Repositoryのモック
+type MockStatsRepository struct {
+	mock.Mock
+}
+
+func (m *MockStatsRepository) AggregateWalks(ctx context.Context, userID string, g stats.Granularity, from, to time.Time, loc *time.Location) ([]stats.Bucket, error) {
+	args := m.Called(ctx, userID, g, from, to, loc)
+	return args.Get(0).([]stats.Bucket), args.Error(1)
+}
+
+func (m *MockStatsRepository) SummarizeWalks(ctx context.Context, userID string) (stats.Bucket, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(stats.Bucket), args.Error(1)
+}
+
+func newCompletedWalk(distance float64) *walk.Walk {
+	w := walk.NewWalk("user-1", "Walk", "")
+	w.Status = walk.StatusCompleted
+	w.TotalDistance = distance
+	return w
+}
+
+func TestRecordCompletedWalk_AwardsNewAchievements(t *testing.T) {
+	achievementRepo := new(MockAchievementRepository)
+	recordRepo := new(MockRecordRepository)
+	statsRepo := new(MockStatsRepository)
+	it := NewInteractor(achievementRepo, recordRepo, statsRepo)
+	ctx := context.Background()
+
+	w := newCompletedWalk(5500)
+	records := record.NewPersonalRecords("user-1")
+	records.LongestStreak = 7
+
+	achievementRepo.On("FindByUserID", ctx, "user-1").Return([]*achievement.Achievement{
+		achievement.NewAchievement("user-1", achievement.CodeFirstWalk, w.ID),
+	}, nil)
+	recordRepo.On("FindByUserID", ctx, "user-1").Return(records, nil)
+	statsRepo.On("SummarizeWalks", ctx, "user-1").Return(stats.Bucket{WalkCount: 3, TotalDistance: 9000}, nil)
+	achievementRepo.On("Award", ctx, mock.AnythingOfType("*achievement.Achievement")).Return(true, nil)
+
+	err := it.RecordCompletedWalk(ctx, w, nil)
+	require.NoError(t, err)
+
+	// 期待値: 獲得済みのfirst_walk以外で条件を満たす実績のみ、散歩IDとともに付与される
+	var awarded []achievement.Code
+	for _, call := range achievementRepo.Calls {
+		if call.Method == "Award" {
+			a := call.Arguments.Get(1).(*achievement.Achievement)
+			assert.Equal(t, w.ID, *a.WalkID)
+			awarded = append(awarded, a.Code)
+		}
+	}
+	assert.Equal(t, []achievement.Code{achievement.CodeFirst5km, achievement.CodeStreak7Days}, awarded)
+}
+
+func TestRecordCompletedWalk_NoRecordsYet(t *testing.T) {
+	achievementRepo := new(MockAchievementRepository)
+	recordRepo := new(MockRecordRepository)
+	statsRepo := new(MockStatsRepository)
+	it := NewInteractor(achievementRepo, recordRepo, statsRepo)
+	ctx := context.Background()
+
+	w := newCompletedWalk(800)
+
+	achievementRepo.On("FindByUserID", ctx, "user-1").Return([]*achievement.Achievement{}, nil)
+	recordRepo.On("FindByUserID", ctx, "user-1").Return(nil, sql.ErrNoRows)
+	statsRepo.On("SummarizeWalks", ctx, "user-1").Return(stats.Bucket{WalkCount: 1, TotalDistance: 800}, nil)
+	achievementRepo.On("Award", ctx, mock.MatchedBy(func(a *achievement.Achievement) bool {
+		return a.Code == achievement.CodeFirstWalk
+	})).Return(true, nil).Once()
+
+	// 期待値: 記録がまだなくても判定でき、初めての散歩のみ付与される
+	err := it.RecordCompletedWalk(ctx, w, nil)
+	require.NoError(t, err)
+	achievementRepo.AssertExpectations(t)
+}
+
+func TestRecordCompletedWalk_NotCompleted(t *testing.T) {
+	achievementRepo := new(MockAchievementRepository)
+	it := NewInteractor(achievementRepo, new(MockRecordRepository), new(MockStatsRepository))
+
+	// 期待値: 完了していない散歩は判定しない
+	err := it.RecordCompletedWalk(context.Background(), walk.NewWalk("user-1", "Walk", ""), nil)
+	require.NoError(t, err)
+	achievementRepo.AssertNotCalled(t, "FindByUserID", mock.Anything, mock.Anything)
+}
+
+func TestListAchievements(t *testing.T) {
+	achievementRepo := new(MockAchievementRepository)
+	it := NewInteractor(achievementRepo, new(MockRecordRepository), new(MockStatsRepository))
+	ctx := context.Background()
+
+	w := newCompletedWalk(0)
+	achievementRepo.On("FindByUserID", ctx, "user-1").Return([]*achievement.Achievement{
+		achievement.NewAchievement("user-1", achievement.CodeFirstWalk, w.ID),
+		achievement.NewAchievement("user-1", "retired_badge", w.ID),
+	}, nil)
+
+	result, err := it.ListAchievements(ctx, "user-1")
+	require.NoError(t, err)
+
+	// 期待値: 定義のある実績のみ、定義と合わせて返す
+	require.Len(t, result, 1)
+	assert.Equal(t, achievement.CodeFirstWalk, result[0].Rule.Code)
+	assert.Equal(t, "First steps", result[0].Rule.Title)
+}
@@ -0,0 +1,45 @@
+package collection
+
+import (
+	"context"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/collection"
+	"github.com/google/uuid"
+)
+
+// CreateCollectionInput はコレクション作成の入力
+type CreateCollectionInput struct {
+	UserID      string
+	Name        string
+	Description string
+	WalkIDs     []uuid.UUID // 含める散歩（指定順が並び順になる）
+}
+
+// UpdateCollectionInput はコレクション更新の入力
+// nilのフィールドは変更しない
+type UpdateCollectionInput struct {
+	ID          uuid.UUID
+	Name        *string
+	Description *string
+	WalkIDs     *[]uuid.UUID // 指定した場合は含まれる散歩と並び順を置き換える
+}
+
+// Usecase はコレクションのユースケースインターフェース
+// 他のユーザーのコレクションは存在しないコレクションとして扱い、collection.ErrCollectionNotFound を返す
+// 自分が所有していない散歩を含めようとした場合は walk.ErrNotOwner を返す
+type Usecase interface {
+	// ListCollections はユーザーのコレクションを取得する
+	ListCollections(ctx context.Context, userID string) ([]*collection.Collection, error)
+	// GetCollection はIDでコレクションを取得する
+	GetCollection(ctx context.Context, id uuid.UUID, userID string) (*collection.Collection, error)
+	// CreateCollection はコレクションを作成する
+	CreateCollection(ctx context.Context, input CreateCollectionInput) (*collection.Collection, error)
+	// UpdateCollection はコレクションの名前・説明・散歩の並び順を更新する
+	UpdateCollection(ctx context.Context, input UpdateCollectionInput, userID string) (*collection.Collection, error)
+	// DeleteCollection はコレクションを削除する（散歩自体は削除しない）
+	DeleteCollection(ctx context.Context, id uuid.UUID, userID string) error
+	// AddWalk は散歩をコレクションの末尾に追加する（追加済みの場合は何もしない）
+	AddWalk(ctx context.Context, id, walkID uuid.UUID, userID string) (*collection.Collection, error)
+	// RemoveWalk は散歩をコレクションから外す
+	RemoveWalk(ctx context.Context, id, walkID uuid.UUID, userID string) (*collection.Collection, error)
+}
@@ -0,0 +1,206 @@
+package collection
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/collection"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+	"github.com/google/uuid"
+)
+
+// interactor はコレクションUsecaseの実装
+type interactor struct {
+	collectionRepo collection.Repository
+}
+
+// NewInteractor は新しいコレクションInteractorを生成する
+func NewInteractor(collectionRepo collection.Repository) Usecase {
+	return &interactor{
+		collectionRepo: collectionRepo,
+	}
+}
+
+// ListCollections はユーザーのコレクションを取得する
+func (i *interactor) ListCollections(ctx context.Context, userID string) ([]*collection.Collection, error) {
+	collections, err := i.collectionRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+	return collections, nil
+}
+
+// GetCollection はIDでコレクションを取得する
+func (i *interactor) GetCollection(ctx context.Context, id uuid.UUID, userID string) (*collection.Collection, error) {
+	c, err := i.collectionRepo.FindByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, collection.ErrCollectionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %w", err)
+	}
+
+	// 権限チェック
+	if c.UserID != userID {
+		return nil, collection.ErrCollectionNotFound
+	}
+
+	return c, nil
+}
+
+// CreateCollection はコレクションを作成する
+func (i *interactor) CreateCollection(ctx context.Context, input CreateCollectionInput) (*collection.Collection, error) {
+	name := strings.TrimSpace(input.Name)
+	if err := validateCollection(name, input.Description, input.WalkIDs).Err(); err != nil {
+		return nil, err
+	}
+
+	c := collection.NewCollection(input.UserID, name, input.Description)
+	c.SetWalks(input.WalkIDs)
+	if err := i.checkWalksOwned(ctx, input.UserID, c.WalkIDs); err != nil {
+		return nil, err
+	}
+
+	if err := i.collectionRepo.Create(ctx, c); err != nil {
+		return nil, fmt.Errorf("failed to create collection: %w", err)
+	}
+
+	return c, nil
+}
+
+// UpdateCollection はコレクションの名前・説明・散歩の並び順を更新する
+func (i *interactor) UpdateCollection(ctx context.Context, input UpdateCollectionInput, userID string) (*collection.Collection, error) {
+	c, err := i.GetCollection(ctx, input.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// フィールド更新
+	if input.Name != nil {
+		c.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.Description != nil {
+		c.Description = *input.Description
+	}
+	if input.WalkIDs != nil {
+		c.SetWalks(*input.WalkIDs)
+	}
+
+	if err := validateCollection(c.Name, c.Description, c.WalkIDs).Err(); err != nil {
+		return nil, err
+	}
+	if input.WalkIDs != nil {
+		if err := i.checkWalksOwned(ctx, userID, c.WalkIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	return i.save(ctx, c)
+}
+
+// DeleteCollection はコレクションを削除する
+func (i *interactor) DeleteCollection(ctx context.Context, id uuid.UUID, userID string) error {
+	// 権限チェック
+	if _, err := i.GetCollection(ctx, id, userID); err != nil {
+		return err
+	}
+
+	if err := i.collectionRepo.Delete(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return collection.ErrCollectionNotFound
+		}
+		return fmt.Errorf("failed to delete collection: %w", err)
+	}
+
+	return nil
+}
+
+// AddWalk は散歩をコレクションの末尾に追加する
+func (i *interactor) AddWalk(ctx context.Context, id, walkID uuid.UUID, userID string) (*collection.Collection, error) {
+	c, err := i.GetCollection(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.checkWalksOwned(ctx, userID, []uuid.UUID{walkID}); err != nil {
+		return nil, err
+	}
+	if !c.AddWalk(walkID) {
+		return c, nil
+	}
+	if len(c.WalkIDs) > collection.MaxWalks {
+		var errs validator.ValidationErrors
+		errs.AddField("walk_id", fmt.Sprintf("a collection can contain at most %d walks", collection.MaxWalks))
+		return nil, errs.Err()
+	}
+
+	return i.save(ctx, c)
+}
+
+// RemoveWalk は散歩をコレクションから外す
+// コレクションに含まれていない散歩の場合は collection.ErrWalkNotInCollection を返す
+func (i *interactor) RemoveWalk(ctx context.Context, id, walkID uuid.UUID, userID string) (*collection.Collection, error) {
+	c, err := i.GetCollection(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.RemoveWalk(walkID) {
+		return nil, collection.ErrWalkNotInCollection
+	}
+
+	return i.save(ctx, c)
+}
+
+// save はコレクションを保存する
+func (i *interactor) save(ctx context.Context, c *collection.Collection) (*collection.Collection, error) {
+	if err := i.collectionRepo.Update(ctx, c); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, collection.ErrCollectionNotFound
+		}
+		return nil, fmt.Errorf("failed to update collection: %w", err)
+	}
+	return c, nil
+}
+
+// checkWalksOwned は散歩がすべてユーザーの所有であることを確認する
+// 存在しない散歩や他のユーザーの散歩を含む場合は walk.ErrNotOwner を返す
+func (i *interactor) checkWalksOwned(ctx context.Context, userID string, walkIDs []uuid.UUID) error {
+	if len(walkIDs) == 0 {
+		return nil
+	}
+
+	owned, err := i.collectionRepo.CountOwnedWalks(ctx, userID, walkIDs)
+	if err != nil {
+		return fmt.Errorf("failed to check walks: %w", err)
+	}
+	if owned != len(walkIDs) {
+		return walk.ErrNotOwner
+	}
+	return nil
+}
+
+// validateCollection はコレクションの名前・説明・散歩の数を検証する
+// 不正なフィールドはすべて収集して validator.ValidationErrors として返す
+func validateCollection(name, description string, walkIDs []uuid.UUID) validator.ValidationErrors {
+	var errs validator.ValidationErrors
+
+	if name == "" {
+		errs.AddField("name", "name is required")
+	} else if utf8.RuneCountInString(name) > collection.MaxNameLength {
+		errs.AddField("name", fmt.Sprintf("name must be at most %d characters", collection.MaxNameLength))
+	}
+	if utf8.RuneCountInString(description) > collection.MaxDescriptionLength {
+		errs.AddField("description", fmt.Sprintf("description must be at most %d characters", collection.MaxDescriptionLength))
+	}
+	if len(walkIDs) > collection.MaxWalks {
+		errs.AddField("walk_ids", fmt.Sprintf("a collection can contain at most %d walks", collection.MaxWalks))
+	}
+
+	return errs
+}
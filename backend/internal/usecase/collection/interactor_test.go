@@ -0,0 +1,177 @@
+package collection
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/collection"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockCollectionRepository はcollection.Repositoryのモック
+type MockCollectionRepository struct {
+	mock.Mock
+}
+
+func (m *MockCollectionRepository) ListByUserID(ctx context.Context, userID string) ([]*collection.Collection, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*collection.Collection), args.Error(1)
+}
+
+func (m *MockCollectionRepository) FindByID(ctx context.Context, id uuid.UUID) (*collection.Collection, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*collection.Collection), args.Error(1)
+}
+
+func (m *MockCollectionRepository) Create(ctx context.Context, c *collection.Collection) error {
+	return m.Called(ctx, c).Error(0)
+}
+
+func (m *MockCollectionRepository) Update(ctx context.Context, c *collection.Collection) error {
+	return m.Called(ctx, c).Error(0)
+}
+
+func (m *MockCollectionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockCollectionRepository) CountOwnedWalks(ctx context.Context, userID string, walkIDs []uuid.UUID) (int, error) {
+	args := m.Called(ctx, userID, walkIDs)
+	return args.Int(0), args.Error(1)
+}
+
+func setupInteractor() (Usecase, *MockCollectionRepository) {
+	collectionRepo := new(MockCollectionRepository)
+	return NewInteractor(collectionRepo), collectionRepo
+}
+
+func TestCreateCollection(t *testing.T) {
+	ctx := context.Background()
+	a, b := uuid.New(), uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		it, repo := setupInteractor()
+		repo.On("CountOwnedWalks", ctx, "alice", []uuid.UUID{b, a}).Return(2, nil)
+		repo.On("Create", ctx, mock.AnythingOfType("*collection.Collection")).Return(nil)
+
+		c, err := it.CreateCollection(ctx, CreateCollectionInput{
+			UserID:  "alice",
+			Name:    " 京都旅行 ",
+			WalkIDs: []uuid.UUID{b, a, b},
+		})
+
+		// 期待値: 名前の空白が除去され、重複を除いた指定順で保存される
+		require.NoError(t, err)
+		assert.Equal(t, "京都旅行", c.Name)
+		assert.Equal(t, []uuid.UUID{b, a}, c.WalkIDs)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("other user's walk", func(t *testing.T) {
+		it, repo := setupInteractor()
+		repo.On("CountOwnedWalks", ctx, "alice", []uuid.UUID{a, b}).Return(1, nil)
+
+		_, err := it.CreateCollection(ctx, CreateCollectionInput{
+			UserID:  "alice",
+			Name:    "京都旅行",
+			WalkIDs: []uuid.UUID{a, b},
+		})
+
+		// 期待値: 所有していない散歩は含められない
+		assert.ErrorIs(t, err, walk.ErrNotOwner)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("blank name", func(t *testing.T) {
+		it, _ := setupInteractor()
+
+		_, err := it.CreateCollection(ctx, CreateCollectionInput{UserID: "alice", Name: "  "})
+
+		// 期待値: nameフィールドのバリデーションエラー
+		var errs validator.ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		assert.Equal(t, "name", errs[0].Field)
+	})
+}
+
+func TestUpdateCollection_Reorder(t *testing.T) {
+	it, repo := setupInteractor()
+	ctx := context.Background()
+	a, b := uuid.New(), uuid.New()
+
+	existing := collection.NewCollection("alice", "朝の散歩", "")
+	existing.SetWalks([]uuid.UUID{a, b})
+	repo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+	repo.On("CountOwnedWalks", ctx, "alice", []uuid.UUID{b, a}).Return(2, nil)
+	repo.On("Update", ctx, existing).Return(nil)
+
+	reordered := []uuid.UUID{b, a}
+	c, err := it.UpdateCollection(ctx, UpdateCollectionInput{ID: existing.ID, WalkIDs: &reordered}, "alice")
+
+	// 期待値: 並び順が置き換わり、名前は変わらない
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{b, a}, c.WalkIDs)
+	assert.Equal(t, "朝の散歩", c.Name)
+	repo.AssertExpectations(t)
+}
+
+func TestGetCollection_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("other user's collection", func(t *testing.T) {
+		it, repo := setupInteractor()
+		existing := collection.NewCollection("bob", "朝の散歩", "")
+		repo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+
+		// 期待値: 他のユーザーのコレクションは存在しない扱い
+		_, err := it.GetCollection(ctx, existing.ID, "alice")
+		assert.ErrorIs(t, err, collection.ErrCollectionNotFound)
+	})
+
+	t.Run("missing collection", func(t *testing.T) {
+		it, repo := setupInteractor()
+		id := uuid.New()
+		repo.On("FindByID", ctx, id).Return(nil, sql.ErrNoRows)
+
+		_, err := it.GetCollection(ctx, id, "alice")
+		assert.ErrorIs(t, err, collection.ErrCollectionNotFound)
+	})
+}
+
+func TestAddRemoveWalk(t *testing.T) {
+	ctx := context.Background()
+	a := uuid.New()
+
+	t.Run("add is idempotent", func(t *testing.T) {
+		it, repo := setupInteractor()
+		existing := collection.NewCollection("alice", "朝の散歩", "")
+		existing.SetWalks([]uuid.UUID{a})
+		repo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+		repo.On("CountOwnedWalks", ctx, "alice", []uuid.UUID{a}).Return(1, nil)
+
+		// 期待値: 追加済みの散歩は保存せずにそのまま返す
+		c, err := it.AddWalk(ctx, existing.ID, a, "alice")
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{a}, c.WalkIDs)
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("remove missing walk", func(t *testing.T) {
+		it, repo := setupInteractor()
+		existing := collection.NewCollection("alice", "朝の散歩", "")
+		repo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+
+		// 期待値: 含まれていない散歩は外せない
+		_, err := it.RemoveWalk(ctx, existing.ID, a, "alice")
+		assert.ErrorIs(t, err, collection.ErrWalkNotInCollection)
+	})
+}
@@ -0,0 +1,112 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/outbox"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/logger"
+	"go.uber.org/zap"
+)
+
+// errLeaseExpired は試行回数の上限に達したイベントが配信中のまま取得期限を過ぎたことを表す
+var errLeaseExpired = errors.New("lease expired after the last attempt")
+
+// dispatcher はDispatcherの実装
+type dispatcher struct {
+	outboxRepo  outbox.Repository
+	tx          TxManager
+	subscribers map[string][]Subscriber
+	logger      logger.Logger
+	now         func() time.Time
+}
+
+// NewDispatcher は新しいDispatcherを生成する
+// subscribers はイベント名ごとの購読者。1つのイベントの購読者には登録順に配信する
+func NewDispatcher(outboxRepo outbox.Repository, tx TxManager, subscribers map[string][]Subscriber, log logger.Logger) Dispatcher {
+	return &dispatcher{
+		outboxRepo:  outboxRepo,
+		tx:          tx,
+		subscribers: subscribers,
+		logger:      log,
+		now:         time.Now,
+	}
+}
+
+// ProcessNext は配信できるイベントを1件取得して購読者に配信する
+// 購読者の書き込みとイベントの削除は1つのトランザクションで行い、いずれかの購読者が失敗した場合はどれも反映しない
+// 失敗したイベントは試行回数に応じて再配信または配信をあきらめる
+func (d *dispatcher) ProcessNext(ctx context.Context) (bool, error) {
+	m, err := d.outboxRepo.ClaimNext(ctx, outbox.LeaseDuration)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to claim event: %w", err)
+	}
+
+	fields := []zap.Field{
+		zap.Int64("event_id", m.ID),
+		zap.String("event_name", m.EventName),
+		zap.String("walk_id", m.WalkID.String()),
+		zap.Int("attempts", m.Attempts),
+	}
+
+	// 最後の試行でディスパッチャーが異常終了したイベントは、上限を超えて配信しない
+	if m.Attempts > outbox.MaxAttempts {
+		m.Fail(errLeaseExpired, d.now())
+		return true, d.saveFailure(ctx, m, errLeaseExpired, fields)
+	}
+
+	e, err := walk.DecodeEvent(m.EventName, m.Payload)
+	if err != nil {
+		// 復元できないイベントは再配信しても成功しないため、すぐに配信をあきらめる
+		m.Bury(err)
+		return true, d.saveFailure(ctx, m, err, fields)
+	}
+
+	err = d.tx.WithinTx(ctx, func(ctx context.Context) error {
+		for _, s := range d.subscribers[m.EventName] {
+			if err := deliver(ctx, s, e); err != nil {
+				return err
+			}
+		}
+		if err := d.outboxRepo.MarkDispatched(ctx, m.ID); err != nil {
+			return fmt.Errorf("failed to mark event dispatched: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		m.Fail(err, d.now())
+		return true, d.saveFailure(ctx, m, err, fields)
+	}
+	return true, nil
+}
+
+// saveFailure は配信に失敗したイベントの状態を保存し、ログに残す
+func (d *dispatcher) saveFailure(ctx context.Context, m *outbox.Message, cause error, fields []zap.Field) error {
+	if m.IsDead() {
+		d.logger.Error("Event dispatch abandoned", append(fields, zap.Error(cause))...)
+	} else {
+		d.logger.Warn("Event dispatch failed, will retry", append(fields, zap.Time("next_attempt_at", m.NextAttemptAt), zap.Error(cause))...)
+	}
+
+	if err := d.outboxRepo.SaveFailure(ctx, m); err != nil {
+		return fmt.Errorf("failed to save event failure: %w", err)
+	}
+	return nil
+}
+
+// deliver は購読者にイベントを渡す。購読者のpanicは失敗として扱い、ディスパッチャーを止めない
+func deliver(ctx context.Context, s Subscriber, e walk.DomainEvent) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("event subscriber panicked: %v", r)
+		}
+	}()
+	return s.HandleEvent(ctx, e)
+}
@@ -0,0 +1,244 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/job"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/outbox"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockOutboxRepository はoutbox.Repositoryのモック
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) ClaimNext(ctx context.Context, lease time.Duration) (*outbox.Message, error) {
+	args := m.Called(ctx, lease)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*outbox.Message), args.Error(1)
+}
+
+func (m *MockOutboxRepository) MarkDispatched(ctx context.Context, id int64) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockOutboxRepository) SaveFailure(ctx context.Context, msg *outbox.Message) error {
+	return m.Called(ctx, msg).Error(0)
+}
+
+// stubTxManager は fn をそのまま実行するTxManagerのスタブ
+type stubTxManager struct{}
+
+func (stubTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+var testNow = time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+func setupDispatcher(subscribers map[string][]Subscriber) (*MockOutboxRepository, Dispatcher) {
+	outboxRepo := new(MockOutboxRepository)
+	d := NewDispatcher(outboxRepo, stubTxManager{}, subscribers, logger.NewNopLogger()).(*dispatcher)
+	d.now = func() time.Time { return testNow }
+	return outboxRepo, d
+}
+
+// claimedMessage は取得済み（試行回数を増やした後）のイベントを生成する
+func claimedMessage(name string, attempts int) *outbox.Message {
+	walkID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	return &outbox.Message{
+		ID:         1,
+		WalkID:     walkID,
+		UserID:     "user-123",
+		EventName:  name,
+		Payload:    []byte(`{"walk_id":"00000000-0000-0000-0000-000000000001","user_id":"user-123","occurred_at":"2024-01-01T08:00:00Z"}`),
+		OccurredAt: testNow.Add(-time.Hour),
+		Status:     outbox.StatusPending,
+		Attempts:   attempts,
+	}
+}
+
+func TestDispatcher_ProcessNext(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("イベントがない場合はfalse", func(t *testing.T) {
+		outboxRepo, d := setupDispatcher(nil)
+		outboxRepo.On("ClaimNext", ctx, outbox.LeaseDuration).Return(nil, sql.ErrNoRows)
+
+		processed, err := d.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.False(t, processed)
+	})
+
+	t.Run("全ての購読者に登録順に配信し、配信済みにする", func(t *testing.T) {
+		var calls []string
+		var received walk.DomainEvent
+		outboxRepo, d := setupDispatcher(map[string][]Subscriber{
+			walk.EventWalkCompleted: {
+				SubscriberFunc(func(_ context.Context, e walk.DomainEvent) error {
+					calls = append(calls, "first")
+					received = e
+					return nil
+				}),
+				SubscriberFunc(func(context.Context, walk.DomainEvent) error {
+					calls = append(calls, "second")
+					return nil
+				}),
+			},
+		})
+		m := claimedMessage(walk.EventWalkCompleted, 1)
+		outboxRepo.On("ClaimNext", ctx, outbox.LeaseDuration).Return(m, nil)
+		outboxRepo.On("MarkDispatched", ctx, m.ID).Return(nil)
+
+		processed, err := d.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.True(t, processed)
+
+		// 期待値: 保存したイベントを復元して渡す
+		assert.Equal(t, []string{"first", "second"}, calls)
+		completed, ok := received.(*walk.WalkCompleted)
+		require.True(t, ok)
+		assert.Equal(t, m.WalkID, completed.WalkID)
+		assert.Equal(t, "user-123", completed.UserID)
+		outboxRepo.AssertExpectations(t)
+	})
+
+	t.Run("購読者がいないイベントは配信済みにする", func(t *testing.T) {
+		outboxRepo, d := setupDispatcher(nil)
+		m := claimedMessage(walk.EventWalkCreated, 1)
+		outboxRepo.On("ClaimNext", ctx, outbox.LeaseDuration).Return(m, nil)
+		outboxRepo.On("MarkDispatched", ctx, m.ID).Return(nil)
+
+		processed, err := d.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.True(t, processed)
+		outboxRepo.AssertExpectations(t)
+	})
+
+	t.Run("購読者が失敗した場合は後続の購読者に配信せず、バックオフ後に再配信する", func(t *testing.T) {
+		secondCalled := false
+		outboxRepo, d := setupDispatcher(map[string][]Subscriber{
+			walk.EventWalkCompleted: {
+				SubscriberFunc(func(context.Context, walk.DomainEvent) error { return errors.New("boom") }),
+				SubscriberFunc(func(context.Context, walk.DomainEvent) error { secondCalled = true; return nil }),
+			},
+		})
+		m := claimedMessage(walk.EventWalkCompleted, 1)
+		outboxRepo.On("ClaimNext", ctx, outbox.LeaseDuration).Return(m, nil)
+		outboxRepo.On("SaveFailure", ctx, m).Return(nil)
+
+		processed, err := d.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.True(t, processed)
+
+		assert.False(t, secondCalled)
+		assert.Equal(t, outbox.StatusPending, m.Status)
+		assert.Equal(t, testNow.Add(job.BaseBackoff), m.NextAttemptAt)
+		require.NotNil(t, m.LastError)
+		assert.Equal(t, "boom", *m.LastError)
+		outboxRepo.AssertNotCalled(t, "MarkDispatched", mock.Anything, mock.Anything)
+	})
+
+	t.Run("配信済みにできなかった場合は失敗として再配信する", func(t *testing.T) {
+		outboxRepo, d := setupDispatcher(map[string][]Subscriber{
+			walk.EventWalkCompleted: {
+				SubscriberFunc(func(context.Context, walk.DomainEvent) error { return nil }),
+			},
+		})
+		m := claimedMessage(walk.EventWalkCompleted, 1)
+		outboxRepo.On("ClaimNext", ctx, outbox.LeaseDuration).Return(m, nil)
+		outboxRepo.On("MarkDispatched", ctx, m.ID).Return(errors.New("db error"))
+		outboxRepo.On("SaveFailure", ctx, m).Return(nil)
+
+		processed, err := d.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.True(t, processed)
+
+		// 期待値: 購読者の書き込みはロールバックされるため、イベントを再配信する
+		assert.Equal(t, outbox.StatusPending, m.Status)
+		require.NotNil(t, m.LastError)
+		assert.Contains(t, *m.LastError, "failed to mark event dispatched")
+	})
+
+	t.Run("購読者のpanicは失敗として扱う", func(t *testing.T) {
+		outboxRepo, d := setupDispatcher(map[string][]Subscriber{
+			walk.EventWalkCompleted: {
+				SubscriberFunc(func(context.Context, walk.DomainEvent) error { panic("unexpected") }),
+			},
+		})
+		m := claimedMessage(walk.EventWalkCompleted, 1)
+		outboxRepo.On("ClaimNext", ctx, outbox.LeaseDuration).Return(m, nil)
+		outboxRepo.On("SaveFailure", ctx, m).Return(nil)
+
+		_, err := d.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, outbox.StatusPending, m.Status)
+		require.NotNil(t, m.LastError)
+		assert.Contains(t, *m.LastError, "unexpected")
+	})
+
+	t.Run("最後の試行で失敗したイベントは配信をあきらめる", func(t *testing.T) {
+		outboxRepo, d := setupDispatcher(map[string][]Subscriber{
+			walk.EventWalkCompleted: {
+				SubscriberFunc(func(context.Context, walk.DomainEvent) error { return errors.New("boom") }),
+			},
+		})
+		m := claimedMessage(walk.EventWalkCompleted, outbox.MaxAttempts)
+		outboxRepo.On("ClaimNext", ctx, outbox.LeaseDuration).Return(m, nil)
+		outboxRepo.On("SaveFailure", ctx, m).Return(nil)
+
+		_, err := d.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.True(t, m.IsDead())
+	})
+
+	t.Run("復元できないイベントはすぐに配信をあきらめる", func(t *testing.T) {
+		outboxRepo, d := setupDispatcher(nil)
+		m := claimedMessage("walk.unknown", 1)
+		outboxRepo.On("ClaimNext", ctx, outbox.LeaseDuration).Return(m, nil)
+		outboxRepo.On("SaveFailure", ctx, m).Return(nil)
+
+		_, err := d.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.True(t, m.IsDead())
+		require.NotNil(t, m.LastError)
+		assert.Contains(t, *m.LastError, "walk.unknown")
+	})
+
+	t.Run("上限を超えて取得し直されたイベントは配信せずあきらめる", func(t *testing.T) {
+		called := false
+		outboxRepo, d := setupDispatcher(map[string][]Subscriber{
+			walk.EventWalkCompleted: {
+				SubscriberFunc(func(context.Context, walk.DomainEvent) error { called = true; return nil }),
+			},
+		})
+		m := claimedMessage(walk.EventWalkCompleted, outbox.MaxAttempts+1)
+		outboxRepo.On("ClaimNext", ctx, outbox.LeaseDuration).Return(m, nil)
+		outboxRepo.On("SaveFailure", ctx, m).Return(nil)
+
+		_, err := d.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.False(t, called)
+		assert.True(t, m.IsDead())
+	})
+
+	t.Run("取得に失敗した場合はエラー", func(t *testing.T) {
+		outboxRepo, d := setupDispatcher(nil)
+		outboxRepo.On("ClaimNext", ctx, outbox.LeaseDuration).Return(nil, errors.New("db error"))
+
+		processed, err := d.ProcessNext(ctx)
+		assert.Error(t, err)
+		assert.False(t, processed)
+	})
+}
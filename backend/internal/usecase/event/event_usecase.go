@@ -0,0 +1,37 @@
+package event
+
+import (
+	"context"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+)
+
+// Subscriber はドメインイベントの購読者
+// 配信に失敗したイベントは同じ購読者にも再配信されるため、同じイベントを複数回受け取っても結果が変わらないように実装する
+type Subscriber interface {
+	// HandleEvent はイベントを処理する。エラーを返した場合はバックオフ後に再配信する
+	// ctx はイベントの削除と同じトランザクションを持つため、リポジトリにはこの ctx を渡す
+	HandleEvent(ctx context.Context, e walk.DomainEvent) error
+}
+
+// SubscriberFunc は関数をSubscriberとして扱うための型
+type SubscriberFunc func(ctx context.Context, e walk.DomainEvent) error
+
+// HandleEvent はイベントを処理する
+func (f SubscriberFunc) HandleEvent(ctx context.Context, e walk.DomainEvent) error {
+	return f(ctx, e)
+}
+
+// TxManager は複数の書き込みを1つのトランザクションにまとめるインターフェース
+type TxManager interface {
+	// WithinTx は fn を1つのトランザクションで実行し、fn がエラーを返した場合は全ての書き込みを取り消す
+	// リポジトリには fn に渡されたコンテキストを渡すこと
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Dispatcher はアウトボックスに保存したドメインイベントを購読者に配信するユースケースインターフェース
+type Dispatcher interface {
+	// ProcessNext は配信できるイベントを1件取得して購読者に配信する
+	// 処理したイベントがあった場合は true を返す。配信の失敗はエラーとして返さず、再配信または配信をあきらめる
+	ProcessNext(ctx context.Context) (bool, error)
+}
@@ -0,0 +1,124 @@
+package job
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/job"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/logger"
+	"go.uber.org/zap"
+)
+
+var (
+	// errNoHandler はジョブの種類に対応するハンドラーが登録されていないことを表す
+	errNoHandler = errors.New("no handler registered for job type")
+	// errLeaseExpired は試行回数の上限に達したジョブが処理中のまま取得期限を過ぎたことを表す
+	errLeaseExpired = errors.New("lease expired after the last attempt")
+)
+
+// interactor はバックグラウンドジョブUsecaseの実装
+type interactor struct {
+	jobRepo     job.Repository
+	tx          TxManager
+	handlers    map[string]Handler
+	maxAttempts int
+	logger      logger.Logger
+	now         func() time.Time
+}
+
+// NewInteractor は新しいバックグラウンドジョブInteractorを生成する
+// handlers はジョブの種類ごとのハンドラー。maxAttempts が1未満の場合は job.DefaultMaxAttempts を使う
+func NewInteractor(jobRepo job.Repository, tx TxManager, handlers map[string]Handler, maxAttempts int, log logger.Logger) Usecase {
+	return &interactor{
+		jobRepo:     jobRepo,
+		tx:          tx,
+		handlers:    handlers,
+		maxAttempts: maxAttempts,
+		logger:      log,
+		now:         time.Now,
+	}
+}
+
+// Enqueue はジョブを追加する
+func (i *interactor) Enqueue(ctx context.Context, jobType string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode job payload: %w", err)
+	}
+
+	if err := i.jobRepo.Enqueue(ctx, job.NewJob(jobType, data, i.maxAttempts)); err != nil {
+		return fmt.Errorf("failed to enqueue job: %w", err)
+	}
+	return nil
+}
+
+// ProcessNext は実行できるジョブを1件取得して処理する
+// ハンドラーの書き込みとジョブの削除は1つのトランザクションで行い、失敗した場合はどちらも反映しない
+// 失敗したジョブは試行回数に応じて再試行またはデッドレターにする
+func (i *interactor) ProcessNext(ctx context.Context) (bool, error) {
+	j, err := i.jobRepo.ClaimNext(ctx, job.LeaseDuration)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to claim job: %w", err)
+	}
+
+	fields := []zap.Field{zap.String("job_id", j.ID.String()), zap.String("job_type", j.Type), zap.Int("attempts", j.Attempts)}
+
+	// 最後の試行でワーカーが異常終了したジョブは、上限を超えて実行しない
+	if j.Attempts > j.MaxAttempts {
+		j.Fail(errLeaseExpired, i.now())
+		return true, i.saveFailure(ctx, j, errLeaseExpired, fields)
+	}
+
+	handler, ok := i.handlers[j.Type]
+	if !ok {
+		// 登録されていない種類のジョブは再試行しても成功しないため、すぐにデッドレターにする
+		j.Bury(errNoHandler, i.now())
+		return true, i.saveFailure(ctx, j, errNoHandler, fields)
+	}
+
+	err = i.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := handle(ctx, handler, j.Payload); err != nil {
+			return err
+		}
+		if err := i.jobRepo.Complete(ctx, j.ID); err != nil {
+			return fmt.Errorf("failed to complete job: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		j.Fail(err, i.now())
+		return true, i.saveFailure(ctx, j, err, fields)
+	}
+	return true, nil
+}
+
+// saveFailure は失敗したジョブの状態を保存し、ログに残す
+func (i *interactor) saveFailure(ctx context.Context, j *job.Job, cause error, fields []zap.Field) error {
+	if j.IsDead() {
+		i.logger.Error("Job moved to dead letter", append(fields, zap.Error(cause))...)
+	} else {
+		i.logger.Warn("Job failed, will retry", append(fields, zap.Time("run_at", j.RunAt), zap.Error(cause))...)
+	}
+
+	if err := i.jobRepo.SaveFailure(ctx, j); err != nil {
+		return fmt.Errorf("failed to save job failure: %w", err)
+	}
+	return nil
+}
+
+// handle はハンドラーを呼び出す。ハンドラーのpanicは失敗として扱い、ワーカーを止めない
+func handle(ctx context.Context, h Handler, payload []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job handler panicked: %v", r)
+		}
+	}()
+	return h.Handle(ctx, payload)
+}
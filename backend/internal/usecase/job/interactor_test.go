@@ -0,0 +1,220 @@
+package job
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/job"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockJobRepository はjob.Repositoryのモック
+type MockJobRepository struct {
+	mock.Mock
+}
+
+func (m *MockJobRepository) Enqueue(ctx context.Context, j *job.Job) error {
+	return m.Called(ctx, j).Error(0)
+}
+
+func (m *MockJobRepository) ClaimNext(ctx context.Context, lease time.Duration) (*job.Job, error) {
+	args := m.Called(ctx, lease)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobRepository) Complete(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockJobRepository) SaveFailure(ctx context.Context, j *job.Job) error {
+	return m.Called(ctx, j).Error(0)
+}
+
+// stubTxManager は fn をそのまま実行するTxManagerのスタブ
+type stubTxManager struct{}
+
+func (stubTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+var testNow = time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+func setupInteractor(handlers map[string]Handler) (*MockJobRepository, Usecase) {
+	jobRepo := new(MockJobRepository)
+	it := NewInteractor(jobRepo, stubTxManager{}, handlers, 3, logger.NewNopLogger()).(*interactor)
+	it.now = func() time.Time { return testNow }
+	return jobRepo, it
+}
+
+// claimedJob は取得済み（試行回数を増やした後）のジョブを生成する
+func claimedJob(jobType string, attempts int) *job.Job {
+	j := job.NewJob(jobType, []byte(`{"walk_id":"00000000-0000-0000-0000-000000000001"}`), 3)
+	j.Status = job.StatusRunning
+	j.Attempts = attempts
+	return j
+}
+
+func TestEnqueue(t *testing.T) {
+	ctx := context.Background()
+	jobRepo, uc := setupInteractor(nil)
+
+	var enqueued *job.Job
+	jobRepo.On("Enqueue", ctx, mock.AnythingOfType("*job.Job")).
+		Run(func(args mock.Arguments) { enqueued = args.Get(1).(*job.Job) }).
+		Return(nil)
+
+	err := uc.Enqueue(ctx, TypeWalkCompleted, WalkCompletedPayload{WalkID: uuid.Nil})
+	require.NoError(t, err)
+
+	// 期待値: ペイロードをJSONにエンコードし、設定した試行回数の上限で追加する
+	require.NotNil(t, enqueued)
+	assert.Equal(t, TypeWalkCompleted, enqueued.Type)
+	assert.JSONEq(t, `{"walk_id":"00000000-0000-0000-0000-000000000000"}`, string(enqueued.Payload))
+	assert.Equal(t, 3, enqueued.MaxAttempts)
+	assert.Equal(t, job.StatusPending, enqueued.Status)
+}
+
+func TestProcessNext(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ジョブがない場合はfalse", func(t *testing.T) {
+		jobRepo, uc := setupInteractor(nil)
+		jobRepo.On("ClaimNext", ctx, job.LeaseDuration).Return(nil, sql.ErrNoRows)
+
+		processed, err := uc.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.False(t, processed)
+	})
+
+	t.Run("成功したジョブは削除する", func(t *testing.T) {
+		var received []byte
+		jobRepo, uc := setupInteractor(map[string]Handler{
+			"test": HandlerFunc(func(_ context.Context, payload []byte) error {
+				received = payload
+				return nil
+			}),
+		})
+		j := claimedJob("test", 1)
+		jobRepo.On("ClaimNext", ctx, job.LeaseDuration).Return(j, nil)
+		jobRepo.On("Complete", ctx, j.ID).Return(nil)
+
+		processed, err := uc.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.True(t, processed)
+
+		// 期待値: ハンドラーにペイロードが渡される
+		assert.Equal(t, j.Payload, received)
+		jobRepo.AssertExpectations(t)
+	})
+
+	t.Run("失敗したジョブはバックオフ後に再試行する", func(t *testing.T) {
+		jobRepo, uc := setupInteractor(map[string]Handler{
+			"test": HandlerFunc(func(context.Context, []byte) error { return errors.New("boom") }),
+		})
+		j := claimedJob("test", 1)
+		jobRepo.On("ClaimNext", ctx, job.LeaseDuration).Return(j, nil)
+		jobRepo.On("SaveFailure", ctx, j).Return(nil)
+
+		processed, err := uc.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.True(t, processed)
+
+		assert.Equal(t, job.StatusPending, j.Status)
+		assert.Equal(t, testNow.Add(job.BaseBackoff), j.RunAt)
+		require.NotNil(t, j.LastError)
+		assert.Equal(t, "boom", *j.LastError)
+		jobRepo.AssertNotCalled(t, "Complete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ジョブの削除に失敗した場合は失敗として再試行する", func(t *testing.T) {
+		jobRepo, uc := setupInteractor(map[string]Handler{
+			"test": HandlerFunc(func(context.Context, []byte) error { return nil }),
+		})
+		j := claimedJob("test", 1)
+		jobRepo.On("ClaimNext", ctx, job.LeaseDuration).Return(j, nil)
+		jobRepo.On("Complete", ctx, j.ID).Return(errors.New("db error"))
+		jobRepo.On("SaveFailure", ctx, j).Return(nil)
+
+		processed, err := uc.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.True(t, processed)
+
+		// 期待値: ハンドラーの書き込みはロールバックされるため、ジョブを再試行する
+		assert.Equal(t, job.StatusPending, j.Status)
+		require.NotNil(t, j.LastError)
+		assert.Contains(t, *j.LastError, "failed to complete job")
+	})
+
+	t.Run("最後の試行で失敗したジョブはデッドレターにする", func(t *testing.T) {
+		jobRepo, uc := setupInteractor(map[string]Handler{
+			"test": HandlerFunc(func(context.Context, []byte) error { return errors.New("boom") }),
+		})
+		j := claimedJob("test", 3)
+		jobRepo.On("ClaimNext", ctx, job.LeaseDuration).Return(j, nil)
+		jobRepo.On("SaveFailure", ctx, j).Return(nil)
+
+		_, err := uc.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.True(t, j.IsDead())
+	})
+
+	t.Run("ハンドラーのpanicは失敗として扱う", func(t *testing.T) {
+		jobRepo, uc := setupInteractor(map[string]Handler{
+			"test": HandlerFunc(func(context.Context, []byte) error { panic("unexpected") }),
+		})
+		j := claimedJob("test", 1)
+		jobRepo.On("ClaimNext", ctx, job.LeaseDuration).Return(j, nil)
+		jobRepo.On("SaveFailure", ctx, j).Return(nil)
+
+		_, err := uc.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, job.StatusPending, j.Status)
+		require.NotNil(t, j.LastError)
+		assert.Contains(t, *j.LastError, "unexpected")
+	})
+
+	t.Run("ハンドラーが登録されていないジョブはすぐにデッドレターにする", func(t *testing.T) {
+		jobRepo, uc := setupInteractor(nil)
+		j := claimedJob("unknown", 1)
+		jobRepo.On("ClaimNext", ctx, job.LeaseDuration).Return(j, nil)
+		jobRepo.On("SaveFailure", ctx, j).Return(nil)
+
+		_, err := uc.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.True(t, j.IsDead())
+	})
+
+	t.Run("上限を超えて取得し直されたジョブは実行せずデッドレターにする", func(t *testing.T) {
+		called := false
+		jobRepo, uc := setupInteractor(map[string]Handler{
+			"test": HandlerFunc(func(context.Context, []byte) error { called = true; return nil }),
+		})
+		j := claimedJob("test", 4)
+		jobRepo.On("ClaimNext", ctx, job.LeaseDuration).Return(j, nil)
+		jobRepo.On("SaveFailure", ctx, j).Return(nil)
+
+		_, err := uc.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.False(t, called)
+		assert.True(t, j.IsDead())
+	})
+
+	t.Run("取得に失敗した場合はエラー", func(t *testing.T) {
+		jobRepo, uc := setupInteractor(nil)
+		jobRepo.On("ClaimNext", ctx, job.LeaseDuration).Return(nil, errors.New("db error"))
+
+		processed, err := uc.ProcessNext(ctx)
+		assert.Error(t, err)
+		assert.False(t, processed)
+	})
+}
@@ -0,0 +1,37 @@
+package job
+
+import (
+	"context"
+)
+
+// Handler はジョブの種類ごとの処理
+// 失敗したジョブは再試行されるため、同じジョブが複数回実行されても結果が変わらないように実装する
+type Handler interface {
+	// Handle はジョブを処理する。エラーを返した場合はバックオフ後に再試行する
+	// ctx はジョブの削除と同じトランザクションを持つため、リポジトリにはこの ctx を渡す
+	Handle(ctx context.Context, payload []byte) error
+}
+
+// HandlerFunc は関数をHandlerとして扱うための型
+type HandlerFunc func(ctx context.Context, payload []byte) error
+
+// Handle はジョブを処理する
+func (f HandlerFunc) Handle(ctx context.Context, payload []byte) error {
+	return f(ctx, payload)
+}
+
+// TxManager は複数の書き込みを1つのトランザクションにまとめるインターフェース
+type TxManager interface {
+	// WithinTx は fn を1つのトランザクションで実行し、fn がエラーを返した場合は全ての書き込みを取り消す
+	// リポジトリには fn に渡されたコンテキストを渡すこと
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Usecase はバックグラウンドジョブのユースケースインターフェース
+type Usecase interface {
+	// Enqueue はジョブを追加する。payload はJSONにエンコードしてハンドラーに渡す
+	Enqueue(ctx context.Context, jobType string, payload any) error
+	// ProcessNext は実行できるジョブを1件取得して処理する
+	// 処理したジョブがあった場合は true を返す。ジョブの失敗はエラーとして返さず、再試行またはデッドレターにする
+	ProcessNext(ctx context.Context) (bool, error)
+}
@@ -0,0 +1,111 @@
+package job
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/event"
+	"github.com/google/uuid"
+)
+
+const (
+	// TypeWalkCompleted は完了した散歩を記録・実績に反映するジョブ
+	TypeWalkCompleted = "walk.completed"
+	// TypeWalkThumbnail は完了した散歩のサムネイル画像を生成するジョブ
+	// 描画・保存の失敗で記録・実績の反映がロールバックされないよう、TypeWalkCompleted とは別のジョブにする
+	TypeWalkThumbnail = "walk.thumbnail"
+)
+
+// WalkCompletedPayload は TypeWalkCompleted・TypeWalkThumbnail のジョブに渡す内容
+type WalkCompletedPayload struct {
+	WalkID uuid.UUID `json:"walk_id"`
+}
+
+// CompletionRecorder は完了した散歩を反映する処理のインターフェース
+type CompletionRecorder interface {
+	// RecordCompletedWalk は完了した散歩と全位置情報から記録を更新する
+	RecordCompletedWalk(ctx context.Context, w *walk.Walk, locations []*walk.WalkLocation) error
+}
+
+// CompletionRecorders は複数のCompletionRecorderを順に呼び出す
+// 前の記録の結果を後の記録が参照する場合があるため、順序に意味がある
+type CompletionRecorders []CompletionRecorder
+
+// RecordCompletedWalk は各CompletionRecorderを順に呼び出し、最初のエラーで中断する
+func (rs CompletionRecorders) RecordCompletedWalk(ctx context.Context, w *walk.Walk, locations []*walk.WalkLocation) error {
+	for _, r := range rs {
+		if err := r.RecordCompletedWalk(ctx, w, locations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// completionSubscriber は散歩の完了イベントをジョブとして追加する購読者
+type completionSubscriber struct {
+	jobs    Usecase
+	jobType string
+}
+
+// NewCompletionSubscriber は walk.WalkCompleted のイベントを受け取り、jobType のジョブを追加する購読者を生成する
+func NewCompletionSubscriber(jobs Usecase, jobType string) event.Subscriber {
+	return &completionSubscriber{jobs: jobs, jobType: jobType}
+}
+
+// HandleEvent は完了した散歩をジョブとして追加する
+// 位置情報はジョブの実行時に保存済みのものを取得し直すため、ジョブには含めない
+func (s *completionSubscriber) HandleEvent(ctx context.Context, e walk.DomainEvent) error {
+	completed, ok := e.(*walk.WalkCompleted)
+	if !ok {
+		return nil
+	}
+	return s.jobs.Enqueue(ctx, s.jobType, WalkCompletedPayload{WalkID: completed.WalkID})
+}
+
+// walkCompletedHandler は TypeWalkCompleted・TypeWalkThumbnail のジョブのハンドラー
+type walkCompletedHandler struct {
+	walkRepo     walk.Repository
+	locationRepo walk.LocationRepository
+	recorder     CompletionRecorder
+}
+
+// NewWalkCompletedHandler は完了した散歩を recorder に反映するジョブのハンドラーを生成する
+// 再試行時は recorder 全体をやり直すため、recorder の各処理は同じ散歩を複数回反映しても結果が変わらないこと
+func NewWalkCompletedHandler(walkRepo walk.Repository, locationRepo walk.LocationRepository, recorder CompletionRecorder) Handler {
+	return &walkCompletedHandler{
+		walkRepo:     walkRepo,
+		locationRepo: locationRepo,
+		recorder:     recorder,
+	}
+}
+
+// Handle は散歩と位置情報を取得し、完了した散歩を反映する
+// ジョブの実行前に散歩が削除された場合は何もしない
+func (h *walkCompletedHandler) Handle(ctx context.Context, payload []byte) error {
+	var p WalkCompletedPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	w, err := h.walkRepo.FindByID(ctx, p.WalkID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get walk: %w", err)
+	}
+
+	locations, err := h.locationRepo.FindByWalkID(ctx, w.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get walk locations: %w", err)
+	}
+
+	if err := h.recorder.RecordCompletedWalk(ctx, w, locations); err != nil {
+		return fmt.Errorf("failed to record completed walk: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package job
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockWalkRepository はwalk.Repositoryのモック（使用するメソッドのみ実装）
+type MockWalkRepository struct {
+	mock.Mock
+	walk.Repository
+}
+
+func (m *MockWalkRepository) FindByID(ctx context.Context, id uuid.UUID) (*walk.Walk, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walk.Walk), args.Error(1)
+}
+
+// MockLocationRepository はwalk.LocationRepositoryのモック（使用するメソッドのみ実装）
+type MockLocationRepository struct {
+	mock.Mock
+	walk.LocationRepository
+}
+
+func (m *MockLocationRepository) FindByWalkID(ctx context.Context, walkID uuid.UUID) ([]*walk.WalkLocation, error) {
+	args := m.Called(ctx, walkID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*walk.WalkLocation), args.Error(1)
+}
+
+// MockCompletionRecorder はCompletionRecorderのモック
+type MockCompletionRecorder struct {
+	mock.Mock
+}
+
+func (m *MockCompletionRecorder) RecordCompletedWalk(ctx context.Context, w *walk.Walk, locations []*walk.WalkLocation) error {
+	return m.Called(ctx, w, locations).Error(0)
+}
+
+// MockUsecase はUsecaseのモック
+type MockUsecase struct {
+	mock.Mock
+}
+
+func (m *MockUsecase) Enqueue(ctx context.Context, jobType string, payload any) error {
+	return m.Called(ctx, jobType, payload).Error(0)
+}
+
+func (m *MockUsecase) ProcessNext(ctx context.Context) (bool, error) {
+	args := m.Called(ctx)
+	return args.Bool(0), args.Error(1)
+}
+
+func newCompletedWalk() *walk.Walk {
+	w := walk.NewWalk("user-123", "Morning Walk", "")
+	w.Status = walk.StatusCompleted
+	return w
+}
+
+func TestCompletionSubscriber(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("完了イベントをジョブとして追加する", func(t *testing.T) {
+		jobs := new(MockUsecase)
+		w := newCompletedWalk()
+		jobs.On("Enqueue", ctx, TypeWalkCompleted, WalkCompletedPayload{WalkID: w.ID}).Return(nil)
+
+		err := NewCompletionSubscriber(jobs, TypeWalkCompleted).HandleEvent(ctx, &walk.WalkCompleted{EventMetadata: walk.EventMetadata{WalkID: w.ID, UserID: w.UserID}})
+		require.NoError(t, err)
+		jobs.AssertExpectations(t)
+	})
+
+	t.Run("指定した種類のジョブを追加する", func(t *testing.T) {
+		jobs := new(MockUsecase)
+		w := newCompletedWalk()
+		jobs.On("Enqueue", ctx, TypeWalkThumbnail, WalkCompletedPayload{WalkID: w.ID}).Return(nil)
+
+		err := NewCompletionSubscriber(jobs, TypeWalkThumbnail).HandleEvent(ctx, &walk.WalkCompleted{EventMetadata: walk.EventMetadata{WalkID: w.ID, UserID: w.UserID}})
+		require.NoError(t, err)
+		jobs.AssertExpectations(t)
+	})
+
+	t.Run("完了以外のイベントは追加しない", func(t *testing.T) {
+		jobs := new(MockUsecase)
+
+		err := NewCompletionSubscriber(jobs, TypeWalkCompleted).HandleEvent(ctx, &walk.WalkStarted{})
+		require.NoError(t, err)
+		jobs.AssertNotCalled(t, "Enqueue", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("追加に失敗した場合はエラー", func(t *testing.T) {
+		jobs := new(MockUsecase)
+		jobs.On("Enqueue", ctx, TypeWalkCompleted, mock.Anything).Return(errors.New("db error"))
+
+		err := NewCompletionSubscriber(jobs, TypeWalkCompleted).HandleEvent(ctx, &walk.WalkCompleted{})
+		assert.Error(t, err)
+	})
+}
+
+func TestCompletionRecorders(t *testing.T) {
+	ctx := context.Background()
+	w := newCompletedWalk()
+
+	t.Run("登録順に呼び出す", func(t *testing.T) {
+		first := new(MockCompletionRecorder)
+		second := new(MockCompletionRecorder)
+		first.On("RecordCompletedWalk", ctx, w, mock.Anything).Return(nil)
+		second.On("RecordCompletedWalk", ctx, w, mock.Anything).Return(nil)
+
+		err := CompletionRecorders{first, second}.RecordCompletedWalk(ctx, w, nil)
+		require.NoError(t, err)
+		first.AssertExpectations(t)
+		second.AssertExpectations(t)
+	})
+
+	t.Run("最初のエラーで中断する", func(t *testing.T) {
+		first := new(MockCompletionRecorder)
+		second := new(MockCompletionRecorder)
+		first.On("RecordCompletedWalk", ctx, w, mock.Anything).Return(errors.New("boom"))
+
+		err := CompletionRecorders{first, second}.RecordCompletedWalk(ctx, w, nil)
+		assert.Error(t, err)
+		second.AssertNotCalled(t, "RecordCompletedWalk", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestWalkCompletedHandler(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func() (*MockWalkRepository, *MockLocationRepository, *MockCompletionRecorder, Handler) {
+		walkRepo := new(MockWalkRepository)
+		locationRepo := new(MockLocationRepository)
+		recorder := new(MockCompletionRecorder)
+		return walkRepo, locationRepo, recorder, NewWalkCompletedHandler(walkRepo, locationRepo, recorder)
+	}
+	payload := func(id uuid.UUID) []byte {
+		return []byte(`{"walk_id":"` + id.String() + `"}`)
+	}
+
+	t.Run("保存済みの散歩と位置情報で記録を更新する", func(t *testing.T) {
+		walkRepo, locationRepo, recorder, h := setup()
+		w := newCompletedWalk()
+		locations := []*walk.WalkLocation{{WalkID: w.ID, Latitude: 35.68, Longitude: 139.76}}
+		walkRepo.On("FindByID", ctx, w.ID).Return(w, nil)
+		locationRepo.On("FindByWalkID", ctx, w.ID).Return(locations, nil)
+		recorder.On("RecordCompletedWalk", ctx, w, locations).Return(nil)
+
+		require.NoError(t, h.Handle(ctx, payload(w.ID)))
+		recorder.AssertExpectations(t)
+	})
+
+	t.Run("削除済みの散歩は何もしない", func(t *testing.T) {
+		walkRepo, _, recorder, h := setup()
+		id := uuid.New()
+		walkRepo.On("FindByID", ctx, id).Return(nil, sql.ErrNoRows)
+
+		require.NoError(t, h.Handle(ctx, payload(id)))
+		recorder.AssertNotCalled(t, "RecordCompletedWalk", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("記録の更新に失敗した場合はエラー（再試行される）", func(t *testing.T) {
+		walkRepo, locationRepo, recorder, h := setup()
+		w := newCompletedWalk()
+		walkRepo.On("FindByID", ctx, w.ID).Return(w, nil)
+		locationRepo.On("FindByWalkID", ctx, w.ID).Return([]*walk.WalkLocation{}, nil)
+		recorder.On("RecordCompletedWalk", ctx, w, mock.Anything).Return(errors.New("db error"))
+
+		assert.Error(t, h.Handle(ctx, payload(w.ID)))
+	})
+
+	t.Run("不正なペイロードはエラー", func(t *testing.T) {
+		_, _, _, h := setup()
+		assert.Error(t, h.Handle(ctx, []byte(`not json`)))
+	})
+}
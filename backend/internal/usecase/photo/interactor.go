@@ -0,0 +1,158 @@
+package photo
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/photo"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/logger"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// sniffLength は形式の判定に読み込む先頭のバイト数（http.DetectContentType が参照する長さ）
+const sniffLength = 512
+
+// interactor は写真Usecaseの実装
+type interactor struct {
+	photoRepo photo.Repository
+	walkRepo  walk.Repository
+	store     ObjectStore
+	logger    logger.Logger
+}
+
+// NewInteractor は新しい写真Interactorを生成する
+func NewInteractor(photoRepo photo.Repository, walkRepo walk.Repository, store ObjectStore, log logger.Logger) Usecase {
+	return &interactor{
+		photoRepo: photoRepo,
+		walkRepo:  walkRepo,
+		store:     store,
+		logger:    log,
+	}
+}
+
+// UploadPhoto は写真をストレージに保存し、散歩に添付する
+// 形式は申告されたContent-Typeではなく内容から判定する
+func (i *interactor) UploadPhoto(ctx context.Context, input UploadPhotoInput) (*photo.Photo, error) {
+	if err := i.checkWalkOwned(ctx, input.WalkID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(input.Content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read photo: %w", err)
+	}
+	head = head[:n]
+	contentType := http.DetectContentType(head)
+
+	if err := validateUpload(input, contentType).Err(); err != nil {
+		return nil, err
+	}
+
+	p := photo.NewPhoto(input.WalkID, input.UserID, contentType, input.CapturedAt, input.Latitude, input.Longitude)
+	url, err := i.store.Upload(ctx, p.StoragePath, io.MultiReader(bytes.NewReader(head), input.Content), contentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload photo: %w", err)
+	}
+	p.URL = url
+
+	if err := i.photoRepo.Create(ctx, p); err != nil {
+		// 行を保存できなかったファイルは参照されないため削除する
+		i.RemoveObjects(ctx, []*photo.Photo{p})
+		return nil, fmt.Errorf("failed to create photo: %w", err)
+	}
+
+	return p, nil
+}
+
+// DeletePhoto は写真を削除し、保存済みのファイルも削除する
+// ファイルの削除に失敗しても写真の削除自体は成功として扱い、ログに残す
+func (i *interactor) DeletePhoto(ctx context.Context, walkID, id uuid.UUID, userID string) error {
+	if err := i.checkWalkOwned(ctx, walkID, userID); err != nil {
+		return err
+	}
+
+	p, err := i.photoRepo.FindByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return photo.ErrPhotoNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get photo: %w", err)
+	}
+	if p.WalkID != walkID {
+		return photo.ErrPhotoNotFound
+	}
+
+	if err := i.photoRepo.Delete(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return photo.ErrPhotoNotFound
+		}
+		return fmt.Errorf("failed to delete photo: %w", err)
+	}
+
+	i.RemoveObjects(ctx, []*photo.Photo{p})
+	return nil
+}
+
+// ListWalkPhotos は散歩の写真を取得する
+func (i *interactor) ListWalkPhotos(ctx context.Context, walkID uuid.UUID) ([]*photo.Photo, error) {
+	photos, err := i.photoRepo.ListByWalkID(ctx, walkID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list photos: %w", err)
+	}
+	return photos, nil
+}
+
+// RemoveObjects は写真の保存済みのファイルを削除する
+func (i *interactor) RemoveObjects(ctx context.Context, photos []*photo.Photo) {
+	for _, p := range photos {
+		if err := i.store.Delete(ctx, p.StoragePath); err != nil {
+			i.logger.Error("Failed to delete photo object",
+				zap.String("photo_id", p.ID.String()), zap.String("path", p.StoragePath), zap.Error(err))
+		}
+	}
+}
+
+// checkWalkOwned は散歩がユーザーのものであることを確認する
+func (i *interactor) checkWalkOwned(ctx context.Context, walkID uuid.UUID, userID string) error {
+	w, err := i.walkRepo.FindByID(ctx, walkID)
+	if err != nil {
+		return fmt.Errorf("failed to get walk: %w", err)
+	}
+	if w.UserID != userID {
+		return walk.ErrNotOwner
+	}
+	return nil
+}
+
+// validateUpload は写真の形式・サイズ・撮影場所を検証する
+// 不正なフィールドはすべて収集して validator.ValidationErrors として返す
+func validateUpload(input UploadPhotoInput, contentType string) validator.ValidationErrors {
+	var errs validator.ValidationErrors
+
+	if !photo.IsSupportedContentType(contentType) {
+		errs.AddField("photo", "photo must be a JPEG or PNG image")
+	}
+	if input.Size > photo.MaxSizeBytes {
+		errs.AddField("photo", fmt.Sprintf("photo must be at most %d bytes", photo.MaxSizeBytes))
+	}
+	if (input.Latitude == nil) != (input.Longitude == nil) {
+		errs.AddField("latitude", "latitude and longitude must be specified together")
+	}
+	if input.Latitude != nil && (*input.Latitude < -90 || *input.Latitude > 90) {
+		errs.AddField("latitude", "latitude must be between -90 and 90")
+	}
+	if input.Longitude != nil && (*input.Longitude < -180 || *input.Longitude > 180) {
+		errs.AddField("longitude", "longitude must be between -180 and 180")
+	}
+
+	return errs
+}
@@ -0,0 +1,202 @@
+package photo
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/photo"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/logger"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockPhotoRepository はphoto.Repositoryのモック
+type MockPhotoRepository struct {
+	mock.Mock
+}
+
+func (m *MockPhotoRepository) Create(ctx context.Context, p *photo.Photo) error {
+	return m.Called(ctx, p).Error(0)
+}
+
+func (m *MockPhotoRepository) FindByID(ctx context.Context, id uuid.UUID) (*photo.Photo, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*photo.Photo), args.Error(1)
+}
+
+func (m *MockPhotoRepository) ListByWalkID(ctx context.Context, walkID uuid.UUID) ([]*photo.Photo, error) {
+	args := m.Called(ctx, walkID)
+	return args.Get(0).([]*photo.Photo), args.Error(1)
+}
+
+func (m *MockPhotoRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+// MockWalkRepository はwalk.Repositoryのモック（使用するメソッドのみ実装）
+type MockWalkRepository struct {
+	mock.Mock
+	walk.Repository
+}
+
+func (m *MockWalkRepository) FindByID(ctx context.Context, id uuid.UUID) (*walk.Walk, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walk.Walk), args.Error(1)
+}
+
+// MockObjectStore はObjectStoreのモック
+// アップロードされた内容を uploaded に保持する
+type MockObjectStore struct {
+	mock.Mock
+	uploaded []byte
+}
+
+func (m *MockObjectStore) Upload(ctx context.Context, path string, content io.Reader, contentType string) (string, error) {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return "", err
+	}
+	m.uploaded = data
+	args := m.Called(ctx, path, contentType)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockObjectStore) Delete(ctx context.Context, path string) error {
+	return m.Called(ctx, path).Error(0)
+}
+
+// pngContent はPNGとして判定される内容
+var pngContent = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 1024)...)
+
+func setupInteractor() (Usecase, *MockPhotoRepository, *MockWalkRepository, *MockObjectStore) {
+	photoRepo := new(MockPhotoRepository)
+	walkRepo := new(MockWalkRepository)
+	store := new(MockObjectStore)
+	return NewInteractor(photoRepo, walkRepo, store, logger.NewNopLogger()), photoRepo, walkRepo, store
+}
+
+func TestUploadPhoto(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		it, photoRepo, walkRepo, store := setupInteractor()
+		w := walk.NewWalk("alice", "Walk", "")
+		walkRepo.On("FindByID", ctx, w.ID).Return(w, nil)
+		store.On("Upload", ctx, mock.AnythingOfType("string"), "image/png").Return("https://example.com/photo.png", nil)
+		photoRepo.On("Create", ctx, mock.AnythingOfType("*photo.Photo")).Return(nil)
+
+		p, err := it.UploadPhoto(ctx, UploadPhotoInput{
+			WalkID: w.ID, UserID: "alice", Content: bytes.NewReader(pngContent), Size: int64(len(pngContent)),
+		})
+
+		// 期待値: 内容から形式を判定し、先頭を読み込んだ後も内容を欠かさずアップロードする
+		require.NoError(t, err)
+		assert.Equal(t, "image/png", p.ContentType)
+		assert.Equal(t, "https://example.com/photo.png", p.URL)
+		assert.Equal(t, pngContent, store.uploaded)
+		store.AssertCalled(t, "Upload", ctx, p.StoragePath, "image/png")
+	})
+
+	t.Run("unsupported content", func(t *testing.T) {
+		it, _, walkRepo, store := setupInteractor()
+		w := walk.NewWalk("alice", "Walk", "")
+		walkRepo.On("FindByID", ctx, w.ID).Return(w, nil)
+		lat := 35.0
+
+		_, err := it.UploadPhoto(ctx, UploadPhotoInput{
+			WalkID: w.ID, UserID: "alice", Content: strings.NewReader("not an image"), Size: 12, Latitude: &lat,
+		})
+
+		// 期待値: 形式と撮影場所の不備がまとめて返され、アップロードしない
+		var errs validator.ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 2)
+		assert.Equal(t, "photo", errs[0].Field)
+		assert.Equal(t, "latitude", errs[1].Field)
+		store.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("other user's walk", func(t *testing.T) {
+		it, _, walkRepo, store := setupInteractor()
+		w := walk.NewWalk("bob", "Walk", "")
+		walkRepo.On("FindByID", ctx, w.ID).Return(w, nil)
+
+		_, err := it.UploadPhoto(ctx, UploadPhotoInput{
+			WalkID: w.ID, UserID: "alice", Content: bytes.NewReader(pngContent), Size: int64(len(pngContent)),
+		})
+
+		assert.ErrorIs(t, err, walk.ErrNotOwner)
+		store.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("removes object when saving fails", func(t *testing.T) {
+		it, photoRepo, walkRepo, store := setupInteractor()
+		w := walk.NewWalk("alice", "Walk", "")
+		walkRepo.On("FindByID", ctx, w.ID).Return(w, nil)
+		store.On("Upload", ctx, mock.AnythingOfType("string"), "image/png").Return("https://example.com/photo.png", nil)
+		store.On("Delete", ctx, mock.AnythingOfType("string")).Return(nil)
+		photoRepo.On("Create", ctx, mock.AnythingOfType("*photo.Photo")).Return(errors.New("db error"))
+
+		_, err := it.UploadPhoto(ctx, UploadPhotoInput{
+			WalkID: w.ID, UserID: "alice", Content: bytes.NewReader(pngContent), Size: int64(len(pngContent)),
+		})
+
+		// 期待値: 参照されないファイルを残さない
+		require.Error(t, err)
+		store.AssertNumberOfCalls(t, "Delete", 1)
+	})
+}
+
+func TestDeletePhoto(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		it, photoRepo, walkRepo, store := setupInteractor()
+		w := walk.NewWalk("alice", "Walk", "")
+		p := photo.NewPhoto(w.ID, "alice", "image/jpeg", nil, nil, nil)
+		walkRepo.On("FindByID", ctx, w.ID).Return(w, nil)
+		photoRepo.On("FindByID", ctx, p.ID).Return(p, nil)
+		photoRepo.On("Delete", ctx, p.ID).Return(nil)
+		store.On("Delete", ctx, p.StoragePath).Return(errors.New("storage error"))
+
+		// 期待値: ファイルの削除に失敗しても写真の削除は成功する
+		require.NoError(t, it.DeletePhoto(ctx, w.ID, p.ID, "alice"))
+		store.AssertExpectations(t)
+	})
+
+	t.Run("photo of another walk", func(t *testing.T) {
+		it, photoRepo, walkRepo, _ := setupInteractor()
+		w := walk.NewWalk("alice", "Walk", "")
+		p := photo.NewPhoto(uuid.New(), "alice", "image/jpeg", nil, nil, nil)
+		walkRepo.On("FindByID", ctx, w.ID).Return(w, nil)
+		photoRepo.On("FindByID", ctx, p.ID).Return(p, nil)
+
+		assert.ErrorIs(t, it.DeletePhoto(ctx, w.ID, p.ID, "alice"), photo.ErrPhotoNotFound)
+		photoRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		it, photoRepo, walkRepo, _ := setupInteractor()
+		w := walk.NewWalk("alice", "Walk", "")
+		id := uuid.New()
+		walkRepo.On("FindByID", ctx, w.ID).Return(w, nil)
+		photoRepo.On("FindByID", ctx, id).Return(nil, sql.ErrNoRows)
+
+		assert.ErrorIs(t, it.DeletePhoto(ctx, w.ID, id, "alice"), photo.ErrPhotoNotFound)
+	})
+}
@@ -0,0 +1,46 @@
+package photo
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/photo"
+	"github.com/google/uuid"
+)
+
+// UploadPhotoInput は写真アップロードの入力
+type UploadPhotoInput struct {
+	WalkID     uuid.UUID
+	UserID     string
+	Content    io.Reader
+	Size       int64 // 写真のサイズ（バイト）
+	CapturedAt *time.Time
+	Latitude   *float64
+	Longitude  *float64
+}
+
+// ObjectStore は写真のファイルを保存するストレージのインターフェース
+// storage.Storage の一部で、テストではモックに差し替える
+type ObjectStore interface {
+	// Upload はファイルをアップロードし、ファイルのURLを返す
+	Upload(ctx context.Context, path string, content io.Reader, contentType string) (string, error)
+	// Delete はファイルを削除する
+	Delete(ctx context.Context, path string) error
+}
+
+// Usecase は散歩の写真のユースケースインターフェース
+// 他のユーザーの散歩への操作は存在しない散歩として扱い、walk.ErrNotOwner を返す
+type Usecase interface {
+	// UploadPhoto は写真をストレージに保存し、散歩に添付する
+	// 対応していない形式（JPEG・PNG以外）やサイズ超過の場合は検証エラーを返す
+	UploadPhoto(ctx context.Context, input UploadPhotoInput) (*photo.Photo, error)
+	// DeletePhoto は写真を削除し、保存済みのファイルも削除する
+	// 散歩に添付されていない写真の場合は photo.ErrPhotoNotFound を返す
+	DeletePhoto(ctx context.Context, walkID, id uuid.UUID, userID string) error
+	// ListWalkPhotos は散歩の写真を取得する（閲覧権限は呼び出し側で確認する）
+	ListWalkPhotos(ctx context.Context, walkID uuid.UUID) ([]*photo.Photo, error)
+	// RemoveObjects は写真の保存済みのファイルを削除する
+	// 散歩の削除で写真の行がカスケード削除された後に呼び出す。削除に失敗したファイルはログに残す
+	RemoveObjects(ctx context.Context, photos []*photo.Photo)
+}
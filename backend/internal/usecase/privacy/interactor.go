@@ -0,0 +1,91 @@
+package privacy
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/privacy"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+	"github.com/google/uuid"
+)
+
+// interactor はプライバシーゾーンUsecaseの実装
+type interactor struct {
+	zoneRepo privacy.Repository
+}
+
+// NewInteractor は新しいプライバシーゾーンInteractorを生成する
+func NewInteractor(zoneRepo privacy.Repository) Usecase {
+	return &interactor{
+		zoneRepo: zoneRepo,
+	}
+}
+
+// ListZones はユーザーのプライバシーゾーンを取得する
+func (i *interactor) ListZones(ctx context.Context, userID string) ([]*privacy.Zone, error) {
+	zones, err := i.zoneRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list privacy zones: %w", err)
+	}
+	return zones, nil
+}
+
+// CreateZone はプライバシーゾーンを作成する
+func (i *interactor) CreateZone(ctx context.Context, input CreateZoneInput) (*privacy.Zone, error) {
+	z := privacy.NewZone(input.UserID, input.Name, input.Latitude, input.Longitude, input.RadiusMeters)
+	if err := validateZone(z).Err(); err != nil {
+		return nil, err
+	}
+
+	zones, err := i.zoneRepo.ListByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list privacy zones: %w", err)
+	}
+	if len(zones) >= privacy.MaxZonesPerUser {
+		return nil, privacy.ErrTooManyZones
+	}
+
+	if err := i.zoneRepo.Create(ctx, z); err != nil {
+		return nil, fmt.Errorf("failed to create privacy zone: %w", err)
+	}
+
+	return z, nil
+}
+
+// DeleteZone はプライバシーゾーンを削除する
+func (i *interactor) DeleteZone(ctx context.Context, id uuid.UUID, userID string) error {
+	if err := i.zoneRepo.Delete(ctx, id, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return privacy.ErrZoneNotFound
+		}
+		return fmt.Errorf("failed to delete privacy zone: %w", err)
+	}
+	return nil
+}
+
+// validateZone はゾーンの名前・中心座標・半径を検証する
+// 不正なフィールドはすべて収集して validator.ValidationErrors として返す
+func validateZone(z *privacy.Zone) validator.ValidationErrors {
+	var errs validator.ValidationErrors
+
+	if z.Name == "" {
+		errs.AddField("name", "name is required")
+	} else if utf8.RuneCountInString(z.Name) > privacy.MaxNameLength {
+		errs.AddField("name", fmt.Sprintf("name must be at most %d characters", privacy.MaxNameLength))
+	}
+	if z.Latitude < -90 || z.Latitude > 90 {
+		errs.AddField("latitude", "latitude must be between -90 and 90")
+	}
+	if z.Longitude < -180 || z.Longitude > 180 {
+		errs.AddField("longitude", "longitude must be between -180 and 180")
+	}
+	if z.RadiusMeters < privacy.MinRadiusMeters || z.RadiusMeters > privacy.MaxRadiusMeters {
+		errs.AddField("radius_meters", fmt.Sprintf("radius_meters must be between %.0f and %.0f",
+			privacy.MinRadiusMeters, privacy.MaxRadiusMeters))
+	}
+
+	return errs
+}
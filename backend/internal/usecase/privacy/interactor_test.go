@@ -0,0 +1,110 @@
+package privacy
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/privacy"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockZoneRepository はprivacy.Repositoryのモック
+type MockZoneRepository struct {
+	mock.Mock
+}
+
+func (m *MockZoneRepository) ListByUserID(ctx context.Context, userID string) ([]*privacy.Zone, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*privacy.Zone), args.Error(1)
+}
+
+func (m *MockZoneRepository) Create(ctx context.Context, z *privacy.Zone) error {
+	return m.Called(ctx, z).Error(0)
+}
+
+func (m *MockZoneRepository) Delete(ctx context.Context, id uuid.UUID, userID string) error {
+	return m.Called(ctx, id, userID).Error(0)
+}
+
+func setupInteractor() (Usecase, *MockZoneRepository) {
+	zoneRepo := new(MockZoneRepository)
+	return NewInteractor(zoneRepo), zoneRepo
+}
+
+func TestCreateZone(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		it, repo := setupInteractor()
+		repo.On("ListByUserID", ctx, "alice").Return([]*privacy.Zone{}, nil)
+		repo.On("Create", ctx, mock.AnythingOfType("*privacy.Zone")).Return(nil)
+
+		z, err := it.CreateZone(ctx, CreateZoneInput{
+			UserID: "alice", Name: " 自宅 ", Latitude: 35.68, Longitude: 139.76, RadiusMeters: 200,
+		})
+
+		// 期待値: 名前の空白が除去されて保存される
+		require.NoError(t, err)
+		assert.Equal(t, "自宅", z.Name)
+		assert.Equal(t, "alice", z.UserID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("invalid fields", func(t *testing.T) {
+		it, repo := setupInteractor()
+
+		_, err := it.CreateZone(ctx, CreateZoneInput{
+			UserID: "alice", Name: "", Latitude: 91, Longitude: 139.76, RadiusMeters: 50,
+		})
+
+		// 期待値: 不正なフィールドがすべて返され、保存されない
+		var errs validator.ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		fields := make([]string, len(errs))
+		for i, e := range errs {
+			fields[i] = e.Field
+		}
+		assert.Equal(t, []string{"name", "latitude", "radius_meters"}, fields)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("too many zones", func(t *testing.T) {
+		it, repo := setupInteractor()
+		zones := make([]*privacy.Zone, privacy.MaxZonesPerUser)
+		repo.On("ListByUserID", ctx, "alice").Return(zones, nil)
+
+		_, err := it.CreateZone(ctx, CreateZoneInput{
+			UserID: "alice", Name: "職場", Latitude: 35.66, Longitude: 139.70, RadiusMeters: 300,
+		})
+
+		// 期待値: 上限に達している場合は作成しない
+		assert.ErrorIs(t, err, privacy.ErrTooManyZones)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeleteZone(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		it, repo := setupInteractor()
+		repo.On("Delete", ctx, id, "alice").Return(nil)
+
+		require.NoError(t, it.DeleteZone(ctx, id, "alice"))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		it, repo := setupInteractor()
+		repo.On("Delete", ctx, id, "alice").Return(sql.ErrNoRows)
+
+		// 期待値: 他のユーザーのゾーンも存在しないゾーンとして扱う
+		assert.ErrorIs(t, it.DeleteZone(ctx, id, "alice"), privacy.ErrZoneNotFound)
+	})
+}
@@ -0,0 +1,30 @@
+package privacy
+
+import (
+	"context"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/privacy"
+	"github.com/google/uuid"
+)
+
+// CreateZoneInput はプライバシーゾーン作成の入力
+type CreateZoneInput struct {
+	UserID       string
+	Name         string
+	Latitude     float64
+	Longitude    float64
+	RadiusMeters float64
+}
+
+// Usecase はプライバシーゾーンのユースケースインターフェース
+// ゾーンは所有者以外が散歩を閲覧する際に位置情報を取り除く範囲として使われる
+type Usecase interface {
+	// ListZones はユーザーのプライバシーゾーンを取得する
+	ListZones(ctx context.Context, userID string) ([]*privacy.Zone, error)
+	// CreateZone はプライバシーゾーンを作成する
+	// 上限に達している場合は privacy.ErrTooManyZones を返す
+	CreateZone(ctx context.Context, input CreateZoneInput) (*privacy.Zone, error)
+	// DeleteZone はプライバシーゾーンを削除する
+	// 存在しない場合や他のユーザーのゾーンの場合は privacy.ErrZoneNotFound を返す
+	DeleteZone(ctx context.Context, id uuid.UUID, userID string) error
+}
@@ -0,0 +1,97 @@
+package record
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/record"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+)
+
+// interactor は連続記録・自己ベストUsecaseの実装
+type interactor struct {
+	recordRepo record.Repository
+	loc        *time.Location // 暦日の判定に使用するタイムゾーン
+	now        func() time.Time
+}
+
+// NewInteractor は新しい連続記録・自己ベストInteractorを生成する
+func NewInteractor(recordRepo record.Repository, loc *time.Location) Usecase {
+	return &interactor{
+		recordRepo: recordRepo,
+		loc:        loc,
+		now:        time.Now,
+	}
+}
+
+// GetRecords はユーザーの連続記録・自己ベストを取得する
+// 完了した散歩がまだない場合は記録なしの値を返す
+func (i *interactor) GetRecords(ctx context.Context, userID string) (*RecordsResult, error) {
+	pr, err := i.findOrNew(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecordsResult{
+		Records:       pr,
+		CurrentStreak: pr.CurrentStreakOn(record.DayOf(i.now(), i.loc)),
+		TimeZone:      i.loc,
+	}, nil
+}
+
+// RecordCompletedWalk は完了した散歩を連続記録・自己ベストに反映する
+// 通常は保存済みの記録との差分で更新し、過去の日付の散歩の場合のみ連続記録を全件から再計算する
+// 同時に完了した散歩の更新が失われないよう、記録は行ロックした状態で読み書きする
+func (i *interactor) RecordCompletedWalk(ctx context.Context, w *walk.Walk, locations []*walk.WalkLocation) error {
+	if !w.IsCompleted() || w.StartTime == nil {
+		return nil
+	}
+
+	day := record.DayOf(*w.StartTime, i.loc)
+
+	var kmPace *float64
+	if pace, ok := walk.FastestSplitPace(locations, record.FastestSplitDistance); ok {
+		kmPace = &pace
+	}
+
+	err := i.recordRepo.UpdateByUserID(ctx, w.UserID, func(pr *record.PersonalRecords) error {
+		if !pr.ApplyWalkDay(day) {
+			days, err := i.recordRepo.FindWalkDays(ctx, w.UserID, i.loc)
+			if err != nil {
+				return fmt.Errorf("failed to get walk days: %w", err)
+			}
+			pr.RebuildStreaks(days)
+		}
+
+		pr.ApplyWalk(w.ID, w.TotalDistance, kmPace)
+
+		// 同じ日の他の散歩と合わせた歩数で比較する
+		steps, err := i.recordRepo.SumStepsOnDay(ctx, w.UserID, day, i.loc)
+		if err != nil {
+			return fmt.Errorf("failed to sum daily steps: %w", err)
+		}
+		pr.ApplyDailySteps(day, steps)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update personal records: %w", err)
+	}
+
+	return nil
+}
+
+// findOrNew はユーザーの記録を取得し、存在しない場合は記録なしの値を返す
+func (i *interactor) findOrNew(ctx context.Context, userID string) (*record.PersonalRecords, error) {
+	pr, err := i.recordRepo.FindByUserID(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return record.NewPersonalRecords(userID), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get personal records: %w", err)
+	}
+	return pr, nil
+}
@@ -0,0 +1,180 @@
+package record
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/record"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRecordRepository はrecord.Repositoryのモック
+type MockRecordRepository struct {
+	mock.Mock
+}
+
+func (m *MockRecordRepository) FindByUserID(ctx context.Context, userID string) (*record.PersonalRecords, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*record.PersonalRecords), args.Error(1)
+}
+
+// UpdateByUserID はモックが返す記録に update を適用する
+func (m *MockRecordRepository) UpdateByUserID(ctx context.Context, userID string, update func(*record.PersonalRecords) error) error {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return args.Error(1)
+	}
+	if err := update(args.Get(0).(*record.PersonalRecords)); err != nil {
+		return err
+	}
+	return args.Error(1)
+}
+
+func (m *MockRecordRepository) FindWalkDays(ctx context.Context, userID string, loc *time.Location) ([]time.Time, error) {
+	args := m.Called(ctx, userID, loc)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]time.Time), args.Error(1)
+}
+
+func (m *MockRecordRepository) SumStepsOnDay(ctx context.Context, userID string, day time.Time, loc *time.Location) (int, error) {
+	args := m.Called(ctx, userID, day, loc)
+	return args.Int(0), args.Error(1)
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// newCompletedWalk は指定時刻に開始した完了済みのテスト用Walkを生成する
+func newCompletedWalk(start time.Time, distance float64) *walk.Walk {
+	w := walk.NewWalk("user-1", "Walk", "")
+	w.StartTime = &start
+	end := start.Add(time.Hour)
+	w.EndTime = &end
+	w.Status = walk.StatusCompleted
+	w.TotalDistance = distance
+	return w
+}
+
+// newStraightRoute は赤道上を経度0.001度（約111.2m）ずつ interval 間隔で東へ進む位置情報を生成する
+func newStraightRoute(start time.Time, points int, interval time.Duration) []*walk.WalkLocation {
+	locations := make([]*walk.WalkLocation, points)
+	for i := range locations {
+		locations[i] = walk.NewWalkLocationWithOptionals(
+			uuid.Nil, 0, float64(i)*0.001, nil, start.Add(time.Duration(i)*interval), nil, nil, nil, nil, i,
+		)
+	}
+	return locations
+}
+
+func TestRecordCompletedWalk_Incremental(t *testing.T) {
+	repo := new(MockRecordRepository)
+	it := NewInteractor(repo, time.UTC)
+	ctx := context.Background()
+
+	existing := record.NewPersonalRecords("user-1")
+	existing.ApplyWalkDay(date(2025, 1, 14))
+	existing.ApplyDailySteps(date(2025, 1, 14), 7000)
+
+	w := newCompletedWalk(time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC), 2000)
+	locations := newStraightRoute(*w.StartTime, 19, 30*time.Second)
+
+	repo.On("UpdateByUserID", ctx, "user-1").Return(existing, nil)
+	repo.On("SumStepsOnDay", ctx, "user-1", date(2025, 1, 15), time.UTC).Return(8000, nil)
+
+	err := it.RecordCompletedWalk(ctx, w, locations)
+	require.NoError(t, err)
+
+	saved := existing
+	// 期待値: 前日からの連続で2日になる
+	assert.Equal(t, 2, saved.CurrentStreak)
+	assert.Equal(t, 2, saved.LongestStreak)
+	// 期待値: 最長距離と1km最速ペース（約270秒/km）が記録される
+	assert.Equal(t, w.ID, *saved.LongestWalkID)
+	require.NotNil(t, saved.FastestKmPace)
+	assert.InDelta(t, 270, *saved.FastestKmPace, 1)
+	// 期待値: 1日の最多歩数が更新される
+	assert.Equal(t, 8000, saved.MostStepsInDay)
+	// 期待値: 差分で更新できるため全日付の再取得は行わない
+	repo.AssertNotCalled(t, "FindWalkDays", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRecordCompletedWalk_PastDayRebuildsStreaks(t *testing.T) {
+	repo := new(MockRecordRepository)
+	it := NewInteractor(repo, time.UTC)
+	ctx := context.Background()
+
+	existing := record.NewPersonalRecords("user-1")
+	existing.ApplyWalkDay(date(2025, 1, 13))
+	existing.ApplyWalkDay(date(2025, 1, 15))
+
+	// 1月14日の散歩を後から取り込んだ場合
+	w := newCompletedWalk(time.Date(2025, 1, 14, 9, 0, 0, 0, time.UTC), 500)
+
+	repo.On("UpdateByUserID", ctx, "user-1").Return(existing, nil)
+	repo.On("FindWalkDays", ctx, "user-1", time.UTC).
+		Return([]time.Time{date(2025, 1, 13), date(2025, 1, 14), date(2025, 1, 15)}, nil)
+	repo.On("SumStepsOnDay", ctx, "user-1", date(2025, 1, 14), time.UTC).Return(0, nil)
+
+	err := it.RecordCompletedWalk(ctx, w, nil)
+	require.NoError(t, err)
+
+	saved := existing
+	// 期待値: 全日付から再計算して3日連続になる
+	assert.Equal(t, 3, saved.CurrentStreak)
+	assert.Equal(t, 3, saved.LongestStreak)
+	// 期待値: 1km未満のため最速ペースは記録されない
+	assert.Nil(t, saved.FastestKmPace)
+}
+
+func TestRecordCompletedWalk_NotCompleted(t *testing.T) {
+	repo := new(MockRecordRepository)
+	it := NewInteractor(repo, time.UTC)
+
+	w := walk.NewWalk("user-1", "Walk", "")
+
+	// 期待値: 完了していない散歩は何もしない
+	err := it.RecordCompletedWalk(context.Background(), w, nil)
+	require.NoError(t, err)
+	repo.AssertNotCalled(t, "UpdateByUserID", mock.Anything, mock.Anything)
+}
+
+func TestGetRecords(t *testing.T) {
+	repo := new(MockRecordRepository)
+	ctx := context.Background()
+
+	pr := record.NewPersonalRecords("user-1")
+	pr.ApplyWalkDay(date(2025, 1, 14))
+	pr.ApplyWalkDay(date(2025, 1, 15))
+
+	repo.On("FindByUserID", ctx, "user-1").Return(pr, nil)
+	repo.On("FindByUserID", ctx, "user-2").Return(nil, sql.ErrNoRows)
+
+	it := &interactor{
+		recordRepo: repo,
+		loc:        time.UTC,
+		now:        func() time.Time { return time.Date(2025, 1, 16, 12, 0, 0, 0, time.UTC) },
+	}
+
+	// 期待値: 昨日まで散歩していれば連続は継続中
+	result, err := it.GetRecords(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.CurrentStreak)
+
+	// 期待値: 記録がないユーザーは記録なしの値を返す
+	result, err = it.GetRecords(ctx, "user-2")
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.CurrentStreak)
+	assert.Equal(t, "user-2", result.Records.UserID)
+}
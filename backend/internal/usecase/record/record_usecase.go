@@ -0,0 +1,25 @@
+package record
+
+import (
+	"context"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/record"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+)
+
+// RecordsResult は連続記録・自己ベストの取得結果
+type RecordsResult struct {
+	Records       *record.PersonalRecords
+	CurrentStreak int            // 今日時点で継続中の連続日数（途切れている場合は0）
+	TimeZone      *time.Location // 暦日の判定に使用したタイムゾーン
+}
+
+// Usecase は連続記録・自己ベストのユースケースインターフェース
+type Usecase interface {
+	// GetRecords はユーザーの連続記録・自己ベストを取得する
+	GetRecords(ctx context.Context, userID string) (*RecordsResult, error)
+	// RecordCompletedWalk は完了した散歩を連続記録・自己ベストに反映する
+	// locations は1km最速ペースの算出に使用する散歩の全位置情報
+	RecordCompletedWalk(ctx context.Context, w *walk.Walk, locations []*walk.WalkLocation) error
+}
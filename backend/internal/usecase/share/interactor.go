@@ -0,0 +1,158 @@
+package share
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/privacy"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/share"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/sharetoken"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+	walkusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/walk"
+	"github.com/google/uuid"
+)
+
+// interactor は共有リンクUsecaseの実装
+type interactor struct {
+	shareRepo    share.Repository
+	walkRepo     walk.Repository
+	locationRepo walk.LocationRepository
+	zones        walkusecase.ZoneLister
+	signer       *sharetoken.Signer
+}
+
+// NewInteractor は新しい共有リンクInteractorを生成する
+func NewInteractor(shareRepo share.Repository, walkRepo walk.Repository, locationRepo walk.LocationRepository, zones walkusecase.ZoneLister, signer *sharetoken.Signer) Usecase {
+	return &interactor{
+		shareRepo:    shareRepo,
+		walkRepo:     walkRepo,
+		locationRepo: locationRepo,
+		zones:        zones,
+		signer:       signer,
+	}
+}
+
+// CreateLink は散歩の共有リンクを作成する
+func (i *interactor) CreateLink(ctx context.Context, input CreateLinkInput) (*SharedLink, error) {
+	if err := i.checkWalkOwned(ctx, input.WalkID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	link, err := share.NewLink(input.WalkID, input.UserID, input.ExpiresAt)
+	if err != nil {
+		var errs validator.ValidationErrors
+		errs.AddField("expires_at", err.Error())
+		return nil, errs.Err()
+	}
+
+	if err := i.shareRepo.Create(ctx, link); err != nil {
+		return nil, fmt.Errorf("failed to create share link: %w", err)
+	}
+
+	return i.withToken(link), nil
+}
+
+// ListLinks は散歩の共有リンクを取得する
+func (i *interactor) ListLinks(ctx context.Context, walkID uuid.UUID, userID string) ([]*SharedLink, error) {
+	if err := i.checkWalkOwned(ctx, walkID, userID); err != nil {
+		return nil, err
+	}
+
+	links, err := i.shareRepo.ListByWalkID(ctx, walkID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list share links: %w", err)
+	}
+
+	shared := make([]*SharedLink, len(links))
+	for idx, link := range links {
+		shared[idx] = i.withToken(link)
+	}
+	return shared, nil
+}
+
+// RevokeLink は散歩の共有リンクを削除して無効にする
+// 散歩に存在しないリンクの場合は share.ErrLinkNotFound を返す
+func (i *interactor) RevokeLink(ctx context.Context, walkID, linkID uuid.UUID, userID string) error {
+	if err := i.checkWalkOwned(ctx, walkID, userID); err != nil {
+		return err
+	}
+
+	if err := i.shareRepo.Delete(ctx, walkID, linkID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return share.ErrLinkNotFound
+		}
+		return fmt.Errorf("failed to revoke share link: %w", err)
+	}
+
+	return nil
+}
+
+// GetSharedWalk はトークンで共有された散歩と位置情報を取得する
+// 署名が不正なトークン・削除済みや期限切れのリンクはいずれも share.ErrLinkNotFound を返す
+// 所有者のプライバシーゾーン内の位置情報と経路は取り除いて返す
+func (i *interactor) GetSharedWalk(ctx context.Context, token string) (*walkusecase.WalkWithLocations, error) {
+	linkID, err := i.signer.Parse(token)
+	if err != nil {
+		return nil, share.ErrLinkNotFound
+	}
+
+	link, err := i.shareRepo.FindByID(ctx, linkID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, share.ErrLinkNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get share link: %w", err)
+	}
+	if link.IsExpired(time.Now()) {
+		return nil, share.ErrLinkNotFound
+	}
+
+	// 散歩の削除時にリンクもカスケード削除されるため、散歩が存在しない場合も同じ扱いにする
+	w, err := i.walkRepo.FindByID(ctx, link.WalkID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, share.ErrLinkNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get walk: %w", err)
+	}
+
+	locations, err := i.locationRepo.FindByWalkID(ctx, w.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get walk locations: %w", err)
+	}
+
+	zones, err := i.zones.ListByUserID(ctx, w.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get privacy zones: %w", err)
+	}
+	masker := privacy.NewMasker(zones)
+
+	return &walkusecase.WalkWithLocations{
+		Walk:      masker.MaskWalk(w),
+		Locations: masker.MaskLocations(locations),
+	}, nil
+}
+
+// checkWalkOwned は散歩がユーザーのものであることを確認する
+func (i *interactor) checkWalkOwned(ctx context.Context, walkID uuid.UUID, userID string) error {
+	w, err := i.walkRepo.FindByID(ctx, walkID)
+	if err != nil {
+		return fmt.Errorf("failed to get walk: %w", err)
+	}
+	if w.UserID != userID {
+		return walk.ErrNotOwner
+	}
+	return nil
+}
+
+// withToken は共有リンクに閲覧用のトークンを付ける
+func (i *interactor) withToken(link *share.Link) *SharedLink {
+	return &SharedLink{
+		Link:  link,
+		Token: i.signer.Sign(link.ID),
+	}
+}
@@ -0,0 +1,234 @@
+package share
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/privacy"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/share"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/sharetoken"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockShareRepository はshare.Repositoryのモック
+type MockShareRepository struct {
+	mock.Mock
+}
+
+func (m *MockShareRepository) Create(ctx context.Context, link *share.Link) error {
+	return m.Called(ctx, link).Error(0)
+}
+
+func (m *MockShareRepository) FindByID(ctx context.Context, id uuid.UUID) (*share.Link, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*share.Link), args.Error(1)
+}
+
+func (m *MockShareRepository) ListByWalkID(ctx context.Context, walkID uuid.UUID) ([]*share.Link, error) {
+	args := m.Called(ctx, walkID)
+	return args.Get(0).([]*share.Link), args.Error(1)
+}
+
+func (m *MockShareRepository) Delete(ctx context.Context, walkID, id uuid.UUID) error {
+	return m.Called(ctx, walkID, id).Error(0)
+}
+
+// MockWalkRepository はwalk.Repositoryのモック（共有リンクで使うFindByIDのみ設定する）
+type MockWalkRepository struct {
+	mock.Mock
+	walk.Repository
+}
+
+func (m *MockWalkRepository) FindByID(ctx context.Context, id uuid.UUID) (*walk.Walk, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walk.Walk), args.Error(1)
+}
+
+// MockLocationRepository はwalk.LocationRepositoryのモック（共有リンクで使うFindByWalkIDのみ設定する）
+type MockLocationRepository struct {
+	mock.Mock
+	walk.LocationRepository
+}
+
+func (m *MockLocationRepository) FindByWalkID(ctx context.Context, walkID uuid.UUID) ([]*walk.WalkLocation, error) {
+	args := m.Called(ctx, walkID)
+	return args.Get(0).([]*walk.WalkLocation), args.Error(1)
+}
+
+var testSigner = sharetoken.NewSigner([]byte("test-key"))
+
+// stubZoneLister は固定のプライバシーゾーンを返すZoneListerのスタブ
+type stubZoneLister struct {
+	zones []*privacy.Zone
+}
+
+func (s stubZoneLister) ListByUserID(ctx context.Context, userID string) ([]*privacy.Zone, error) {
+	return s.zones, nil
+}
+
+func setupInteractor() (Usecase, *MockShareRepository, *MockWalkRepository, *MockLocationRepository) {
+	return setupInteractorWithZones(nil)
+}
+
+// setupInteractorWithZones は散歩の所有者のプライバシーゾーンを指定してテスト用のInteractorを生成する
+func setupInteractorWithZones(zones []*privacy.Zone) (Usecase, *MockShareRepository, *MockWalkRepository, *MockLocationRepository) {
+	shareRepo := new(MockShareRepository)
+	walkRepo := new(MockWalkRepository)
+	locationRepo := new(MockLocationRepository)
+	it := NewInteractor(shareRepo, walkRepo, locationRepo, stubZoneLister{zones: zones}, testSigner)
+	return it, shareRepo, walkRepo, locationRepo
+}
+
+func TestCreateLink(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		it, shareRepo, walkRepo, _ := setupInteractor()
+		w := walk.NewWalk("alice", "Walk", "")
+		walkRepo.On("FindByID", ctx, w.ID).Return(w, nil)
+		shareRepo.On("Create", ctx, mock.AnythingOfType("*share.Link")).Return(nil)
+
+		expiresAt := time.Now().Add(time.Hour)
+		created, err := it.CreateLink(ctx, CreateLinkInput{WalkID: w.ID, UserID: "alice", ExpiresAt: &expiresAt})
+
+		// 期待値: リンクのIDを署名したトークンが付く
+		require.NoError(t, err)
+		assert.Equal(t, w.ID, created.Link.WalkID)
+		id, err := testSigner.Parse(created.Token)
+		require.NoError(t, err)
+		assert.Equal(t, created.Link.ID, id)
+	})
+
+	t.Run("other user's walk", func(t *testing.T) {
+		it, shareRepo, walkRepo, _ := setupInteractor()
+		w := walk.NewWalk("bob", "Walk", "")
+		walkRepo.On("FindByID", ctx, w.ID).Return(w, nil)
+
+		// 期待値: 他のユーザーの散歩は共有できない
+		_, err := it.CreateLink(ctx, CreateLinkInput{WalkID: w.ID, UserID: "alice"})
+		assert.ErrorIs(t, err, walk.ErrNotOwner)
+		shareRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("past expiry", func(t *testing.T) {
+		it, shareRepo, walkRepo, _ := setupInteractor()
+		w := walk.NewWalk("alice", "Walk", "")
+		walkRepo.On("FindByID", ctx, w.ID).Return(w, nil)
+
+		expiresAt := time.Now().Add(-time.Hour)
+		_, err := it.CreateLink(ctx, CreateLinkInput{WalkID: w.ID, UserID: "alice", ExpiresAt: &expiresAt})
+
+		// 期待値: expires_atフィールドのバリデーションエラー
+		var errs validator.ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		assert.Equal(t, "expires_at", errs[0].Field)
+		shareRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRevokeLink(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		it, shareRepo, walkRepo, _ := setupInteractor()
+		w := walk.NewWalk("alice", "Walk", "")
+		linkID := uuid.New()
+		walkRepo.On("FindByID", ctx, w.ID).Return(w, nil)
+		shareRepo.On("Delete", ctx, w.ID, linkID).Return(nil)
+
+		require.NoError(t, it.RevokeLink(ctx, w.ID, linkID, "alice"))
+		shareRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown link", func(t *testing.T) {
+		it, shareRepo, walkRepo, _ := setupInteractor()
+		w := walk.NewWalk("alice", "Walk", "")
+		linkID := uuid.New()
+		walkRepo.On("FindByID", ctx, w.ID).Return(w, nil)
+		shareRepo.On("Delete", ctx, w.ID, linkID).Return(sql.ErrNoRows)
+
+		assert.ErrorIs(t, it.RevokeLink(ctx, w.ID, linkID, "alice"), share.ErrLinkNotFound)
+	})
+}
+
+func TestGetSharedWalk(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		it, shareRepo, walkRepo, locationRepo := setupInteractor()
+		w := walk.NewWalk("alice", "Walk", "")
+		link, err := share.NewLink(w.ID, "alice", nil)
+		require.NoError(t, err)
+		locations := []*walk.WalkLocation{walk.NewWalkLocationWithOptionals(w.ID, 35.0, 139.0, nil, time.Now(), nil, nil, nil, nil, 0)}
+		shareRepo.On("FindByID", ctx, link.ID).Return(link, nil)
+		walkRepo.On("FindByID", ctx, w.ID).Return(w, nil)
+		locationRepo.On("FindByWalkID", ctx, w.ID).Return(locations, nil)
+
+		got, err := it.GetSharedWalk(ctx, testSigner.Sign(link.ID))
+
+		require.NoError(t, err)
+		assert.Equal(t, w, got.Walk)
+		assert.Equal(t, locations, got.Locations)
+	})
+
+	t.Run("masks privacy zones", func(t *testing.T) {
+		home := &privacy.Zone{UserID: "alice", Latitude: 35.0, Longitude: 139.0, RadiusMeters: 200}
+		it, shareRepo, walkRepo, locationRepo := setupInteractorWithZones([]*privacy.Zone{home})
+		w := walk.NewWalk("alice", "Walk", "")
+		link, err := share.NewLink(w.ID, "alice", nil)
+		require.NoError(t, err)
+		inside := walk.NewWalkLocationWithOptionals(w.ID, 35.0, 139.0, nil, time.Now(), nil, nil, nil, nil, 0)
+		outside := walk.NewWalkLocationWithOptionals(w.ID, 35.01, 139.0, nil, time.Now(), nil, nil, nil, nil, 1)
+		shareRepo.On("FindByID", ctx, link.ID).Return(link, nil)
+		walkRepo.On("FindByID", ctx, w.ID).Return(w, nil)
+		locationRepo.On("FindByWalkID", ctx, w.ID).Return([]*walk.WalkLocation{inside, outside}, nil)
+
+		got, err := it.GetSharedWalk(ctx, testSigner.Sign(link.ID))
+
+		// 期待値: 所有者のゾーン内の位置情報は含めない
+		require.NoError(t, err)
+		assert.Equal(t, []*walk.WalkLocation{outside}, got.Locations)
+	})
+
+	t.Run("forged token", func(t *testing.T) {
+		it, shareRepo, _, _ := setupInteractor()
+
+		// 期待値: 署名が不正なトークンはDBに問い合わせずに拒否する
+		_, err := it.GetSharedWalk(ctx, sharetoken.NewSigner([]byte("other-key")).Sign(uuid.New()))
+		assert.ErrorIs(t, err, share.ErrLinkNotFound)
+		shareRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("revoked link", func(t *testing.T) {
+		it, shareRepo, _, _ := setupInteractor()
+		linkID := uuid.New()
+		shareRepo.On("FindByID", ctx, linkID).Return(nil, sql.ErrNoRows)
+
+		_, err := it.GetSharedWalk(ctx, testSigner.Sign(linkID))
+		assert.ErrorIs(t, err, share.ErrLinkNotFound)
+	})
+
+	t.Run("expired link", func(t *testing.T) {
+		it, shareRepo, walkRepo, _ := setupInteractor()
+		expiresAt := time.Now().Add(-time.Minute)
+		link := &share.Link{ID: uuid.New(), WalkID: uuid.New(), UserID: "alice", ExpiresAt: &expiresAt}
+		shareRepo.On("FindByID", ctx, link.ID).Return(link, nil)
+
+		_, err := it.GetSharedWalk(ctx, testSigner.Sign(link.ID))
+		assert.ErrorIs(t, err, share.ErrLinkNotFound)
+		walkRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+}
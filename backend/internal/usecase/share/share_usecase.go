@@ -0,0 +1,36 @@
+package share
+
+import (
+	"context"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/share"
+	walkusecase "github.com/RRRRRRR-777/TekuToko/backend/internal/usecase/walk"
+	"github.com/google/uuid"
+)
+
+// CreateLinkInput は共有リンク作成の入力
+type CreateLinkInput struct {
+	WalkID    uuid.UUID
+	UserID    string
+	ExpiresAt *time.Time // nilの場合は無期限
+}
+
+// SharedLink は共有リンクと、閲覧に使うトークンをまとめた構造体
+type SharedLink struct {
+	Link  *share.Link
+	Token string
+}
+
+// Usecase は散歩の共有リンクのユースケースインターフェース
+// 他のユーザーの散歩は存在しない散歩として扱い、walk.ErrNotOwner を返す
+type Usecase interface {
+	// CreateLink は散歩の共有リンクを作成する
+	CreateLink(ctx context.Context, input CreateLinkInput) (*SharedLink, error)
+	// ListLinks は散歩の共有リンクを取得する（期限切れのリンクを含む）
+	ListLinks(ctx context.Context, walkID uuid.UUID, userID string) ([]*SharedLink, error)
+	// RevokeLink は散歩の共有リンクを無効にする
+	RevokeLink(ctx context.Context, walkID, linkID uuid.UUID, userID string) error
+	// GetSharedWalk はトークンで共有された散歩と位置情報を取得する（認証不要）
+	GetSharedWalk(ctx context.Context, token string) (*walkusecase.WalkWithLocations, error)
+}
@@ -0,0 +1,222 @@
+package social
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/social"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/user"
+)
+
+// interactor はフォロー・友達・ブロックUsecaseの実装
+type interactor struct {
+	socialRepo social.Repository
+	userRepo   user.Repository
+}
+
+// NewInteractor は新しいフォロー・友達・ブロックInteractorを生成する
+func NewInteractor(socialRepo social.Repository, userRepo user.Repository) Usecase {
+	return &interactor{
+		socialRepo: socialRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// ListFriends は相互フォロー（友達）のユーザーを取得する
+func (i *interactor) ListFriends(ctx context.Context, userID string) ([]*social.Connection, error) {
+	friends, err := i.socialRepo.ListFriends(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list friends: %w", err)
+	}
+	return friends, nil
+}
+
+// ListMutualFriends は相手との共通の友達を取得する
+func (i *interactor) ListMutualFriends(ctx context.Context, userID, otherID string) ([]*social.Connection, error) {
+	if err := i.checkVisible(ctx, userID, otherID); err != nil {
+		return nil, err
+	}
+
+	friends, err := i.socialRepo.ListMutualFriends(ctx, userID, otherID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list mutual friends: %w", err)
+	}
+	return friends, nil
+}
+
+// ListFollowRequests は承認待ちのフォローリクエストを取得する
+func (i *interactor) ListFollowRequests(ctx context.Context, userID string, direction RequestDirection) ([]*social.Connection, error) {
+	var (
+		requests []*social.Connection
+		err      error
+	)
+	if direction == RequestOutgoing {
+		requests, err = i.socialRepo.ListOutgoingRequests(ctx, userID)
+	} else {
+		requests, err = i.socialRepo.ListIncomingRequests(ctx, userID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list follow requests: %w", err)
+	}
+	return requests, nil
+}
+
+// SendFollowRequest は相手にフォローリクエストを送信する
+// 承認待ち・承認済みを問わず、既にフォロー関係がある場合は social.ErrAlreadyFollowing を返す
+func (i *interactor) SendFollowRequest(ctx context.Context, userID, targetID string) (*social.Follow, error) {
+	if err := i.checkVisible(ctx, userID, targetID); err != nil {
+		return nil, err
+	}
+
+	_, err := i.socialRepo.FindFollow(ctx, userID, targetID)
+	if err == nil {
+		return nil, social.ErrAlreadyFollowing
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get follow: %w", err)
+	}
+
+	f, err := social.NewFollowRequest(userID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.socialRepo.SaveFollow(ctx, f); err != nil {
+		return nil, fmt.Errorf("failed to save follow request: %w", err)
+	}
+
+	return f, nil
+}
+
+// AcceptFollowRequest は相手からのフォローリクエストを承認する
+func (i *interactor) AcceptFollowRequest(ctx context.Context, userID, requesterID string) (*social.Follow, error) {
+	f, err := i.findPendingRequest(ctx, requesterID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := f.Accept(); err != nil {
+		return nil, err
+	}
+	if err := i.socialRepo.SaveFollow(ctx, f); err != nil {
+		return nil, fmt.Errorf("failed to accept follow request: %w", err)
+	}
+
+	return f, nil
+}
+
+// RejectFollowRequest は相手からのフォローリクエストを拒否する
+// 拒否したことは相手に通知せず、リクエストを削除するだけとする
+func (i *interactor) RejectFollowRequest(ctx context.Context, userID, requesterID string) error {
+	if _, err := i.findPendingRequest(ctx, requesterID, userID); err != nil {
+		return err
+	}
+
+	if err := i.socialRepo.DeleteFollow(ctx, requesterID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return social.ErrRequestNotFound
+		}
+		return fmt.Errorf("failed to reject follow request: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveFriend は相手との間のフォロー関係（承認待ちを含む）を両方向とも解除する
+func (i *interactor) RemoveFriend(ctx context.Context, userID, otherID string) error {
+	removed, err := i.socialRepo.DeleteFollows(ctx, userID, otherID)
+	if err != nil {
+		return fmt.Errorf("failed to remove friend: %w", err)
+	}
+	if removed == 0 {
+		return social.ErrRelationNotFound
+	}
+	return nil
+}
+
+// BlockUser は相手をブロックし、相手との間のフォロー関係を解除する
+// 既にブロックしている場合も成功として扱う
+func (i *interactor) BlockUser(ctx context.Context, userID, targetID string) error {
+	b, err := social.NewBlock(userID, targetID)
+	if err != nil {
+		return err
+	}
+
+	// 相手からブロックされていてもブロックできるよう、存在確認のみ行う
+	if err := i.checkUserExists(ctx, targetID); err != nil {
+		return err
+	}
+
+	if err := i.socialRepo.Block(ctx, b); err != nil {
+		return fmt.Errorf("failed to block user: %w", err)
+	}
+	return nil
+}
+
+// UnblockUser は相手のブロックを解除する
+func (i *interactor) UnblockUser(ctx context.Context, userID, targetID string) error {
+	if err := i.socialRepo.Unblock(ctx, userID, targetID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return social.ErrRelationNotFound
+		}
+		return fmt.Errorf("failed to unblock user: %w", err)
+	}
+	return nil
+}
+
+// ListBlockedUsers はブロックしているユーザーを取得する
+func (i *interactor) ListBlockedUsers(ctx context.Context, userID string) ([]*social.Connection, error) {
+	blocked, err := i.socialRepo.ListBlocked(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blocked users: %w", err)
+	}
+	return blocked, nil
+}
+
+// checkVisible は相手が存在し、ブロック関係にないことを確認する
+// ブロック関係にある場合も存在しない場合と同じ social.ErrUserNotFound を返す
+func (i *interactor) checkVisible(ctx context.Context, userID, otherID string) error {
+	if userID == otherID {
+		return social.ErrSelfRelation
+	}
+	if err := i.checkUserExists(ctx, otherID); err != nil {
+		return err
+	}
+
+	blocked, err := i.socialRepo.IsBlocked(ctx, userID, otherID)
+	if err != nil {
+		return fmt.Errorf("failed to check block: %w", err)
+	}
+	if blocked {
+		return social.ErrUserNotFound
+	}
+	return nil
+}
+
+// checkUserExists はユーザーが存在することを確認する
+func (i *interactor) checkUserExists(ctx context.Context, userID string) error {
+	if _, err := i.userRepo.FindByID(ctx, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return social.ErrUserNotFound
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	return nil
+}
+
+// findPendingRequest は承認待ちのフォローリクエストを取得する
+// 存在しない場合や承認済みの場合は social.ErrRequestNotFound を返す
+func (i *interactor) findPendingRequest(ctx context.Context, followerID, followeeID string) (*social.Follow, error) {
+	f, err := i.socialRepo.FindFollow(ctx, followerID, followeeID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, social.ErrRequestNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get follow request: %w", err)
+	}
+	if !f.IsPending() {
+		return nil, social.ErrRequestNotFound
+	}
+	return f, nil
+}
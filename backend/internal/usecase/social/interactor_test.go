@@ -0,0 +1,239 @@
+package social
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/social"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSocialRepository はsocial.Repositoryのモック
+type MockSocialRepository struct {
+	mock.Mock
+}
+
+func (m *MockSocialRepository) FindFollow(ctx context.Context, followerID, followeeID string) (*social.Follow, error) {
+	args := m.Called(ctx, followerID, followeeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*social.Follow), args.Error(1)
+}
+
+func (m *MockSocialRepository) SaveFollow(ctx context.Context, f *social.Follow) error {
+	return m.Called(ctx, f).Error(0)
+}
+
+func (m *MockSocialRepository) DeleteFollows(ctx context.Context, userID, otherID string) (int, error) {
+	args := m.Called(ctx, userID, otherID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockSocialRepository) DeleteFollow(ctx context.Context, followerID, followeeID string) error {
+	return m.Called(ctx, followerID, followeeID).Error(0)
+}
+
+func (m *MockSocialRepository) ListFriends(ctx context.Context, userID string) ([]*social.Connection, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*social.Connection), args.Error(1)
+}
+
+func (m *MockSocialRepository) AreFriends(ctx context.Context, userID, otherID string) (bool, error) {
+	args := m.Called(ctx, userID, otherID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockSocialRepository) ListMutualFriends(ctx context.Context, userID, otherID string) ([]*social.Connection, error) {
+	args := m.Called(ctx, userID, otherID)
+	return args.Get(0).([]*social.Connection), args.Error(1)
+}
+
+func (m *MockSocialRepository) ListIncomingRequests(ctx context.Context, userID string) ([]*social.Connection, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*social.Connection), args.Error(1)
+}
+
+func (m *MockSocialRepository) ListOutgoingRequests(ctx context.Context, userID string) ([]*social.Connection, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*social.Connection), args.Error(1)
+}
+
+func (m *MockSocialRepository) IsBlocked(ctx context.Context, userID, otherID string) (bool, error) {
+	args := m.Called(ctx, userID, otherID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockSocialRepository) Block(ctx context.Context, b *social.Block) error {
+	return m.Called(ctx, b).Error(0)
+}
+
+func (m *MockSocialRepository) Unblock(ctx context.Context, blockerID, blockedID string) error {
+	return m.Called(ctx, blockerID, blockedID).Error(0)
+}
+
+func (m *MockSocialRepository) ListBlocked(ctx context.Context, blockerID string) ([]*social.Connection, error) {
+	args := m.Called(ctx, blockerID)
+	return args.Get(0).([]*social.Connection), args.Error(1)
+}
+
+// MockUserRepository はuser.Repositoryのモック
+type MockUserRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRepository) FindByID(ctx context.Context, id string) (*user.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, u *user.User) error {
+	return m.Called(ctx, u).Error(0)
+}
+
+func (m *MockUserRepository) CreateIfNotExists(ctx context.Context, u *user.User) error {
+	return m.Called(ctx, u).Error(0)
+}
+
+func setupInteractor() (Usecase, *MockSocialRepository, *MockUserRepository) {
+	socialRepo := new(MockSocialRepository)
+	userRepo := new(MockUserRepository)
+	return NewInteractor(socialRepo, userRepo), socialRepo, userRepo
+}
+
+func TestSendFollowRequest_Success(t *testing.T) {
+	it, socialRepo, userRepo := setupInteractor()
+	ctx := context.Background()
+
+	userRepo.On("FindByID", ctx, "bob").Return(user.NewUser("bob", "Bob", "google"), nil)
+	socialRepo.On("IsBlocked", ctx, "alice", "bob").Return(false, nil)
+	socialRepo.On("FindFollow", ctx, "alice", "bob").Return(nil, sql.ErrNoRows)
+	socialRepo.On("SaveFollow", ctx, mock.AnythingOfType("*social.Follow")).Return(nil)
+
+	f, err := it.SendFollowRequest(ctx, "alice", "bob")
+
+	// 期待値: 承認待ちのリクエストが保存される
+	require.NoError(t, err)
+	assert.Equal(t, social.FollowPending, f.Status)
+	socialRepo.AssertExpectations(t)
+}
+
+func TestSendFollowRequest_Blocked(t *testing.T) {
+	it, socialRepo, userRepo := setupInteractor()
+	ctx := context.Background()
+
+	userRepo.On("FindByID", ctx, "bob").Return(user.NewUser("bob", "Bob", "google"), nil)
+	socialRepo.On("IsBlocked", ctx, "alice", "bob").Return(true, nil)
+
+	_, err := it.SendFollowRequest(ctx, "alice", "bob")
+
+	// 期待値: ブロック関係にある相手は存在しないユーザーとして扱う
+	assert.ErrorIs(t, err, social.ErrUserNotFound)
+	socialRepo.AssertNotCalled(t, "SaveFollow", mock.Anything, mock.Anything)
+}
+
+func TestSendFollowRequest_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("self", func(t *testing.T) {
+		it, _, _ := setupInteractor()
+		// 期待値: 自分自身にはリクエストできない
+		_, err := it.SendFollowRequest(ctx, "alice", "alice")
+		assert.ErrorIs(t, err, social.ErrSelfRelation)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		it, _, userRepo := setupInteractor()
+		userRepo.On("FindByID", ctx, "nobody").Return(nil, sql.ErrNoRows)
+		// 期待値: 存在しないユーザーにはリクエストできない
+		_, err := it.SendFollowRequest(ctx, "alice", "nobody")
+		assert.ErrorIs(t, err, social.ErrUserNotFound)
+	})
+
+	t.Run("already requested", func(t *testing.T) {
+		it, socialRepo, userRepo := setupInteractor()
+		existing, _ := social.NewFollowRequest("alice", "bob")
+		userRepo.On("FindByID", ctx, "bob").Return(user.NewUser("bob", "Bob", "google"), nil)
+		socialRepo.On("IsBlocked", ctx, "alice", "bob").Return(false, nil)
+		socialRepo.On("FindFollow", ctx, "alice", "bob").Return(existing, nil)
+		// 期待値: 送信済みのリクエストは重複して送信できない
+		_, err := it.SendFollowRequest(ctx, "alice", "bob")
+		assert.ErrorIs(t, err, social.ErrAlreadyFollowing)
+	})
+}
+
+func TestAcceptFollowRequest(t *testing.T) {
+	it, socialRepo, _ := setupInteractor()
+	ctx := context.Background()
+
+	pending, _ := social.NewFollowRequest("alice", "bob")
+	socialRepo.On("FindFollow", ctx, "alice", "bob").Return(pending, nil)
+	socialRepo.On("SaveFollow", ctx, pending).Return(nil)
+
+	f, err := it.AcceptFollowRequest(ctx, "bob", "alice")
+
+	// 期待値: 宛先のユーザーが承認すると承認済みになる
+	require.NoError(t, err)
+	assert.Equal(t, social.FollowAccepted, f.Status)
+
+	// 期待値: 承認済みのリクエストは拒否できない
+	err = it.RejectFollowRequest(ctx, "bob", "alice")
+	assert.ErrorIs(t, err, social.ErrRequestNotFound)
+}
+
+func TestRejectFollowRequest_NotFound(t *testing.T) {
+	it, socialRepo, _ := setupInteractor()
+	ctx := context.Background()
+
+	socialRepo.On("FindFollow", ctx, "alice", "bob").Return(nil, sql.ErrNoRows)
+
+	// 期待値: リクエストがない場合は見つからないエラー
+	err := it.RejectFollowRequest(ctx, "bob", "alice")
+	assert.ErrorIs(t, err, social.ErrRequestNotFound)
+}
+
+func TestRemoveFriend_NotFound(t *testing.T) {
+	it, socialRepo, _ := setupInteractor()
+	ctx := context.Background()
+
+	socialRepo.On("DeleteFollows", ctx, "alice", "bob").Return(0, nil)
+
+	// 期待値: フォロー関係がない場合は見つからないエラー
+	err := it.RemoveFriend(ctx, "alice", "bob")
+	assert.ErrorIs(t, err, social.ErrRelationNotFound)
+}
+
+func TestBlockUser_EvenIfBlockedByTarget(t *testing.T) {
+	it, socialRepo, userRepo := setupInteractor()
+	ctx := context.Background()
+
+	userRepo.On("FindByID", ctx, "bob").Return(user.NewUser("bob", "Bob", "google"), nil)
+	socialRepo.On("Block", ctx, mock.MatchedBy(func(b *social.Block) bool {
+		return b.BlockerID == "alice" && b.BlockedID == "bob"
+	})).Return(nil)
+
+	// 期待値: 相手からブロックされているかどうかに関わらずブロックできる
+	err := it.BlockUser(ctx, "alice", "bob")
+	require.NoError(t, err)
+	socialRepo.AssertNotCalled(t, "IsBlocked", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestListMutualFriends_Blocked(t *testing.T) {
+	it, socialRepo, userRepo := setupInteractor()
+	ctx := context.Background()
+
+	userRepo.On("FindByID", ctx, "bob").Return(user.NewUser("bob", "Bob", "google"), nil)
+	socialRepo.On("IsBlocked", ctx, "alice", "bob").Return(true, nil)
+
+	// 期待値: ブロック関係にある相手の共通の友達は参照できない
+	_, err := it.ListMutualFriends(ctx, "alice", "bob")
+	assert.ErrorIs(t, err, social.ErrUserNotFound)
+	socialRepo.AssertNotCalled(t, "ListMutualFriends", mock.Anything, mock.Anything, mock.Anything)
+}
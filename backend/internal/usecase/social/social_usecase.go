@@ -0,0 +1,47 @@
+package social
+
+import (
+	"context"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/social"
+)
+
+// RequestDirection はフォローリクエスト一覧の方向
+type RequestDirection string
+
+const (
+	// RequestIncoming は自分宛てのリクエスト
+	RequestIncoming RequestDirection = "incoming"
+	// RequestOutgoing は自分が送信したリクエスト
+	RequestOutgoing RequestDirection = "outgoing"
+)
+
+// IsValid は定義済みの方向かどうかを返す
+func (d RequestDirection) IsValid() bool {
+	return d == RequestIncoming || d == RequestOutgoing
+}
+
+// Usecase はフォロー・友達・ブロックのユースケースインターフェース
+// ブロック関係にある相手は存在しないユーザーとして扱い、social.ErrUserNotFound を返す
+type Usecase interface {
+	// ListFriends は相互フォロー（友達）のユーザーを取得する
+	ListFriends(ctx context.Context, userID string) ([]*social.Connection, error)
+	// ListMutualFriends は相手との共通の友達を取得する
+	ListMutualFriends(ctx context.Context, userID, otherID string) ([]*social.Connection, error)
+	// ListFollowRequests は承認待ちのフォローリクエストを取得する
+	ListFollowRequests(ctx context.Context, userID string, direction RequestDirection) ([]*social.Connection, error)
+	// SendFollowRequest は相手にフォローリクエストを送信する
+	SendFollowRequest(ctx context.Context, userID, targetID string) (*social.Follow, error)
+	// AcceptFollowRequest は相手からのフォローリクエストを承認する
+	AcceptFollowRequest(ctx context.Context, userID, requesterID string) (*social.Follow, error)
+	// RejectFollowRequest は相手からのフォローリクエストを拒否する
+	RejectFollowRequest(ctx context.Context, userID, requesterID string) error
+	// RemoveFriend は相手との間のフォロー関係（承認待ちを含む）を両方向とも解除する
+	RemoveFriend(ctx context.Context, userID, otherID string) error
+	// BlockUser は相手をブロックし、相手との間のフォロー関係を解除する
+	BlockUser(ctx context.Context, userID, targetID string) error
+	// UnblockUser は相手のブロックを解除する
+	UnblockUser(ctx context.Context, userID, targetID string) error
+	// ListBlockedUsers はブロックしているユーザーを取得する
+	ListBlockedUsers(ctx context.Context, userID string) ([]*social.Connection, error)
+}
@@ -0,0 +1,117 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/stats"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+)
+
+// maxBuckets は1回の取得で返す期間数の上限
+const maxBuckets = 366
+
+// defaultBucketCounts は期間未指定時に返す期間数（今日を含む）
+var defaultBucketCounts = map[stats.Granularity]int{
+	stats.GranularityDay:   30,
+	stats.GranularityWeek:  12,
+	stats.GranularityMonth: 12,
+}
+
+// interactor は散歩統計Usecaseの実装
+type interactor struct {
+	statsRepo stats.Repository
+	loc       *time.Location // タイムゾーン未指定時に期間の区切りに使うタイムゾーン
+	now       func() time.Time
+}
+
+// NewInteractor は新しい散歩統計Interactorを生成する
+// loc は連続記録・自己ベストと同じ暦日で集計するため、記録のタイムゾーン設定を渡す
+func NewInteractor(statsRepo stats.Repository, loc *time.Location) Usecase {
+	return &interactor{
+		statsRepo: statsRepo,
+		loc:       loc,
+		now:       time.Now,
+	}
+}
+
+// GetStats はユーザーの散歩統計を期間ごとに集計して取得する
+func (i *interactor) GetStats(ctx context.Context, input GetStatsInput) (*StatsResult, error) {
+	if input.Location == nil {
+		input.Location = i.loc
+	}
+
+	from, to, errs := resolvePeriod(input, i.now())
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+
+	loc := from.Location()
+	buckets, err := i.statsRepo.AggregateWalks(ctx, input.UserID, input.Granularity, from, to, loc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate walks: %w", err)
+	}
+
+	filled := stats.FillBuckets(input.Granularity, from, to, buckets)
+
+	return &StatsResult{
+		Granularity: input.Granularity,
+		From:        from,
+		To:          to,
+		Buckets:     filled,
+		Total:       stats.Summarize(filled),
+	}, nil
+}
+
+// resolvePeriod は入力から集計対象の期間 [from, to) を求める
+// from・to はユーザーのタイムゾーンでの期間の境界に揃える
+func resolvePeriod(input GetStatsInput, now time.Time) (time.Time, time.Time, validator.ValidationErrors) {
+	var errs validator.ValidationErrors
+
+	g := input.Granularity
+	if !g.IsValid() {
+		errs.AddField("granularity", fmt.Sprintf("granularity must be one of %s, %s, %s",
+			stats.GranularityDay, stats.GranularityWeek, stats.GranularityMonth))
+		return time.Time{}, time.Time{}, errs
+	}
+
+	loc := input.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	// 日付はユーザーのタイムゾーンでの暦日として扱う
+	toDay := now.In(loc)
+	if input.To != nil {
+		y, m, d := input.To.Date()
+		toDay = time.Date(y, m, d, 0, 0, 0, 0, loc)
+	}
+	to := g.Next(g.Truncate(toDay))
+
+	var from time.Time
+	if input.From != nil {
+		y, m, d := input.From.Date()
+		from = g.Truncate(time.Date(y, m, d, 0, 0, 0, 0, loc))
+	} else {
+		from = g.Truncate(toDay)
+		for n := 1; n < defaultBucketCounts[g]; n++ {
+			from = g.Truncate(from.Add(-time.Nanosecond))
+		}
+	}
+
+	if !from.Before(to) {
+		errs.AddField("from", "from must be on or before to")
+		return from, to, errs
+	}
+
+	count := 0
+	for t := from; t.Before(to); t = g.Next(t) {
+		if count++; count > maxBuckets {
+			errs.AddField("from", fmt.Sprintf("period must not exceed %d %ss", maxBuckets, g))
+			break
+		}
+	}
+
+	return from, to, errs
+}
@@ -0,0 +1,132 @@
+package stats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/stats"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockStatsRepository はstats.Repositoryのモック
+type MockStatsRepository struct {
+	mock.Mock
+}
+
+func (m *MockStatsRepository) AggregateWalks(ctx context.Context, userID string, g stats.Granularity, from, to time.Time, loc *time.Location) ([]stats.Bucket, error) {
+	args := m.Called(ctx, userID, g, from, to, loc)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]stats.Bucket), args.Error(1)
+}
+
+func (m *MockStatsRepository) SummarizeWalks(ctx context.Context, userID string) (stats.Bucket, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(stats.Bucket), args.Error(1)
+}
+
+func TestGetStats_DefaultTimeZone(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	repo := new(MockStatsRepository)
+	it := NewInteractor(repo, tokyo).(*interactor)
+	// 2025-01-15 20:00 UTC = 2025-01-16 05:00 JST
+	it.now = func() time.Time { return time.Date(2025, 1, 15, 20, 0, 0, 0, time.UTC) }
+
+	repo.On("AggregateWalks", mock.Anything, "user-1", stats.GranularityDay, mock.Anything, mock.Anything, tokyo).
+		Return([]stats.Bucket{}, nil)
+
+	// 期待値: tz未指定の場合は設定のタイムゾーンの暦日で区切る
+	result, err := it.GetStats(context.Background(), GetStatsInput{UserID: "user-1", Granularity: stats.GranularityDay})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 1, 17, 0, 0, 0, 0, tokyo), result.To)
+	repo.AssertExpectations(t)
+}
+
+func TestResolvePeriod(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	// 2025-01-15 20:00 UTC = 2025-01-16（木）05:00 JST
+	now := time.Date(2025, 1, 15, 20, 0, 0, 0, time.UTC)
+
+	date := func(y int, m time.Month, d int) *time.Time {
+		t := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	tests := []struct {
+		name     string
+		input    GetStatsInput
+		wantFrom time.Time
+		wantTo   time.Time
+	}{
+		{
+			// 期待値: 日単位のデフォルトはユーザーのタイムゾーンでの今日を含む30日間
+			name:     "day default in Tokyo",
+			input:    GetStatsInput{Granularity: stats.GranularityDay, Location: tokyo},
+			wantFrom: time.Date(2024, 12, 18, 0, 0, 0, 0, tokyo),
+			wantTo:   time.Date(2025, 1, 17, 0, 0, 0, 0, tokyo),
+		},
+		{
+			// 期待値: 週単位のデフォルトは今週を含む12週間（月曜始まり）
+			name:     "week default",
+			input:    GetStatsInput{Granularity: stats.GranularityWeek},
+			wantFrom: time.Date(2024, 10, 28, 0, 0, 0, 0, time.UTC),
+			wantTo:   time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// 期待値: 月単位で期間を指定した場合は月の境界に揃え、終了月を含む
+			name: "month with range",
+			input: GetStatsInput{
+				Granularity: stats.GranularityMonth,
+				From:        date(2024, 11, 20),
+				To:          date(2025, 1, 5),
+			},
+			wantFrom: time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
+			wantTo:   time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, errs := resolvePeriod(tt.input, now)
+			require.Empty(t, errs)
+			assert.True(t, tt.wantFrom.Equal(from), "from = %v, want %v", from, tt.wantFrom)
+			assert.True(t, tt.wantTo.Equal(to), "to = %v, want %v", to, tt.wantTo)
+		})
+	}
+}
+
+func TestResolvePeriod_Invalid(t *testing.T) {
+	now := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	from := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	longAgo := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		input     GetStatsInput
+		wantField string
+	}{
+		// 期待値: 未定義の集計単位はgranularityのエラー
+		{name: "invalid granularity", input: GetStatsInput{Granularity: "year"}, wantField: "granularity"},
+		// 期待値: 開始日が終了日より後ならfromのエラー
+		{name: "reversed range", input: GetStatsInput{Granularity: stats.GranularityDay, From: &from, To: &to}, wantField: "from"},
+		// 期待値: 期間数の上限を超える場合はfromのエラー
+		{name: "too many buckets", input: GetStatsInput{Granularity: stats.GranularityDay, From: &longAgo}, wantField: "from"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, errs := resolvePeriod(tt.input, now)
+			require.Len(t, errs, 1)
+			assert.Equal(t, tt.wantField, errs[0].Field)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package stats
+
+import (
+	"context"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/stats"
+)
+
+// GetStatsInput は散歩統計取得の入力
+type GetStatsInput struct {
+	UserID      string
+	Granularity stats.Granularity
+	From        *time.Time     // 集計開始日（この日を含む）。nilの場合は集計単位ごとのデフォルト期間
+	To          *time.Time     // 集計終了日（この日を含む）。nilの場合は今日
+	Location    *time.Location // 期間の区切りに使うユーザーのタイムゾーン。nilの場合はサーバー設定のタイムゾーン
+}
+
+// StatsResult は散歩統計の結果
+type StatsResult struct {
+	Granularity stats.Granularity
+	From        time.Time      // 最初の期間の開始時刻
+	To          time.Time      // 最後の期間の終了時刻（この時刻を含まない）
+	Buckets     []stats.Bucket // 期間ごとの集計値（散歩のない期間も含む）
+	Total       stats.Bucket   // 全期間の合計
+}
+
+// Usecase は散歩統計のユースケースインターフェース
+type Usecase interface {
+	// GetStats はユーザーの散歩統計を期間ごとに集計して取得する
+	GetStats(ctx context.Context, input GetStatsInput) (*StatsResult, error)
+}
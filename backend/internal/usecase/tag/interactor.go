@@ -0,0 +1,111 @@
+package tag
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/tag"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+	"github.com/google/uuid"
+)
+
+// interactor はタグUsecaseの実装
+type interactor struct {
+	tagRepo tag.Repository
+}
+
+// NewInteractor は新しいタグInteractorを生成する
+func NewInteractor(tagRepo tag.Repository) Usecase {
+	return &interactor{
+		tagRepo: tagRepo,
+	}
+}
+
+// ListTags はユーザーのタグを散歩の数とあわせて取得する
+func (i *interactor) ListTags(ctx context.Context, userID string) ([]*tag.Tag, error) {
+	tags, err := i.tagRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	return tags, nil
+}
+
+// CreateTag はタグを作成する
+// 同じ名前（大文字小文字を区別しない）のタグが存在する場合は tag.ErrDuplicateName を返す
+func (i *interactor) CreateTag(ctx context.Context, userID, name string) (*tag.Tag, error) {
+	t, err := tag.NewTag(userID, name)
+	if err != nil {
+		return nil, nameValidationError(err)
+	}
+
+	if err := i.tagRepo.Create(ctx, t); err != nil {
+		if errors.Is(err, tag.ErrDuplicateName) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	return t, nil
+}
+
+// RenameTag はタグ名を変更する
+func (i *interactor) RenameTag(ctx context.Context, id uuid.UUID, userID, name string) (*tag.Tag, error) {
+	t, err := i.findOwnedTag(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.Rename(name); err != nil {
+		return nil, nameValidationError(err)
+	}
+
+	if err := i.tagRepo.Update(ctx, t); err != nil {
+		if errors.Is(err, tag.ErrDuplicateName) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to rename tag: %w", err)
+	}
+
+	return t, nil
+}
+
+// DeleteTag はタグを削除し、散歩から外す
+func (i *interactor) DeleteTag(ctx context.Context, id uuid.UUID, userID string) error {
+	if _, err := i.findOwnedTag(ctx, id, userID); err != nil {
+		return err
+	}
+
+	if err := i.tagRepo.Delete(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return tag.ErrTagNotFound
+		}
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	return nil
+}
+
+// findOwnedTag はユーザーのタグを取得する
+// 存在しない場合や他のユーザーのタグの場合は tag.ErrTagNotFound を返す
+func (i *interactor) findOwnedTag(ctx context.Context, id uuid.UUID, userID string) (*tag.Tag, error) {
+	t, err := i.tagRepo.FindByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, tag.ErrTagNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+	if t.UserID != userID {
+		return nil, tag.ErrTagNotFound
+	}
+	return t, nil
+}
+
+// nameValidationError はタグ名の検証エラーをフィールド単位のバリデーションエラーに変換する
+func nameValidationError(err error) error {
+	var errs validator.ValidationErrors
+	errs.AddField("name", err.Error())
+	return errs.Err()
+}
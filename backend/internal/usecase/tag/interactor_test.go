@@ -0,0 +1,127 @@
+package tag
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/tag"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTagRepository はtag.Repositoryのモック
+type MockTagRepository struct {
+	mock.Mock
+}
+
+func (m *MockTagRepository) ListByUserID(ctx context.Context, userID string) ([]*tag.Tag, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*tag.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) FindByID(ctx context.Context, id uuid.UUID) (*tag.Tag, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tag.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) Create(ctx context.Context, t *tag.Tag) error {
+	return m.Called(ctx, t).Error(0)
+}
+
+func (m *MockTagRepository) Update(ctx context.Context, t *tag.Tag) error {
+	return m.Called(ctx, t).Error(0)
+}
+
+func (m *MockTagRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func setupInteractor() (Usecase, *MockTagRepository) {
+	tagRepo := new(MockTagRepository)
+	return NewInteractor(tagRepo), tagRepo
+}
+
+func TestCreateTag(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		it, tagRepo := setupInteractor()
+		tagRepo.On("Create", ctx, mock.AnythingOfType("*tag.Tag")).Return(nil)
+
+		created, err := it.CreateTag(ctx, "alice", " 朝の散歩 ")
+
+		// 期待値: 前後の空白を除去した名前で保存される
+		require.NoError(t, err)
+		assert.Equal(t, "朝の散歩", created.Name)
+		assert.Equal(t, "alice", created.UserID)
+	})
+
+	t.Run("blank name", func(t *testing.T) {
+		it, tagRepo := setupInteractor()
+
+		_, err := it.CreateTag(ctx, "alice", "  ")
+
+		// 期待値: nameフィールドのバリデーションエラー
+		var errs validator.ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		assert.Equal(t, "name", errs[0].Field)
+		tagRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		it, tagRepo := setupInteractor()
+		tagRepo.On("Create", ctx, mock.AnythingOfType("*tag.Tag")).Return(tag.ErrDuplicateName)
+
+		// 期待値: 重複エラーはそのまま返す
+		_, err := it.CreateTag(ctx, "alice", "dog")
+		assert.ErrorIs(t, err, tag.ErrDuplicateName)
+	})
+}
+
+func TestRenameTag(t *testing.T) {
+	it, tagRepo := setupInteractor()
+	ctx := context.Background()
+
+	existing, _ := tag.NewTag("alice", "dog")
+	tagRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+	tagRepo.On("Update", ctx, existing).Return(nil)
+
+	renamed, err := it.RenameTag(ctx, existing.ID, "alice", "犬の散歩")
+
+	// 期待値: 名前が変更されて保存される
+	require.NoError(t, err)
+	assert.Equal(t, "犬の散歩", renamed.Name)
+	tagRepo.AssertExpectations(t)
+}
+
+func TestDeleteTag_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("other user's tag", func(t *testing.T) {
+		it, tagRepo := setupInteractor()
+		existing, _ := tag.NewTag("bob", "dog")
+		tagRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+
+		// 期待値: 他のユーザーのタグは存在しないタグとして扱う
+		err := it.DeleteTag(ctx, existing.ID, "alice")
+		assert.ErrorIs(t, err, tag.ErrTagNotFound)
+		tagRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("missing tag", func(t *testing.T) {
+		it, tagRepo := setupInteractor()
+		id := uuid.New()
+		tagRepo.On("FindByID", ctx, id).Return(nil, sql.ErrNoRows)
+
+		// 期待値: 存在しないタグは見つからないエラー
+		err := it.DeleteTag(ctx, id, "alice")
+		assert.ErrorIs(t, err, tag.ErrTagNotFound)
+	})
+}
@@ -0,0 +1,22 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/tag"
+	"github.com/google/uuid"
+)
+
+// Usecase はタグのユースケースインターフェース
+// 他のユーザーのタグは存在しないタグとして扱い、tag.ErrTagNotFound を返す
+// 散歩へのタグ付けは散歩の更新（walk.Usecase.UpdateWalk）で行う
+type Usecase interface {
+	// ListTags はユーザーのタグを散歩の数とあわせて取得する
+	ListTags(ctx context.Context, userID string) ([]*tag.Tag, error)
+	// CreateTag はタグを作成する
+	CreateTag(ctx context.Context, userID, name string) (*tag.Tag, error)
+	// RenameTag はタグ名を変更する（タグが付いている散歩にも反映される）
+	RenameTag(ctx context.Context, id uuid.UUID, userID, name string) (*tag.Tag, error)
+	// DeleteTag はタグを削除し、散歩から外す
+	DeleteTag(ctx context.Context, id uuid.UUID, userID string) error
+}
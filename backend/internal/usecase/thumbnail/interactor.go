@@ -0,0 +1,84 @@
+package thumbnail
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/logger"
+	render "github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/thumbnail"
+	"go.uber.org/zap"
+)
+
+// contentType はサムネイル画像の形式
+const contentType = "image/png"
+
+// interactor はサムネイルUsecaseの実装
+type interactor struct {
+	walkRepo walk.Repository
+	store    ObjectStore
+	options  render.Options
+	logger   logger.Logger
+}
+
+// NewInteractor は新しいサムネイルInteractorを生成する
+func NewInteractor(walkRepo walk.Repository, store ObjectStore, log logger.Logger) Usecase {
+	return &interactor{
+		walkRepo: walkRepo,
+		store:    store,
+		options:  render.DefaultOptions,
+		logger:   log,
+	}
+}
+
+// RecordCompletedWalk は完了した散歩のルートをサムネイル画像に描画して保存し、WalkのサムネイルURLに設定する
+// ポリラインと同じく精度の悪い点を除外して描画し、クライアントが指定したURLよりサーバーで生成した画像を正とする
+// 同じ散歩は同じパスに保存するため、再送されても画像は増えない
+func (i *interactor) RecordCompletedWalk(ctx context.Context, w *walk.Walk, locations []*walk.WalkLocation) error {
+	if !w.IsCompleted() {
+		return nil
+	}
+
+	accepted := walk.FilterByAccuracy(locations, walk.MaxHorizontalAccuracy)
+	if len(accepted) == 0 {
+		return nil
+	}
+
+	points := make([]render.Point, len(accepted))
+	for idx, loc := range accepted {
+		points[idx] = render.Point{Lat: loc.Latitude, Lng: loc.Longitude}
+	}
+
+	var buf bytes.Buffer
+	if err := render.EncodePNG(&buf, points, i.options); err != nil {
+		return fmt.Errorf("failed to render thumbnail: %w", err)
+	}
+
+	url, err := i.store.Upload(ctx, storagePath(w), &buf, contentType)
+	if err != nil {
+		return fmt.Errorf("failed to upload thumbnail: %w", err)
+	}
+
+	if err := i.walkRepo.UpdateThumbnailURL(ctx, w.ID, url); err != nil {
+		return fmt.Errorf("failed to update thumbnail url: %w", err)
+	}
+	w.ThumbnailImageURL = &url
+
+	return nil
+}
+
+// RemoveThumbnail は散歩のサムネイル画像を削除する（失敗はログに残す）
+// サムネイルのURLを保存する前に失敗した場合も画像が残るため、URLの有無によらず削除する
+func (i *interactor) RemoveThumbnail(ctx context.Context, w *walk.Walk) {
+	path := storagePath(w)
+	if err := i.store.Delete(ctx, path); err != nil {
+		i.logger.Error("Failed to delete walk thumbnail",
+			zap.String("walk_id", w.ID.String()), zap.String("path", path), zap.Error(err))
+	}
+}
+
+// storagePath はサムネイル画像の保存先のパスを返す
+func storagePath(w *walk.Walk) string {
+	return fmt.Sprintf("walk_thumbnails/%s/%s.png", w.UserID, w.ID)
+}
@@ -0,0 +1,182 @@
+package thumbnail
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image/png"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockWalkRepository はwalk.Repositoryのモック（使用するメソッドのみ実装）
+type MockWalkRepository struct {
+	mock.Mock
+	walk.Repository
+}
+
+func (m *MockWalkRepository) UpdateThumbnailURL(ctx context.Context, id uuid.UUID, url string) error {
+	return m.Called(ctx, id, url).Error(0)
+}
+
+// MockObjectStore はObjectStoreのモック
+// アップロードされた内容を uploaded に保持する
+type MockObjectStore struct {
+	mock.Mock
+	uploaded []byte
+}
+
+func (m *MockObjectStore) Upload(ctx context.Context, path string, content io.Reader, contentType string) (string, error) {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return "", err
+	}
+	m.uploaded = data
+	args := m.Called(ctx, path, contentType)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockObjectStore) Delete(ctx context.Context, path string) error {
+	return m.Called(ctx, path).Error(0)
+}
+
+func setupInteractor() (*MockWalkRepository, *MockObjectStore, Usecase) {
+	walkRepo := new(MockWalkRepository)
+	store := new(MockObjectStore)
+	return walkRepo, store, NewInteractor(walkRepo, store, logger.NewNopLogger())
+}
+
+func newCompletedWalk() *walk.Walk {
+	w := walk.NewWalk("user-123", "Morning Walk", "")
+	w.Status = walk.StatusCompleted
+	return w
+}
+
+func newLocations(walkID uuid.UUID, accuracy float64) []*walk.WalkLocation {
+	base := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	return []*walk.WalkLocation{
+		walk.NewWalkLocation(walkID, 35.6812, 139.7671, 0, base, accuracy, 5, 1.2, 0, 1),
+		walk.NewWalkLocation(walkID, 35.6822, 139.7681, 0, base.Add(time.Minute), accuracy, 5, 1.2, 0, 2),
+		walk.NewWalkLocation(walkID, 35.6832, 139.7671, 0, base.Add(2*time.Minute), accuracy, 5, 1.2, 0, 3),
+	}
+}
+
+func TestRecordCompletedWalk(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ルートを描画して保存し、サムネイルURLを設定する", func(t *testing.T) {
+		walkRepo, store, uc := setupInteractor()
+		w := newCompletedWalk()
+		path := "walk_thumbnails/user-123/" + w.ID.String() + ".png"
+		url := "https://storage.example.com/" + path
+
+		store.On("Upload", ctx, path, "image/png").Return(url, nil)
+		walkRepo.On("UpdateThumbnailURL", ctx, w.ID, url).Return(nil)
+
+		err := uc.RecordCompletedWalk(ctx, w, newLocations(w.ID, 5))
+		require.NoError(t, err)
+
+		// 期待値: Walkにも保存したURLが設定される
+		require.NotNil(t, w.ThumbnailImageURL)
+		assert.Equal(t, url, *w.ThumbnailImageURL)
+		// 期待値: PNG画像としてデコードできる内容をアップロードする
+		_, err = png.Decode(bytes.NewReader(store.uploaded))
+		assert.NoError(t, err)
+		store.AssertExpectations(t)
+		walkRepo.AssertExpectations(t)
+	})
+
+	t.Run("同じルートからは同じ画像を生成する", func(t *testing.T) {
+		walkRepo, store, uc := setupInteractor()
+		w := newCompletedWalk()
+		store.On("Upload", ctx, mock.Anything, "image/png").Return("url", nil)
+		walkRepo.On("UpdateThumbnailURL", ctx, w.ID, "url").Return(nil)
+
+		require.NoError(t, uc.RecordCompletedWalk(ctx, w, newLocations(w.ID, 5)))
+		first := store.uploaded
+		require.NoError(t, uc.RecordCompletedWalk(ctx, w, newLocations(w.ID, 5)))
+
+		// 期待値: アップロードした内容がバイト単位で一致する
+		assert.Equal(t, first, store.uploaded)
+	})
+
+	t.Run("完了していない散歩は何もしない", func(t *testing.T) {
+		walkRepo, store, uc := setupInteractor()
+		w := walk.NewWalk("user-123", "Morning Walk", "")
+
+		err := uc.RecordCompletedWalk(ctx, w, newLocations(w.ID, 5))
+		require.NoError(t, err)
+
+		// 期待値: ストレージ・リポジトリは呼ばれない
+		assert.Nil(t, w.ThumbnailImageURL)
+		store.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything)
+		walkRepo.AssertNotCalled(t, "UpdateThumbnailURL", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("精度の良い位置情報がない場合は何もしない", func(t *testing.T) {
+		walkRepo, store, uc := setupInteractor()
+		w := newCompletedWalk()
+
+		// 精度が許容範囲（50m）を超える点のみ
+		err := uc.RecordCompletedWalk(ctx, w, newLocations(w.ID, 100))
+		require.NoError(t, err)
+
+		assert.Nil(t, w.ThumbnailImageURL)
+		store.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything)
+		walkRepo.AssertNotCalled(t, "UpdateThumbnailURL", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("アップロードに失敗した場合はURLを設定しない", func(t *testing.T) {
+		walkRepo, store, uc := setupInteractor()
+		w := newCompletedWalk()
+		store.On("Upload", ctx, mock.Anything, "image/png").Return("", errors.New("storage error"))
+
+		err := uc.RecordCompletedWalk(ctx, w, newLocations(w.ID, 5))
+		assert.Error(t, err)
+
+		assert.Nil(t, w.ThumbnailImageURL)
+		walkRepo.AssertNotCalled(t, "UpdateThumbnailURL", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("URLの保存に失敗した場合はエラー", func(t *testing.T) {
+		walkRepo, store, uc := setupInteractor()
+		w := newCompletedWalk()
+		store.On("Upload", ctx, mock.Anything, "image/png").Return("url", nil)
+		walkRepo.On("UpdateThumbnailURL", ctx, w.ID, "url").Return(errors.New("db error"))
+
+		err := uc.RecordCompletedWalk(ctx, w, newLocations(w.ID, 5))
+		assert.Error(t, err)
+		assert.Nil(t, w.ThumbnailImageURL)
+	})
+}
+
+func TestRemoveThumbnail(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("保存先のパスの画像を削除する", func(t *testing.T) {
+		_, store, uc := setupInteractor()
+		w := newCompletedWalk()
+		store.On("Delete", ctx, "walk_thumbnails/user-123/"+w.ID.String()+".png").Return(nil)
+
+		uc.RemoveThumbnail(ctx, w)
+
+		store.AssertExpectations(t)
+	})
+
+	t.Run("削除に失敗してもpanicしない", func(t *testing.T) {
+		_, store, uc := setupInteractor()
+		w := newCompletedWalk()
+		store.On("Delete", ctx, mock.Anything).Return(errors.New("storage error"))
+
+		// 期待値: 失敗はログに残すのみ
+		assert.NotPanics(t, func() { uc.RemoveThumbnail(ctx, w) })
+	})
+}
@@ -0,0 +1,27 @@
+package thumbnail
+
+import (
+	"context"
+	"io"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+)
+
+// ObjectStore はサムネイル画像を保存するストレージのインターフェース
+// storage.Storage の一部で、テストではモックに差し替える
+type ObjectStore interface {
+	// Upload はファイルをアップロードし、ファイルのURLを返す
+	Upload(ctx context.Context, path string, content io.Reader, contentType string) (string, error)
+	// Delete はファイルを削除する
+	Delete(ctx context.Context, path string) error
+}
+
+// Usecase は散歩のサムネイル画像のユースケースインターフェース
+type Usecase interface {
+	// RecordCompletedWalk は完了した散歩のルートをサムネイル画像に描画して保存し、WalkのサムネイルURLに設定する
+	// 描画できる位置情報がない場合は何もしない
+	RecordCompletedWalk(ctx context.Context, w *walk.Walk, locations []*walk.WalkLocation) error
+
+	// RemoveThumbnail は散歩のサムネイル画像を削除する（失敗はログに残す）
+	RemoveThumbnail(ctx context.Context, w *walk.Walk)
+}
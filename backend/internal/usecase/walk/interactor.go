@@ -2,25 +2,47 @@ package walk
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/photo"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/privacy"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/tag"
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/logger"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/polyline"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
+// maxVersionConflictRetries は版数を指定しない更新で、版数の競合時にWalkを読み直す最大回数
+const maxVersionConflictRetries = 3
+
 // interactor はWalk Usecaseの実装
 type interactor struct {
-	walkRepo     walk.Repository
-	locationRepo walk.LocationRepository
-	// TODO: Phase2で追加
-	// logger   logger.Logger
+	walkRepo          walk.Repository
+	locationRepo      walk.LocationRepository
+	tx                TxManager
+	relations         RelationChecker
+	zones             ZoneLister
+	photos            PhotoAttacher
+	thumbnails        ThumbnailRemover
+	polylineTolerance float64 // ポリライン簡略化の許容誤差（メートル）
+	logger            logger.Logger
 }
 
 // NewInteractor は新しいWalk Interactorを生成する
-func NewInteractor(walkRepo walk.Repository, locationRepo walk.LocationRepository) Usecase {
+func NewInteractor(walkRepo walk.Repository, locationRepo walk.LocationRepository, tx TxManager, relations RelationChecker, zones ZoneLister, photos PhotoAttacher, thumbnails ThumbnailRemover, polylineTolerance float64, log logger.Logger) Usecase {
 	return &interactor{
-		walkRepo:     walkRepo,
-		locationRepo: locationRepo,
+		walkRepo:          walkRepo,
+		locationRepo:      locationRepo,
+		tx:                tx,
+		relations:         relations,
+		zones:             zones,
+		photos:            photos,
+		thumbnails:        thumbnails,
+		polylineTolerance: polylineTolerance,
+		logger:            log,
 	}
 }
 
@@ -40,7 +62,72 @@ func (i *interactor) CreateWalk(ctx context.Context, input CreateWalkInput) (*wa
 }
 
 // GetWalk はIDでWalkを取得する
+// 閲覧が許可されていない散歩は存在を知られないよう walk.ErrNotOwner を返す
+// 所有者以外にはプライバシーゾーン内の経路を取り除いたWalkを返す
 func (i *interactor) GetWalk(ctx context.Context, id uuid.UUID, userID string) (*walk.Walk, error) {
+	w, masker, err := i.getVisibleWalk(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return masker.MaskWalk(w), nil
+}
+
+// getVisibleWalk はIDで閲覧可能なWalkと、閲覧者に合わせて位置情報を取り除く Masker を取得する
+// 本人の場合はゾーンを持たない Masker を返すため、全データがそのまま見える
+func (i *interactor) getVisibleWalk(ctx context.Context, id uuid.UUID, userID string) (*walk.Walk, *privacy.Masker, error) {
+	w, err := i.walkRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get walk: %w", err)
+	}
+
+	// 閲覧権限チェック
+	rel, err := i.viewerRelation(ctx, w, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !w.IsVisibleTo(userID, rel) {
+		return nil, nil, walk.ErrNotOwner
+	}
+
+	if w.UserID == userID {
+		return w, privacy.NewMasker(nil), nil
+	}
+	zones, err := i.zones.ListByUserID(ctx, w.UserID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get privacy zones: %w", err)
+	}
+
+	return w, privacy.NewMasker(zones), nil
+}
+
+// viewerRelation は公開範囲の判定に必要な閲覧者と所有者の関係を取得する
+// 本人の場合や非公開の散歩では関係によらず判定できるため、問い合わせない
+func (i *interactor) viewerRelation(ctx context.Context, w *walk.Walk, viewerID string) (walk.ViewerRelation, error) {
+	var rel walk.ViewerRelation
+	if w.UserID == viewerID || w.Visibility == walk.VisibilityPrivate {
+		return rel, nil
+	}
+
+	blocked, err := i.relations.IsBlocked(ctx, w.UserID, viewerID)
+	if err != nil {
+		return rel, fmt.Errorf("failed to check block: %w", err)
+	}
+	rel.IsBlocked = blocked
+
+	if !blocked && w.Visibility == walk.VisibilityFriends {
+		friends, err := i.relations.AreFriends(ctx, w.UserID, viewerID)
+		if err != nil {
+			return rel, fmt.Errorf("failed to check friendship: %w", err)
+		}
+		rel.IsFriend = friends
+	}
+
+	return rel, nil
+}
+
+// getOwnedWalk はIDで本人のWalkを取得する
+// 変更系の操作は公開範囲によらず本人のみ許可する
+func (i *interactor) getOwnedWalk(ctx context.Context, id uuid.UUID, userID string) (*walk.Walk, error) {
 	w, err := i.walkRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get walk: %w", err)
@@ -48,7 +135,7 @@ func (i *interactor) GetWalk(ctx context.Context, id uuid.UUID, userID string) (
 
 	// 権限チェック
 	if w.UserID != userID {
-		return nil, fmt.Errorf("unauthorized")
+		return nil, walk.ErrNotOwner
 	}
 
 	return w, nil
@@ -56,7 +143,7 @@ func (i *interactor) GetWalk(ctx context.Context, id uuid.UUID, userID string) (
 
 // GetWalkWithLocations はIDでWalkと位置情報を取得する
 func (i *interactor) GetWalkWithLocations(ctx context.Context, id uuid.UUID, userID string) (*WalkWithLocations, error) {
-	w, err := i.GetWalk(ctx, id, userID)
+	w, masker, err := i.getVisibleWalk(ctx, id, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -66,20 +153,36 @@ func (i *interactor) GetWalkWithLocations(ctx context.Context, id uuid.UUID, use
 		return nil, fmt.Errorf("failed to get walk locations: %w", err)
 	}
 
+	photos, err := i.photos.ListWalkPhotos(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get walk photos: %w", err)
+	}
+
 	return &WalkWithLocations{
-		Walk:      w,
-		Locations: locations,
+		Walk:      masker.MaskWalk(w),
+		Locations: masker.MaskLocations(locations),
+		Photos:    masker.MaskPhotos(photos),
 	}, nil
 }
 
-// ListWalks はユーザーのWalk一覧を取得する
-func (i *interactor) ListWalks(ctx context.Context, userID string, limit, offset int) ([]*walk.Walk, int, error) {
-	walks, err := i.walkRepo.FindByUserID(ctx, userID, limit, offset)
+// ListWalks はユーザーのWalk一覧を絞り込み・並び替えて取得する
+func (i *interactor) ListWalks(ctx context.Context, userID string, query ListQuery, limit, offset int) ([]*walk.Walk, int, error) {
+	if err := validateListQuery(query, false).Err(); err != nil {
+		return nil, 0, err
+	}
+
+	walks, err := i.walkRepo.FindByCriteria(ctx, walk.ListCriteria{
+		UserID: userID,
+		Filter: query.Filter,
+		Sort:   query.Sort,
+		Limit:  limit,
+		Offset: offset,
+	})
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to list walks: %w", err)
 	}
 
-	count, err := i.walkRepo.Count(ctx, userID)
+	count, err := i.walkRepo.CountByCriteria(ctx, userID, query.Filter)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count walks: %w", err)
 	}
@@ -87,6 +190,42 @@ func (i *interactor) ListWalks(ctx context.Context, userID string, limit, offset
 	return walks, count, nil
 }
 
+// ListWalksByCursor はユーザーのWalk一覧をカーソル方式で取得する
+// 次ページの有無を判定するため、limit+1件を取得して余剰分を切り捨てる
+func (i *interactor) ListWalksByCursor(ctx context.Context, input ListWalksByCursorInput) (*WalkPage, error) {
+	if err := validateListQuery(input.Query, true).Err(); err != nil {
+		return nil, err
+	}
+
+	walks, err := i.walkRepo.FindByCriteria(ctx, walk.ListCriteria{
+		UserID: input.UserID,
+		Filter: input.Query.Filter,
+		Sort:   input.Query.Sort,
+		Limit:  input.Limit + 1,
+		After:  input.Cursor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list walks: %w", err)
+	}
+
+	page := &WalkPage{Walks: walks}
+	if len(walks) > input.Limit {
+		page.Walks = walks[:input.Limit]
+		next := walk.CursorOf(page.Walks[len(page.Walks)-1])
+		page.NextCursor = &next
+	}
+
+	if input.IncludeTotal {
+		count, err := i.walkRepo.CountByCriteria(ctx, input.UserID, input.Query.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count walks: %w", err)
+		}
+		page.TotalCount = &count
+	}
+
+	return page, nil
+}
+
 // applyWalkInputFields はUpdateWalkInputのフィールドをWalkエンティティに適用する
 func applyWalkInputFields(w *walk.Walk, input UpdateWalkInput) {
 	if input.Title != nil {
@@ -96,7 +235,10 @@ func applyWalkInputFields(w *walk.Walk, input UpdateWalkInput) {
 		w.Description = *input.Description
 	}
 	if input.Status != nil {
-		w.Status = *input.Status
+		w.ChangeStatus(*input.Status)
+	}
+	if input.Visibility != nil {
+		w.Visibility = *input.Visibility
 	}
 	if input.TotalSteps != nil {
 		w.UpdateSteps(*input.TotalSteps)
@@ -122,127 +264,351 @@ func applyWalkInputFields(w *walk.Walk, input UpdateWalkInput) {
 	if input.TotalPausedDuration != nil {
 		w.TotalPausedDuration = *input.TotalPausedDuration
 	}
+	if input.Tags != nil {
+		// validateUpdateWalkInput で検証済みのためエラーは発生しない
+		w.Tags, _ = tag.NormalizeNames(*input.Tags)
+	}
 }
 
 // UpdateWalk はWalkを更新または作成する（upsert）
 // 存在する場合は更新、存在しない場合は新規作成
+// input.IfMatch を指定した場合は版数が一致する既存のWalkのみ更新する
 func (i *interactor) UpdateWalk(ctx context.Context, input UpdateWalkInput, userID string) (*walk.Walk, error) {
+	// 入力値のバリデーション
+	if err := validateUpdateWalkInput(input).Err(); err != nil {
+		return nil, err
+	}
+
+	var w *walk.Walk
+	err := i.retryOnVersionConflict(input.IfMatch, func() error {
+		var err error
+		w, err = i.updateWalk(ctx, input, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// updateWalk はWalkを読み込み、入力を適用して保存する
+func (i *interactor) updateWalk(ctx context.Context, input UpdateWalkInput, userID string) (*walk.Walk, error) {
 	// 既存のWalkを取得（存在しない場合は新規作成）
 	w, err := i.walkRepo.FindByID(ctx, input.ID)
 	if err != nil {
+		// 版数を指定した場合は存在しないWalkを作成しない
+		if input.IfMatch != nil {
+			return nil, walk.ErrVersionMismatch
+		}
 		// 存在しない場合は新規作成
 		w = walk.NewWalk(userID, "", "")
 		w.ID = input.ID
 	} else if w.UserID != userID {
 		// 権限チェック（既存レコードの場合のみ）
-		return nil, fmt.Errorf("unauthorized")
+		return nil, walk.ErrNotOwner
+	} else if input.IfMatch != nil && w.Version != *input.IfMatch {
+		return nil, walk.ErrVersionMismatch
 	}
 
 	// フィールド更新
 	applyWalkInputFields(w, input)
 
+	// 適用後のドメイン不変条件を検証
+	if err := validateWalkInvariants(w).Err(); err != nil {
+		return nil, err
+	}
+
+	// Walk・タグ・位置情報は1つのトランザクションで保存し、途中で失敗した場合は何も反映しない
+	err = i.tx.WithinTx(ctx, func(ctx context.Context) error {
+		return i.saveUpdatedWalk(ctx, w, input)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// retryOnVersionConflict は fn が walk.ErrVersionMismatch を返した場合に fn を再実行する
+// ifMatch を指定した場合は再実行せず、競合をそのまま返す
+// 版数を指定しないクライアントには従来どおり後勝ちで更新するため、fn は毎回Walkを読み直すこと
+func (i *interactor) retryOnVersionConflict(ifMatch *int, fn func() error) error {
+	err := fn()
+	for attempt := 0; attempt < maxVersionConflictRetries && ifMatch == nil && errors.Is(err, walk.ErrVersionMismatch); attempt++ {
+		i.logger.Info("Walk version conflict, retrying", zap.Int("attempt", attempt+1))
+		err = fn()
+	}
+	return err
+}
+
+// saveUpdatedWalk は更新したWalkを保存し、指定されたタグと位置情報を反映する
+func (i *interactor) saveUpdatedWalk(ctx context.Context, w *walk.Walk, input UpdateWalkInput) error {
 	// Upsertで永続化
 	if err := i.walkRepo.Upsert(ctx, w); err != nil {
-		return nil, fmt.Errorf("failed to upsert walk: %w", err)
+		return fmt.Errorf("failed to upsert walk: %w", err)
+	}
+
+	// タグを置き換える（指定された場合のみ）
+	if input.Tags != nil {
+		if err := i.walkRepo.ReplaceTags(ctx, w); err != nil {
+			return fmt.Errorf("failed to save walk tags: %w", err)
+		}
 	}
 
 	// 位置情報を保存（存在する場合のみ）
 	if len(input.Locations) > 0 {
 		if err := i.locationRepo.BatchCreate(ctx, input.Locations); err != nil {
-			return nil, fmt.Errorf("failed to save walk locations: %w", err)
+			return fmt.Errorf("failed to save walk locations: %w", err)
+		}
+		w.RecordLocationsAppended(len(input.Locations))
+
+		// 保存済みの全位置情報から計測値とポリラインを再生成して反映
+		if err := i.refreshRoute(ctx, w); err != nil {
+			return err
+		}
+		if err := i.walkRepo.Update(ctx, w); err != nil {
+			return fmt.Errorf("failed to update walk metrics: %w", err)
 		}
 	}
 
-	return w, nil
+	return nil
 }
 
-// DeleteWalk はWalkを削除する
-func (i *interactor) DeleteWalk(ctx context.Context, id uuid.UUID, userID string) error {
-	// 権限チェック
-	w, err := i.GetWalk(ctx, id, userID)
+// refreshRoute は保存済みの位置情報から計測値とポリラインを再生成し、Walkに反映する
+// 距離・ポリラインともにクライアント報告値ではなくサーバー算出値を正とする
+func (i *interactor) refreshRoute(ctx context.Context, w *walk.Walk) error {
+	locations, err := i.locationRepo.FindByWalkID(ctx, w.ID)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to get walk locations: %w", err)
 	}
-	if w.UserID != userID {
-		return fmt.Errorf("unauthorized")
+
+	metrics := walk.CalculateRouteMetrics(locations, w.StartTime, w.EndTime, w.TotalPausedDuration)
+	w.ApplyRouteMetrics(metrics)
+
+	// 精度の悪い点を除外したうえで簡略化・エンコードする
+	accepted := walk.FilterByAccuracy(locations, walk.MaxHorizontalAccuracy)
+	if len(accepted) >= 2 {
+		encoded := encodeRoute(accepted, i.polylineTolerance)
+		w.PolylineData = &encoded
 	}
 
-	// 削除
-	if err := i.walkRepo.Delete(ctx, id); err != nil {
-		return fmt.Errorf("failed to delete walk: %w", err)
+	return nil
+}
+
+// encodeRoute は位置情報を簡略化したうえでポリラインにエンコードする
+// tolerance が0以下の場合は簡略化しない
+func encodeRoute(locations []*walk.WalkLocation, tolerance float64) string {
+	points := make([]polyline.Point, len(locations))
+	for idx, loc := range locations {
+		points[idx] = polyline.Point{Lat: loc.Latitude, Lng: loc.Longitude}
+	}
+	return polyline.Encode(polyline.Simplify(points, tolerance))
+}
+
+// DeleteWalk はWalkを削除する
+// 添付された写真の行はカスケード削除されるため、保存済みのファイルとサムネイル画像は散歩の削除後に削除する
+func (i *interactor) DeleteWalk(ctx context.Context, id uuid.UUID, userID string, ifMatch *int) error {
+	var w *walk.Walk
+	var photos []*photo.Photo
+	err := i.retryOnVersionConflict(ifMatch, func() error {
+		// 権限チェック
+		var err error
+		w, err = i.getOwnedWalk(ctx, id, userID)
+		if err != nil {
+			return err
+		}
+		if ifMatch != nil && w.Version != *ifMatch {
+			return walk.ErrVersionMismatch
+		}
+
+		photos, err = i.photos.ListWalkPhotos(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get walk photos: %w", err)
+		}
+
+		// 削除
+		w.MarkDeleted()
+		if err := i.walkRepo.Delete(ctx, w); err != nil {
+			return fmt.Errorf("failed to delete walk: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
+	i.photos.RemoveObjects(ctx, photos)
+	i.thumbnails.RemoveThumbnail(ctx, w)
 	return nil
 }
 
 // StartWalk は散歩を開始する
 func (i *interactor) StartWalk(ctx context.Context, id uuid.UUID, userID string) (*walk.Walk, error) {
-	w, err := i.GetWalk(ctx, id, userID)
+	return i.changeOwnedWalk(ctx, id, userID, func(w *walk.Walk) error {
+		if err := w.Start(); err != nil {
+			return fmt.Errorf("failed to start walk: %w", err)
+		}
+		return nil
+	})
+}
+
+// PauseWalk は散歩を一時停止する
+func (i *interactor) PauseWalk(ctx context.Context, id uuid.UUID, userID string) (*walk.Walk, error) {
+	return i.changeOwnedWalk(ctx, id, userID, func(w *walk.Walk) error {
+		if err := w.Pause(); err != nil {
+			return fmt.Errorf("failed to pause walk: %w", err)
+		}
+		return nil
+	})
+}
+
+// ResumeWalk は散歩を再開する
+func (i *interactor) ResumeWalk(ctx context.Context, id uuid.UUID, userID string) (*walk.Walk, error) {
+	return i.changeOwnedWalk(ctx, id, userID, func(w *walk.Walk) error {
+		if err := w.Resume(); err != nil {
+			return fmt.Errorf("failed to resume walk: %w", err)
+		}
+		return nil
+	})
+}
+
+// CompleteWalk は散歩を完了する
+func (i *interactor) CompleteWalk(ctx context.Context, id uuid.UUID, userID string) (*walk.Walk, error) {
+	return i.changeOwnedWalk(ctx, id, userID, func(w *walk.Walk) error {
+		if err := w.Complete(); err != nil {
+			return fmt.Errorf("failed to complete walk: %w", err)
+		}
+
+		// 終了時刻が確定したため計測値とポリラインを再生成
+		return i.refreshRoute(ctx, w)
+	})
+}
+
+// changeOwnedWalk は本人のWalkを読み込み、change を適用して保存する
+// 状態遷移は版数を指定しないため、版数の競合時は読み直して再適用する
+func (i *interactor) changeOwnedWalk(ctx context.Context, id uuid.UUID, userID string, change func(w *walk.Walk) error) (*walk.Walk, error) {
+	var w *walk.Walk
+	err := i.retryOnVersionConflict(nil, func() error {
+		var err error
+		w, err = i.getOwnedWalk(ctx, id, userID)
+		if err != nil {
+			return err
+		}
+
+		if err := change(w); err != nil {
+			return err
+		}
+
+		if err := i.walkRepo.Update(ctx, w); err != nil {
+			return fmt.Errorf("failed to update walk: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err := w.Start(); err != nil {
-		return nil, fmt.Errorf("failed to start walk: %w", err)
+	return w, nil
+}
+
+// ImportWalk はトラックファイルの経路から完了済みのWalkを作成する
+// 開始・終了時刻は最初と最後のトラックポイントの時刻とし、距離などの計測値はサーバー側で算出する
+func (i *interactor) ImportWalk(ctx context.Context, input ImportWalkInput) (*walk.Walk, error) {
+	if input.Track == nil || len(input.Track.Points) == 0 {
+		return nil, fmt.Errorf("import track has no points")
 	}
 
-	if err := i.walkRepo.Update(ctx, w); err != nil {
-		return nil, fmt.Errorf("failed to update walk: %w", err)
+	title := input.Title
+	if title == "" {
+		title = input.Track.Name
+	}
+	description := input.Description
+	if description == "" {
+		description = input.Track.Description
 	}
 
-	return w, nil
-}
+	w := walk.NewWalk(input.UserID, title, description)
 
-// PauseWalk は散歩を一時停止する
-func (i *interactor) PauseWalk(ctx context.Context, id uuid.UUID, userID string) (*walk.Walk, error) {
-	w, err := i.GetWalk(ctx, id, userID)
-	if err != nil {
+	// トラックポイントをファイル内の順序どおりに位置情報へ変換
+	locations := make([]*walk.WalkLocation, len(input.Track.Points))
+	for idx, p := range input.Track.Points {
+		locations[idx] = walk.NewWalkLocationWithOptionals(
+			w.ID, p.Lat, p.Lon, p.Ele, p.Time, nil, nil, p.Speed, p.Course, idx,
+		)
+	}
+
+	if err := validateImportWalkInput(title, description, locations).Err(); err != nil {
 		return nil, err
 	}
 
-	if err := w.Pause(); err != nil {
-		return nil, fmt.Errorf("failed to pause walk: %w", err)
+	start := locations[0].Timestamp
+	end := locations[len(locations)-1].Timestamp
+	w.StartTime = &start
+	w.EndTime = &end
+	w.ChangeStatus(walk.StatusCompleted)
+
+	if err := validateWalkInvariants(w).Err(); err != nil {
+		return nil, err
 	}
 
-	if err := i.walkRepo.Update(ctx, w); err != nil {
-		return nil, fmt.Errorf("failed to update walk: %w", err)
+	// 経路のない完了済みの散歩が残らないよう、Walkと位置情報は1つのトランザクションで保存する
+	err := i.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := i.walkRepo.Create(ctx, w); err != nil {
+			return fmt.Errorf("failed to create walk: %w", err)
+		}
+		return i.saveImportedRoute(ctx, w, locations)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return w, nil
 }
 
-// ResumeWalk は散歩を再開する
-func (i *interactor) ResumeWalk(ctx context.Context, id uuid.UUID, userID string) (*walk.Walk, error) {
-	w, err := i.GetWalk(ctx, id, userID)
-	if err != nil {
-		return nil, err
+// saveImportedRoute はインポートした位置情報を保存し、計測値とポリラインをWalkに反映する
+func (i *interactor) saveImportedRoute(ctx context.Context, w *walk.Walk, locations []*walk.WalkLocation) error {
+	if err := i.locationRepo.BatchCreate(ctx, locations); err != nil {
+		return fmt.Errorf("failed to save walk locations: %w", err)
 	}
+	w.RecordLocationsAppended(len(locations))
 
-	if err := w.Resume(); err != nil {
-		return nil, fmt.Errorf("failed to resume walk: %w", err)
+	if err := i.refreshRoute(ctx, w); err != nil {
+		return err
 	}
-
 	if err := i.walkRepo.Update(ctx, w); err != nil {
-		return nil, fmt.Errorf("failed to update walk: %w", err)
+		return fmt.Errorf("failed to update walk metrics: %w", err)
 	}
 
-	return w, nil
+	return nil
 }
 
-// CompleteWalk は散歩を完了する
-func (i *interactor) CompleteWalk(ctx context.Context, id uuid.UUID, userID string) (*walk.Walk, error) {
-	w, err := i.GetWalk(ctx, id, userID)
+// ExportWalk は散歩と位置情報をエクスポーターへ逐次書き出す
+// 位置情報はDBから1行ずつ読み出して渡すため、長い散歩でもメモリ使用量は一定
+func (i *interactor) ExportWalk(ctx context.Context, id uuid.UUID, userID string, exporter WalkExporter) error {
+	w, masker, err := i.getVisibleWalk(ctx, id, userID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := w.Complete(); err != nil {
-		return nil, fmt.Errorf("failed to complete walk: %w", err)
+	if err := exporter.WriteHeader(masker.MaskWalk(w)); err != nil {
+		return fmt.Errorf("failed to write export header: %w", err)
 	}
 
-	if err := i.walkRepo.Update(ctx, w); err != nil {
-		return nil, fmt.Errorf("failed to update walk: %w", err)
+	// プライバシーゾーン内の位置情報は書き出さない
+	writeLocation := func(loc *walk.WalkLocation) error {
+		if masker.Hides(loc.Latitude, loc.Longitude) {
+			return nil
+		}
+		return exporter.WriteLocation(loc)
+	}
+	if err := i.locationRepo.StreamByWalkID(ctx, id, writeLocation); err != nil {
+		return fmt.Errorf("failed to export walk locations: %w", err)
 	}
 
-	return w, nil
+	if err := exporter.Close(); err != nil {
+		return fmt.Errorf("failed to finish export: %w", err)
+	}
+
+	return nil
 }
@@ -0,0 +1,854 @@
+package walk
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/photo"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/privacy"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/infrastructure/logger"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/polyline"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/trackfile"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockWalkRepository はwalk.Repositoryのモック
+type MockWalkRepository struct {
+	mock.Mock
+}
+
+func (m *MockWalkRepository) Create(ctx context.Context, w *walk.Walk) error {
+	return m.Called(ctx, w).Error(0)
+}
+
+func (m *MockWalkRepository) FindByID(ctx context.Context, id uuid.UUID) (*walk.Walk, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walk.Walk), args.Error(1)
+}
+
+func (m *MockWalkRepository) FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*walk.Walk, error) {
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*walk.Walk), args.Error(1)
+}
+
+func (m *MockWalkRepository) FindByCriteria(ctx context.Context, criteria walk.ListCriteria) ([]*walk.Walk, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*walk.Walk), args.Error(1)
+}
+
+func (m *MockWalkRepository) Update(ctx context.Context, w *walk.Walk) error {
+	return m.Called(ctx, w).Error(0)
+}
+
+func (m *MockWalkRepository) Upsert(ctx context.Context, w *walk.Walk) error {
+	return m.Called(ctx, w).Error(0)
+}
+
+func (m *MockWalkRepository) UpdateThumbnailURL(ctx context.Context, id uuid.UUID, url string) error {
+	return m.Called(ctx, id, url).Error(0)
+}
+
+func (m *MockWalkRepository) ReplaceTags(ctx context.Context, w *walk.Walk) error {
+	return m.Called(ctx, w).Error(0)
+}
+
+func (m *MockWalkRepository) Delete(ctx context.Context, w *walk.Walk) error {
+	return m.Called(ctx, w).Error(0)
+}
+
+func (m *MockWalkRepository) Count(ctx context.Context, userID string) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockWalkRepository) CountByCriteria(ctx context.Context, userID string, filter walk.WalkFilter) (int, error) {
+	args := m.Called(ctx, userID, filter)
+	return args.Int(0), args.Error(1)
+}
+
+// MockLocationRepository はwalk.LocationRepositoryのモック
+type MockLocationRepository struct {
+	mock.Mock
+}
+
+func (m *MockLocationRepository) BatchCreate(ctx context.Context, locations []*walk.WalkLocation) error {
+	return m.Called(ctx, locations).Error(0)
+}
+
+func (m *MockLocationRepository) FindByWalkID(ctx context.Context, walkID uuid.UUID) ([]*walk.WalkLocation, error) {
+	args := m.Called(ctx, walkID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*walk.WalkLocation), args.Error(1)
+}
+
+func (m *MockLocationRepository) StreamByWalkID(ctx context.Context, walkID uuid.UUID, fn func(*walk.WalkLocation) error) error {
+	return m.Called(ctx, walkID, fn).Error(0)
+}
+
+func (m *MockLocationRepository) DeleteByWalkID(ctx context.Context, walkID uuid.UUID) error {
+	return m.Called(ctx, walkID).Error(0)
+}
+
+// MockRelationChecker はRelationCheckerのモック
+type MockRelationChecker struct {
+	mock.Mock
+}
+
+func (m *MockRelationChecker) IsBlocked(ctx context.Context, userID, otherID string) (bool, error) {
+	args := m.Called(ctx, userID, otherID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRelationChecker) AreFriends(ctx context.Context, userID, otherID string) (bool, error) {
+	args := m.Called(ctx, userID, otherID)
+	return args.Bool(0), args.Error(1)
+}
+
+// stubZoneLister は固定のプライバシーゾーンを返すZoneListerのスタブ
+type stubZoneLister struct {
+	zones []*privacy.Zone
+}
+
+func (s stubZoneLister) ListByUserID(ctx context.Context, userID string) ([]*privacy.Zone, error) {
+	return s.zones, nil
+}
+
+// stubPhotoAttacher は固定の写真を返し、ファイルを削除した写真を記録するPhotoAttacherのスタブ
+type stubPhotoAttacher struct {
+	photos  []*photo.Photo
+	removed []*photo.Photo
+}
+
+func (s *stubPhotoAttacher) ListWalkPhotos(ctx context.Context, walkID uuid.UUID) ([]*photo.Photo, error) {
+	return s.photos, nil
+}
+
+func (s *stubPhotoAttacher) RemoveObjects(ctx context.Context, photos []*photo.Photo) {
+	s.removed = append(s.removed, photos...)
+}
+
+// stubThumbnailRemover はサムネイル画像を削除した散歩を記録するThumbnailRemoverのスタブ
+type stubThumbnailRemover struct {
+	removed []uuid.UUID
+}
+
+func (s *stubThumbnailRemover) RemoveThumbnail(ctx context.Context, w *walk.Walk) {
+	s.removed = append(s.removed, w.ID)
+}
+
+// stubTxManager は fn をそのまま実行し、トランザクションの結果を記録するTxManagerのスタブ
+type stubTxManager struct {
+	calls int
+	err   error // 最後のトランザクションで fn が返したエラー（ロールバックされた場合は非nil）
+}
+
+func (s *stubTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	s.calls++
+	s.err = fn(ctx)
+	return s.err
+}
+
+// setupInteractor はモックリポジトリを使うテスト用のInteractorを生成する
+func setupInteractor() (*interactor, *MockWalkRepository, *MockLocationRepository) {
+	it, walkRepo, locationRepo, _ := setupInteractorWithRelations()
+	return it, walkRepo, locationRepo
+}
+
+// setupInteractorWithRelations は閲覧者との関係の判定もモックにしたテスト用のInteractorを生成する
+func setupInteractorWithRelations() (*interactor, *MockWalkRepository, *MockLocationRepository, *MockRelationChecker) {
+	walkRepo := new(MockWalkRepository)
+	locationRepo := new(MockLocationRepository)
+	relations := new(MockRelationChecker)
+	it := NewInteractor(walkRepo, locationRepo, &stubTxManager{}, relations, stubZoneLister{}, &stubPhotoAttacher{}, &stubThumbnailRemover{}, polyline.DefaultTolerance, logger.NewNopLogger()).(*interactor)
+	return it, walkRepo, locationRepo, relations
+}
+
+// eventNames は保存されていないドメインイベントの種類を発生順に返す
+func eventNames(w *walk.Walk) []string {
+	var names []string
+	for _, e := range w.PendingEvents() {
+		names = append(names, e.EventName())
+	}
+	return names
+}
+
+// newStraightRoute は赤道上を経度0.001度（約111.2m）ずつ10秒間隔で東へ進む位置情報を生成する
+func newStraightRoute(walkID uuid.UUID, start time.Time, points int) []*walk.WalkLocation {
+	locations := make([]*walk.WalkLocation, points)
+	for i := range locations {
+		locations[i] = walk.NewWalkLocationWithOptionals(
+			walkID, 0, float64(i)*0.001, nil, start.Add(time.Duration(i)*10*time.Second), nil, nil, nil, nil, i,
+		)
+	}
+	return locations
+}
+
+// newWalkInStatus は指定したステータスの開始済みのテスト用Walkを生成する
+func newWalkInStatus(status walk.WalkStatus, start time.Time) *walk.Walk {
+	w := walk.NewWalk("user-1", "Walk", "")
+	w.StartTime = &start
+	w.Status = status
+	// 保存済みのWalkとして扱うため、作成時のイベントは取り除く
+	w.ClearEvents()
+	return w
+}
+
+func TestEncodeRoute(t *testing.T) {
+	walkID := uuid.New()
+	now := time.Now()
+	locations := make([]*walk.WalkLocation, 0, 100)
+	for i := 0; i < 100; i++ {
+		// 直線上に約11m間隔で並ぶ位置情報
+		lng := 139.0 + float64(i)*0.0001
+		locations = append(locations, walk.NewWalkLocationWithOptionals(
+			walkID, 35.0, lng, nil, now.Add(time.Duration(i)*time.Second), nil, nil, nil, nil, i,
+		))
+	}
+
+	encoded := encodeRoute(locations, polyline.DefaultTolerance)
+
+	// 期待値: 直線経路は始点と終点の2点に簡略化される
+	decoded, err := polyline.Decode(encoded)
+	require.NoError(t, err)
+	require.Len(t, decoded, 2)
+	assert.InDelta(t, 139.0, decoded[0].Lng, 1e-5)
+	assert.InDelta(t, 139.0099, decoded[1].Lng, 1e-5)
+}
+
+func TestUpdateWalk_RefreshesRouteFromSavedLocations(t *testing.T) {
+	it, walkRepo, locationRepo := setupInteractor()
+	ctx := context.Background()
+
+	start := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+	existing := newWalkInStatus(walk.StatusInProgress, start)
+	// 前回までに保存済みの2点と、今回送信する2点
+	saved := newStraightRoute(existing.ID, start, 4)
+	clientDistance := 9999.0
+
+	walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+	walkRepo.On("Upsert", ctx, existing).Return(nil)
+	locationRepo.On("BatchCreate", ctx, saved[2:]).Return(nil)
+	locationRepo.On("FindByWalkID", ctx, existing.ID).Return(saved, nil)
+	walkRepo.On("Update", ctx, existing).Return(nil)
+
+	got, err := it.UpdateWalk(ctx, UpdateWalkInput{
+		ID:            existing.ID,
+		TotalDistance: &clientDistance,
+		Locations:     saved[2:],
+	}, "user-1")
+	require.NoError(t, err)
+
+	// 期待値: 距離はクライアント報告値ではなく保存済みの全位置情報から算出した値になる
+	assert.InDelta(t, 3*111.19, got.TotalDistance, 0.5)
+
+	// 期待値: ポリラインも保存済みの全位置情報から再生成される（直線のため始点と終点のみ）
+	require.NotNil(t, got.PolylineData)
+	decoded, err := polyline.Decode(*got.PolylineData)
+	require.NoError(t, err)
+	require.Len(t, decoded, 2)
+	assert.InDelta(t, 0.003, decoded[1].Lng, 1e-5)
+
+	// 期待値: 位置情報の追加を記録し、未完了のため完了は記録しない
+	assert.Contains(t, eventNames(existing), walk.EventLocationsAppended)
+	assert.NotContains(t, eventNames(existing), walk.EventWalkCompleted)
+	walkRepo.AssertExpectations(t)
+	locationRepo.AssertExpectations(t)
+}
+
+func TestUpdateWalk_RecordsCompletedEventOnce(t *testing.T) {
+	it, walkRepo, locationRepo := setupInteractor()
+	ctx := context.Background()
+
+	start := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+	end := start.Add(30 * time.Minute)
+	existing := newWalkInStatus(walk.StatusInProgress, start)
+	completed := walk.StatusCompleted
+	input := UpdateWalkInput{ID: existing.ID, Status: &completed, EndTime: &end}
+
+	walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+	walkRepo.On("Upsert", ctx, existing).Return(nil)
+	locationRepo.On("FindByWalkID", ctx, existing.ID).Return(newStraightRoute(existing.ID, start, 3), nil)
+
+	// 期待値: この更新で完了になった場合は完了イベントを記録する
+	_, err := it.UpdateWalk(ctx, input, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{walk.EventWalkCompleted}, eventNames(existing))
+
+	// 保存時にリポジトリがイベントを取り除いた状態を再現する
+	existing.ClearEvents()
+
+	// 期待値: 完了済みの散歩を再送しても二重には記録しない
+	_, err = it.UpdateWalk(ctx, input, "user-1")
+	require.NoError(t, err)
+	assert.Empty(t, eventNames(existing))
+}
+
+func TestUpdateWalk_RollsBackWhenLocationsFail(t *testing.T) {
+	it, walkRepo, locationRepo := setupInteractor()
+	ctx := context.Background()
+
+	start := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+	existing := newWalkInStatus(walk.StatusInProgress, start)
+	title := "Renamed"
+
+	walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+	walkRepo.On("Upsert", ctx, existing).Return(nil)
+	locationRepo.On("BatchCreate", ctx, mock.Anything).Return(assert.AnError)
+
+	_, err := it.UpdateWalk(ctx, UpdateWalkInput{
+		ID:        existing.ID,
+		Title:     &title,
+		Locations: newStraightRoute(existing.ID, start, 2),
+	}, "user-1")
+	require.ErrorIs(t, err, assert.AnError)
+
+	// 期待値: Walkの更新と位置情報の保存を1つのトランザクションで行い、失敗した場合はロールバックする
+	tx := it.tx.(*stubTxManager)
+	assert.Equal(t, 1, tx.calls)
+	assert.ErrorIs(t, tx.err, assert.AnError)
+	walkRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestUpdateWalk_NotOwner(t *testing.T) {
+	it, walkRepo, _ := setupInteractor()
+	ctx := context.Background()
+
+	existing := walk.NewWalk("other-user", "Walk", "")
+	walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+
+	// 期待値: 他のユーザーの散歩は更新できない
+	_, err := it.UpdateWalk(ctx, UpdateWalkInput{ID: existing.ID}, "user-1")
+	assert.ErrorIs(t, err, walk.ErrNotOwner)
+	walkRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+}
+
+func TestUpdateWalk_IfMatch(t *testing.T) {
+	ctx := context.Background()
+	title := "Renamed"
+
+	t.Run("版数が一致しない場合は更新しない", func(t *testing.T) {
+		it, walkRepo, _ := setupInteractor()
+		existing := walk.NewWalk("user-1", "Walk", "")
+		existing.Version = 2
+		walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+
+		version := 1
+		_, err := it.UpdateWalk(ctx, UpdateWalkInput{ID: existing.ID, Title: &title, IfMatch: &version}, "user-1")
+		assert.ErrorIs(t, err, walk.ErrVersionMismatch)
+		walkRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+	})
+
+	t.Run("存在しない散歩は作成しない", func(t *testing.T) {
+		it, walkRepo, _ := setupInteractor()
+		id := uuid.New()
+		walkRepo.On("FindByID", ctx, id).Return(nil, sql.ErrNoRows)
+
+		version := 1
+		_, err := it.UpdateWalk(ctx, UpdateWalkInput{ID: id, Title: &title, IfMatch: &version}, "user-1")
+		assert.ErrorIs(t, err, walk.ErrVersionMismatch)
+		walkRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+	})
+
+	t.Run("他のユーザーの散歩は版数によらず見つからない扱いにする", func(t *testing.T) {
+		it, walkRepo, _ := setupInteractor()
+		existing := walk.NewWalk("other-user", "Walk", "")
+		existing.Version = 2
+		walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+
+		version := 1
+		_, err := it.UpdateWalk(ctx, UpdateWalkInput{ID: existing.ID, Title: &title, IfMatch: &version}, "user-1")
+		assert.ErrorIs(t, err, walk.ErrNotOwner)
+	})
+
+	t.Run("読み込み後に更新された場合は再試行しない", func(t *testing.T) {
+		it, walkRepo, _ := setupInteractor()
+		existing := walk.NewWalk("user-1", "Walk", "")
+		existing.Version = 2
+		walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+		walkRepo.On("Upsert", ctx, existing).Return(walk.ErrVersionMismatch)
+
+		version := 2
+		_, err := it.UpdateWalk(ctx, UpdateWalkInput{ID: existing.ID, Title: &title, IfMatch: &version}, "user-1")
+		assert.ErrorIs(t, err, walk.ErrVersionMismatch)
+		walkRepo.AssertNumberOfCalls(t, "Upsert", 1)
+	})
+}
+
+func TestUpdateWalk_RetriesVersionConflict(t *testing.T) {
+	ctx := context.Background()
+	title := "Renamed"
+
+	t.Run("If-Matchがない場合は読み直して後勝ちで更新する", func(t *testing.T) {
+		it, walkRepo, _ := setupInteractor()
+		existing := walk.NewWalk("user-1", "Walk", "")
+		walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+		walkRepo.On("Upsert", ctx, existing).Return(walk.ErrVersionMismatch).Once()
+		walkRepo.On("Upsert", ctx, existing).Return(nil).Once()
+
+		got, err := it.UpdateWalk(ctx, UpdateWalkInput{ID: existing.ID, Title: &title}, "user-1")
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", got.Title)
+		walkRepo.AssertNumberOfCalls(t, "FindByID", 2)
+	})
+
+	t.Run("競合が続く場合は上限回数で諦める", func(t *testing.T) {
+		it, walkRepo, _ := setupInteractor()
+		existing := walk.NewWalk("user-1", "Walk", "")
+		walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+		walkRepo.On("Upsert", ctx, existing).Return(walk.ErrVersionMismatch)
+
+		_, err := it.UpdateWalk(ctx, UpdateWalkInput{ID: existing.ID, Title: &title}, "user-1")
+		assert.ErrorIs(t, err, walk.ErrVersionMismatch)
+		walkRepo.AssertNumberOfCalls(t, "Upsert", maxVersionConflictRetries+1)
+	})
+}
+
+func TestStartWalk_RetriesVersionConflict(t *testing.T) {
+	ctx := context.Background()
+	it, walkRepo, _ := setupInteractor()
+
+	stale := walk.NewWalk("user-1", "Walk", "")
+	reloaded := walk.NewWalk("user-1", "Walk", "")
+	reloaded.ID = stale.ID
+	walkRepo.On("FindByID", ctx, stale.ID).Return(stale, nil).Once()
+	walkRepo.On("FindByID", ctx, stale.ID).Return(reloaded, nil).Once()
+	walkRepo.On("Update", ctx, stale).Return(walk.ErrVersionMismatch)
+	walkRepo.On("Update", ctx, reloaded).Return(nil)
+
+	// 期待値: 状態遷移は読み直したWalkに再適用する
+	got, err := it.StartWalk(ctx, stale.ID, "user-1")
+	require.NoError(t, err)
+	assert.Same(t, reloaded, got)
+	assert.Equal(t, walk.StatusInProgress, got.Status)
+	walkRepo.AssertExpectations(t)
+}
+
+func TestUpdateWalk_Visibility(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("changes visibility", func(t *testing.T) {
+		it, walkRepo, _ := setupInteractor()
+		existing := walk.NewWalk("user-1", "Walk", "")
+		walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+		walkRepo.On("Upsert", ctx, existing).Return(nil)
+
+		visibility := walk.VisibilityFriends
+		got, err := it.UpdateWalk(ctx, UpdateWalkInput{ID: existing.ID, Visibility: &visibility}, "user-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, walk.VisibilityFriends, got.Visibility)
+	})
+
+	t.Run("new walk defaults to private", func(t *testing.T) {
+		it, walkRepo, _ := setupInteractor()
+		id := uuid.New()
+		walkRepo.On("FindByID", ctx, id).Return(nil, sql.ErrNoRows)
+		walkRepo.On("Upsert", ctx, mock.Anything).Return(nil)
+
+		got, err := it.UpdateWalk(ctx, UpdateWalkInput{ID: id}, "user-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, walk.VisibilityPrivate, got.Visibility)
+	})
+
+	t.Run("invalid visibility", func(t *testing.T) {
+		it, walkRepo, _ := setupInteractor()
+
+		visibility := walk.Visibility("public")
+		_, err := it.UpdateWalk(ctx, UpdateWalkInput{ID: uuid.New(), Visibility: &visibility}, "user-1")
+
+		// 期待値: visibilityフィールドのバリデーションエラーで、保存しない
+		var errs validator.ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		assert.Equal(t, "visibility", errs[0].Field)
+		walkRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetWalk_Visibility(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		visibility walk.Visibility
+		setup      func(relations *MockRelationChecker)
+		wantErr    bool
+	}{
+		{
+			name:       "private walk is hidden from others",
+			visibility: walk.VisibilityPrivate,
+			wantErr:    true,
+		},
+		{
+			name:       "friends walk is visible to friends",
+			visibility: walk.VisibilityFriends,
+			setup: func(relations *MockRelationChecker) {
+				relations.On("IsBlocked", ctx, "owner", "viewer").Return(false, nil)
+				relations.On("AreFriends", ctx, "owner", "viewer").Return(true, nil)
+			},
+		},
+		{
+			name:       "friends walk is hidden from non-friends",
+			visibility: walk.VisibilityFriends,
+			setup: func(relations *MockRelationChecker) {
+				relations.On("IsBlocked", ctx, "owner", "viewer").Return(false, nil)
+				relations.On("AreFriends", ctx, "owner", "viewer").Return(false, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:       "public link walk is visible to others",
+			visibility: walk.VisibilityPublicLink,
+			setup: func(relations *MockRelationChecker) {
+				relations.On("IsBlocked", ctx, "owner", "viewer").Return(false, nil)
+			},
+		},
+		{
+			name:       "public link walk is hidden from blocked users",
+			visibility: walk.VisibilityPublicLink,
+			setup: func(relations *MockRelationChecker) {
+				relations.On("IsBlocked", ctx, "owner", "viewer").Return(true, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it, walkRepo, _, relations := setupInteractorWithRelations()
+			existing := walk.NewWalk("owner", "Walk", "")
+			existing.Visibility = tt.visibility
+			walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+			if tt.setup != nil {
+				tt.setup(relations)
+			}
+
+			got, err := it.GetWalk(ctx, existing.ID, "viewer")
+
+			if tt.wantErr {
+				// 期待値: 閲覧できない散歩は存在しない場合と同じ扱い
+				assert.ErrorIs(t, err, walk.ErrNotOwner)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, existing.ID, got.ID)
+			relations.AssertExpectations(t)
+		})
+	}
+}
+
+func TestStartWalk_PublicWalkIsOwnerOnly(t *testing.T) {
+	it, walkRepo, _, relations := setupInteractorWithRelations()
+	ctx := context.Background()
+
+	existing := walk.NewWalk("owner", "Walk", "")
+	existing.Visibility = walk.VisibilityPublicLink
+	walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+
+	// 期待値: 閲覧できる散歩でも本人以外は操作できない
+	_, err := it.StartWalk(ctx, existing.ID, "viewer")
+	assert.ErrorIs(t, err, walk.ErrNotOwner)
+	relations.AssertNotCalled(t, "IsBlocked", mock.Anything, mock.Anything, mock.Anything)
+	walkRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestUpdateWalk_Tags(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("replaces tags when specified", func(t *testing.T) {
+		it, walkRepo, _ := setupInteractor()
+		existing := walk.NewWalk("user-1", "Walk", "")
+		walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+		walkRepo.On("Upsert", ctx, existing).Return(nil)
+		walkRepo.On("ReplaceTags", ctx, existing).Return(nil)
+
+		tags := []string{" Kyoto ", "kyoto", "morning"}
+		got, err := it.UpdateWalk(ctx, UpdateWalkInput{ID: existing.ID, Tags: &tags}, "user-1")
+
+		// 期待値: 正規化したタグ名で置き換える
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Kyoto", "morning"}, got.Tags)
+		walkRepo.AssertExpectations(t)
+	})
+
+	t.Run("keeps tags when omitted", func(t *testing.T) {
+		it, walkRepo, _ := setupInteractor()
+		existing := walk.NewWalk("user-1", "Walk", "")
+		walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+		walkRepo.On("Upsert", ctx, existing).Return(nil)
+
+		title := "Renamed"
+		_, err := it.UpdateWalk(ctx, UpdateWalkInput{ID: existing.ID, Title: &title}, "user-1")
+
+		// 期待値: タグを指定しない更新ではタグを変更しない
+		require.NoError(t, err)
+		walkRepo.AssertNotCalled(t, "ReplaceTags", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid tag", func(t *testing.T) {
+		it, walkRepo, _ := setupInteractor()
+
+		tags := []string{""}
+		_, err := it.UpdateWalk(ctx, UpdateWalkInput{ID: uuid.New(), Tags: &tags}, "user-1")
+
+		// 期待値: tagsフィールドのバリデーションエラーで、保存しない
+		var errs validator.ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		assert.Equal(t, "tags", errs[0].Field)
+		walkRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCompleteWalk_RecordsCompletedEvent(t *testing.T) {
+	it, walkRepo, locationRepo := setupInteractor()
+	ctx := context.Background()
+
+	start := time.Now().Add(-time.Hour)
+	existing := newWalkInStatus(walk.StatusInProgress, start)
+
+	walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+	locationRepo.On("FindByWalkID", ctx, existing.ID).Return(newStraightRoute(existing.ID, start, 3), nil)
+	walkRepo.On("Update", ctx, existing).Return(nil)
+
+	// 期待値: 完了イベントを記録したWalkをリポジトリに渡す
+	got, err := it.CompleteWalk(ctx, existing.ID, "user-1")
+	require.NoError(t, err)
+	assert.True(t, got.IsCompleted())
+	assert.Equal(t, []string{walk.EventWalkCompleted}, eventNames(existing))
+	walkRepo.AssertExpectations(t)
+}
+
+func TestListWalksByCursor(t *testing.T) {
+	ctx := context.Background()
+
+	base := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+	walks := make([]*walk.Walk, 3)
+	for i := range walks {
+		walks[i] = walk.NewWalk("user-1", "Walk", "")
+		walks[i].CreatedAt = base.Add(-time.Duration(i) * time.Hour)
+	}
+
+	t.Run("次のページがある場合", func(t *testing.T) {
+		it, walkRepo, _ := setupInteractor()
+
+		// 期待値: 次ページの有無を判定するためlimit+1件を取得する
+		walkRepo.On("FindByCriteria", ctx, mock.MatchedBy(func(c walk.ListCriteria) bool {
+			return c.Limit == 3 && c.After == nil
+		})).Return(walks, nil)
+
+		page, err := it.ListWalksByCursor(ctx, ListWalksByCursorInput{UserID: "user-1", Limit: 2})
+		require.NoError(t, err)
+
+		// 期待値: 余剰分を切り捨て、最後の散歩の位置を次のカーソルにする
+		require.Len(t, page.Walks, 2)
+		require.NotNil(t, page.NextCursor)
+		assert.Equal(t, walk.CursorOf(walks[1]), *page.NextCursor)
+		// 期待値: IncludeTotalがfalseの場合は総件数を数えない
+		assert.Nil(t, page.TotalCount)
+		walkRepo.AssertNotCalled(t, "CountByCriteria", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("最後のページの場合", func(t *testing.T) {
+		it, walkRepo, _ := setupInteractor()
+
+		cursor := walk.CursorOf(walks[0])
+		walkRepo.On("FindByCriteria", ctx, mock.MatchedBy(func(c walk.ListCriteria) bool {
+			return c.Limit == 3 && c.After != nil && *c.After == cursor
+		})).Return(walks[1:], nil)
+		walkRepo.On("CountByCriteria", ctx, "user-1", walk.WalkFilter{}).Return(3, nil)
+
+		page, err := it.ListWalksByCursor(ctx, ListWalksByCursorInput{
+			UserID: "user-1", Cursor: &cursor, Limit: 2, IncludeTotal: true,
+		})
+		require.NoError(t, err)
+
+		// 期待値: limit件以下の場合は次のカーソルを返さない
+		assert.Len(t, page.Walks, 2)
+		assert.Nil(t, page.NextCursor)
+		require.NotNil(t, page.TotalCount)
+		assert.Equal(t, 3, *page.TotalCount)
+	})
+}
+
+func TestImportWalk(t *testing.T) {
+	it, walkRepo, locationRepo := setupInteractor()
+	ctx := context.Background()
+
+	start := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+	track := &trackfile.Track{Name: "Morning Walk"}
+	for i := 0; i < 3; i++ {
+		track.Points = append(track.Points, trackfile.Point{Lat: 0, Lon: float64(i) * 0.001, Time: start.Add(time.Duration(i) * time.Minute)})
+	}
+
+	var created *walk.Walk
+	walkRepo.On("Create", ctx, mock.AnythingOfType("*walk.Walk")).
+		Run(func(args mock.Arguments) { created = args.Get(1).(*walk.Walk) }).
+		Return(nil)
+	locationRepo.On("BatchCreate", ctx, mock.AnythingOfType("[]*walk.WalkLocation")).Return(nil)
+	locationRepo.On("FindByWalkID", ctx, mock.AnythingOfType("uuid.UUID")).Return(newStraightRoute(uuid.Nil, start, 3), nil)
+	walkRepo.On("Update", ctx, mock.AnythingOfType("*walk.Walk")).Return(nil)
+
+	got, err := it.ImportWalk(ctx, ImportWalkInput{UserID: "user-1", Track: track})
+	require.NoError(t, err)
+
+	// 期待値: 最初と最後のトラックポイントの時刻で完了済みの散歩になる
+	assert.Same(t, created, got)
+	assert.Equal(t, "Morning Walk", got.Title)
+	assert.True(t, got.IsCompleted())
+	assert.Equal(t, start, *got.StartTime)
+	assert.Equal(t, start.Add(2*time.Minute), *got.EndTime)
+	assert.InDelta(t, 2*111.19, got.TotalDistance, 0.5)
+	require.NotNil(t, got.PolylineData)
+
+	// 期待値: トラックポイントをファイル内の順序どおりに保存する
+	saved := locationRepo.Calls[0].Arguments.Get(1).([]*walk.WalkLocation)
+	require.Len(t, saved, 3)
+	for i, loc := range saved {
+		assert.Equal(t, got.ID, loc.WalkID)
+		assert.Equal(t, i, loc.SequenceNumber)
+	}
+
+	// 期待値: 作成・完了・位置情報の追加を発生順に記録する
+	assert.Equal(t, []string{walk.EventWalkCreated, walk.EventWalkCompleted, walk.EventLocationsAppended}, eventNames(got))
+}
+
+func TestImportWalk_RollsBackWhenLocationsFail(t *testing.T) {
+	it, walkRepo, locationRepo := setupInteractor()
+	ctx := context.Background()
+
+	track := &trackfile.Track{Points: []trackfile.Point{
+		{Lat: 0, Lon: 0, Time: time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)},
+		{Lat: 0, Lon: 0.001, Time: time.Date(2025, 1, 15, 9, 1, 0, 0, time.UTC)},
+	}}
+
+	walkRepo.On("Create", ctx, mock.AnythingOfType("*walk.Walk")).Return(nil)
+	locationRepo.On("BatchCreate", ctx, mock.Anything).Return(assert.AnError)
+
+	// 期待値: 位置情報の保存に失敗した場合は、作成したWalkごとトランザクションをロールバックしてエラーを返す
+	_, err := it.ImportWalk(ctx, ImportWalkInput{UserID: "user-1", Track: track})
+	require.ErrorIs(t, err, assert.AnError)
+
+	tx := it.tx.(*stubTxManager)
+	assert.Equal(t, 1, tx.calls)
+	assert.ErrorIs(t, tx.err, assert.AnError)
+	walkRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestGetWalkWithLocations_PrivacyZones(t *testing.T) {
+	ctx := context.Background()
+	start := time.Now()
+
+	newPublicWalk := func() (*walk.Walk, []*walk.WalkLocation) {
+		w := walk.NewWalk("owner", "Walk", "")
+		w.Visibility = walk.VisibilityPublicLink
+		locations := newStraightRoute(w.ID, start, 5)
+		route := encodeRoute(locations, 0)
+		w.PolylineData = &route
+		return w, locations
+	}
+	// 出発地点（経度0）を中心とする半径150mのゾーン
+	home := &privacy.Zone{UserID: "owner", Latitude: 0, Longitude: 0, RadiusMeters: 150}
+
+	t.Run("masks points for other users", func(t *testing.T) {
+		it, walkRepo, locationRepo, relations := setupInteractorWithRelations()
+		it.zones = stubZoneLister{zones: []*privacy.Zone{home}}
+		existing, locations := newPublicWalk()
+		walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+		locationRepo.On("FindByWalkID", ctx, existing.ID).Return(locations, nil)
+		relations.On("IsBlocked", ctx, "owner", "viewer").Return(false, nil)
+
+		got, err := it.GetWalkWithLocations(ctx, existing.ID, "viewer")
+
+		// 期待値: ゾーン内の2点を除いた位置情報とポリラインを返す
+		require.NoError(t, err)
+		require.Len(t, got.Locations, 3)
+		assert.Equal(t, 2, got.Locations[0].SequenceNumber)
+		require.NotNil(t, got.Walk.PolylineData)
+		assert.Equal(t, encodeRoute(locations[2:], 0), *got.Walk.PolylineData)
+		// 期待値: 保存済みのWalkは変更しない
+		assert.Equal(t, encodeRoute(locations, 0), *existing.PolylineData)
+	})
+
+	t.Run("owner sees full data", func(t *testing.T) {
+		it, walkRepo, locationRepo, _ := setupInteractorWithRelations()
+		it.zones = stubZoneLister{zones: []*privacy.Zone{home}}
+		existing, locations := newPublicWalk()
+		existing.UserID = "viewer"
+		walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+		locationRepo.On("FindByWalkID", ctx, existing.ID).Return(locations, nil)
+
+		got, err := it.GetWalkWithLocations(ctx, existing.ID, "viewer")
+
+		require.NoError(t, err)
+		assert.Len(t, got.Locations, 5)
+		assert.Equal(t, *existing.PolylineData, *got.Walk.PolylineData)
+	})
+}
+
+func TestDeleteWalk_RemovesPhotoObjects(t *testing.T) {
+	ctx := context.Background()
+	it, walkRepo, _ := setupInteractor()
+	existing := walk.NewWalk("user-1", "Walk", "")
+	attached := []*photo.Photo{photo.NewPhoto(existing.ID, "user-1", "image/jpeg", nil, nil, nil)}
+	photos := &stubPhotoAttacher{photos: attached}
+	it.photos = photos
+	walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+	walkRepo.On("Delete", ctx, existing).Return(nil)
+
+	require.NoError(t, it.DeleteWalk(ctx, existing.ID, "user-1", nil))
+	assert.Contains(t, eventNames(existing), walk.EventWalkDeleted)
+
+	// 期待値: 散歩の削除後に写真のファイルとサムネイル画像も削除する
+	assert.Equal(t, attached, photos.removed)
+	assert.Equal(t, []uuid.UUID{existing.ID}, it.thumbnails.(*stubThumbnailRemover).removed)
+	walkRepo.AssertExpectations(t)
+}
+
+func TestDeleteWalk_KeepsPhotoObjectsWhenDeleteFails(t *testing.T) {
+	ctx := context.Background()
+	it, walkRepo, _ := setupInteractor()
+	existing := walk.NewWalk("user-1", "Walk", "")
+	photos := &stubPhotoAttacher{photos: []*photo.Photo{photo.NewPhoto(existing.ID, "user-1", "image/jpeg", nil, nil, nil)}}
+	it.photos = photos
+	walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+	walkRepo.On("Delete", ctx, existing).Return(sql.ErrConnDone)
+
+	// 期待値: 散歩が残っている場合は写真のファイルとサムネイル画像を削除しない
+	require.Error(t, it.DeleteWalk(ctx, existing.ID, "user-1", nil))
+	assert.Empty(t, photos.removed)
+	assert.Empty(t, it.thumbnails.(*stubThumbnailRemover).removed)
+}
+
+func TestDeleteWalk_IfMatch(t *testing.T) {
+	ctx := context.Background()
+	it, walkRepo, _ := setupInteractor()
+	existing := walk.NewWalk("user-1", "Walk", "")
+	existing.Version = 3
+	photos := &stubPhotoAttacher{photos: []*photo.Photo{photo.NewPhoto(existing.ID, "user-1", "image/jpeg", nil, nil, nil)}}
+	it.photos = photos
+	walkRepo.On("FindByID", ctx, existing.ID).Return(existing, nil)
+
+	// 期待値: 版数が一致しない場合は削除しない
+	version := 2
+	assert.ErrorIs(t, it.DeleteWalk(ctx, existing.ID, "user-1", &version), walk.ErrVersionMismatch)
+	walkRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	assert.Empty(t, photos.removed)
+}
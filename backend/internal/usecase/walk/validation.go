@@ -0,0 +1,145 @@
+package walk
+
+import (
+	"fmt"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/tag"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/validator"
+)
+
+const (
+	// maxTitleLength はタイトルの最大文字数（walks.title VARCHAR(255)）
+	maxTitleLength = 255
+	// maxDescriptionLength は説明の最大文字数
+	// walks.description はTEXT型のため、OpenAPI（WalkCreate・WalkUpdate）で公開している上限をAPIの契約として検証する
+	maxDescriptionLength = 1000
+	// maxThumbnailURLLength はサムネイルURLの最大文字数（walks.thumbnail_image_url VARCHAR(500)）
+	maxThumbnailURLLength = 500
+)
+
+// validateUpdateWalkInput はUpdateWalkInputの各フィールドを検証する
+// 不正なフィールドはすべて収集して validator.ValidationErrors として返す
+func validateUpdateWalkInput(input UpdateWalkInput) validator.ValidationErrors {
+	var errs validator.ValidationErrors
+
+	if input.Title != nil {
+		errs.Add(validator.ValidateMaxLength("title", *input.Title, maxTitleLength))
+	}
+	if input.Description != nil {
+		errs.Add(validator.ValidateMaxLength("description", *input.Description, maxDescriptionLength))
+	}
+	if input.Status != nil && !input.Status.IsValid() {
+		errs.AddField("status", fmt.Sprintf("status must be one of %s, %s, %s, %s",
+			walk.StatusNotStarted, walk.StatusInProgress, walk.StatusPaused, walk.StatusCompleted))
+	}
+	if input.Visibility != nil && !input.Visibility.IsValid() {
+		errs.AddField("visibility", fmt.Sprintf("visibility must be one of %s, %s, %s",
+			walk.VisibilityPrivate, walk.VisibilityFriends, walk.VisibilityPublicLink))
+	}
+	if input.TotalSteps != nil {
+		errs.Add(validator.ValidateNonNegative("total_steps", float64(*input.TotalSteps)))
+	}
+	if input.TotalDistance != nil {
+		errs.Add(validator.ValidateNonNegative("total_distance", *input.TotalDistance))
+	}
+	if input.TotalPausedDuration != nil {
+		errs.Add(validator.ValidateNonNegative("total_paused_duration", *input.TotalPausedDuration))
+	}
+	if input.ThumbnailImageURL != nil {
+		errs.Add(validator.ValidateMaxLength("thumbnail_image_url", *input.ThumbnailImageURL, maxThumbnailURLLength))
+	}
+
+	if input.Tags != nil {
+		if _, err := tag.NormalizeNames(*input.Tags); err != nil {
+			errs.AddField("tags", err.Error())
+		}
+	}
+
+	for i, loc := range input.Locations {
+		if err := loc.Validate(); err != nil {
+			errs.AddField(fmt.Sprintf("locations[%d]", i), err.Error())
+		}
+	}
+
+	return errs
+}
+
+// validateImportWalkInput はImportWalkInputのタイトル・説明と各トラックポイントを検証する
+func validateImportWalkInput(title, description string, locations []*walk.WalkLocation) validator.ValidationErrors {
+	var errs validator.ValidationErrors
+
+	errs.Add(validator.ValidateMaxLength("title", title, maxTitleLength))
+	errs.Add(validator.ValidateMaxLength("description", description, maxDescriptionLength))
+
+	for i, loc := range locations {
+		if err := loc.Validate(); err != nil {
+			errs.AddField(fmt.Sprintf("points[%d]", i), err.Error())
+		}
+	}
+
+	return errs
+}
+
+// validateWalkInvariants は入力適用後のWalkがドメイン不変条件を満たすかを検証する
+// DBの chk_walk_times 制約に到達する前に、フィールド単位のエラーとして返す
+func validateWalkInvariants(w *walk.Walk) validator.ValidationErrors {
+	var errs validator.ValidationErrors
+
+	if w.EndTime != nil {
+		if w.StartTime == nil {
+			errs.AddField("end_time", "end_time requires start_time")
+		} else if w.EndTime.Before(*w.StartTime) {
+			errs.AddField("end_time", "end_time must be after start_time")
+		}
+	}
+	if w.PausedAt != nil {
+		if w.StartTime == nil {
+			errs.AddField("paused_at", "paused_at requires start_time")
+		} else if w.PausedAt.Before(*w.StartTime) {
+			errs.AddField("paused_at", "paused_at must be after start_time")
+		}
+	}
+
+	return errs
+}
+
+// validateListQuery は散歩一覧の絞り込み・並び替え条件を検証する
+// cursor がtrueの場合、キーセットページネーションが対応する作成日時順以外の並び替えをエラーにする
+func validateListQuery(query ListQuery, cursor bool) validator.ValidationErrors {
+	var errs validator.ValidationErrors
+
+	filter := query.Filter
+	for _, status := range filter.Statuses {
+		if !status.IsValid() {
+			errs.AddField("status", fmt.Sprintf("status must be one of %s, %s, %s, %s",
+				walk.StatusNotStarted, walk.StatusInProgress, walk.StatusPaused, walk.StatusCompleted))
+			break
+		}
+	}
+	if filter.StartFrom != nil && filter.StartTo != nil && !filter.StartFrom.Before(*filter.StartTo) {
+		errs.AddField("start_to", "start_to must be after start_from")
+	}
+	if filter.MinDistance != nil {
+		errs.Add(validator.ValidateNonNegative("min_distance", *filter.MinDistance))
+	}
+	if filter.MaxDistance != nil {
+		errs.Add(validator.ValidateNonNegative("max_distance", *filter.MaxDistance))
+	}
+	if filter.MinDistance != nil && filter.MaxDistance != nil && *filter.MinDistance > *filter.MaxDistance {
+		errs.AddField("max_distance", "max_distance must be greater than or equal to min_distance")
+	}
+
+	sort := query.Sort.Normalize()
+	if !sort.Field.IsValid() {
+		errs.AddField("sort", fmt.Sprintf("sort must be one of %s, %s, %s, %s",
+			walk.SortByCreatedAt, walk.SortByStartTime, walk.SortByDistance, walk.SortByDuration))
+	} else if cursor && sort.Field != walk.SortByCreatedAt {
+		errs.AddField("sort", fmt.Sprintf("cursor pagination supports only sort=%s", walk.SortByCreatedAt))
+	}
+	if !sort.Order.IsValid() {
+		errs.AddField("order", fmt.Sprintf("order must be %s or %s", walk.SortAsc, walk.SortDesc))
+	}
+
+	return errs
+}
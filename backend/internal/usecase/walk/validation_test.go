@@ -0,0 +1,251 @@
+package walk
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUpdateWalkInput(t *testing.T) {
+	validStatus := walk.StatusCompleted
+	invalidStatus := walk.WalkStatus("running")
+	negativeSteps := -1
+	negativeDistance := -0.5
+	negativePaused := -10.0
+	longTitle := strings.Repeat("a", maxTitleLength+1)
+	multibyteTitle := strings.Repeat("歩", maxTitleLength)
+	longMultibyteTitle := strings.Repeat("歩", maxTitleLength+1)
+	multibyteDescription := strings.Repeat("道", maxDescriptionLength)
+
+	tests := []struct {
+		name       string
+		input      UpdateWalkInput
+		wantFields []string
+	}{
+		{
+			// 期待値: 妥当な入力ではエラーなし
+			name:       "valid input",
+			input:      UpdateWalkInput{Status: &validStatus},
+			wantFields: nil,
+		},
+		{
+			// 期待値: 未定義のステータスはstatusフィールドのエラー
+			name:       "invalid status",
+			input:      UpdateWalkInput{Status: &invalidStatus},
+			wantFields: []string{"status"},
+		},
+		{
+			// 期待値: 負の値はフィールドごとにすべて報告される
+			name: "negative values",
+			input: UpdateWalkInput{
+				TotalSteps:          &negativeSteps,
+				TotalDistance:       &negativeDistance,
+				TotalPausedDuration: &negativePaused,
+			},
+			wantFields: []string{"total_steps", "total_distance", "total_paused_duration"},
+		},
+		{
+			// 期待値: 最大長を超えるタイトルはtitleフィールドのエラー
+			name:       "title too long",
+			input:      UpdateWalkInput{Title: &longTitle},
+			wantFields: []string{"title"},
+		},
+		{
+			// 期待値: 最大長はバイト数ではなく文字数で数える（VARCHAR(255)と同じ）
+			name:       "multibyte title at limit",
+			input:      UpdateWalkInput{Title: &multibyteTitle, Description: &multibyteDescription},
+			wantFields: nil,
+		},
+		{
+			// 期待値: 文字数が最大長を超える場合はtitleフィールドのエラー
+			name:       "multibyte title too long",
+			input:      UpdateWalkInput{Title: &longMultibyteTitle},
+			wantFields: []string{"title"},
+		},
+		{
+			// 期待値: 不正な位置情報はインデックス付きフィールドのエラー
+			name: "invalid location",
+			input: UpdateWalkInput{
+				Locations: []*walk.WalkLocation{
+					walk.NewWalkLocationWithOptionals(uuid.New(), 35.0, 139.0, nil, time.Now(), nil, nil, nil, nil, 0),
+					walk.NewWalkLocationWithOptionals(uuid.New(), 91.0, 139.0, nil, time.Now(), nil, nil, nil, nil, 1),
+				},
+			},
+			wantFields: []string{"locations[1]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateUpdateWalkInput(tt.input)
+
+			fields := make([]string, 0, len(errs))
+			for _, e := range errs {
+				fields = append(fields, e.Field)
+			}
+			assert.ElementsMatch(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func TestValidateWalkInvariants(t *testing.T) {
+	start := time.Now().Add(-time.Hour)
+	beforeStart := start.Add(-time.Minute)
+	afterStart := start.Add(time.Minute)
+
+	tests := []struct {
+		name       string
+		setup      func(w *walk.Walk)
+		wantFields []string
+	}{
+		{
+			// 期待値: 開始・終了時刻が正しい順序ならエラーなし
+			name: "valid times",
+			setup: func(w *walk.Walk) {
+				w.StartTime = &start
+				w.EndTime = &afterStart
+			},
+			wantFields: nil,
+		},
+		{
+			// 期待値: 終了時刻が開始時刻より前ならend_timeのエラー
+			name: "end before start",
+			setup: func(w *walk.Walk) {
+				w.StartTime = &start
+				w.EndTime = &beforeStart
+			},
+			wantFields: []string{"end_time"},
+		},
+		{
+			// 期待値: 開始時刻なしで終了時刻のみ設定されていればend_timeのエラー
+			name: "end without start",
+			setup: func(w *walk.Walk) {
+				w.EndTime = &afterStart
+			},
+			wantFields: []string{"end_time"},
+		},
+		{
+			// 期待値: 一時停止時刻が開始時刻より前ならpaused_atのエラー
+			name: "paused before start",
+			setup: func(w *walk.Walk) {
+				w.StartTime = &start
+				w.PausedAt = &beforeStart
+			},
+			wantFields: []string{"paused_at"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := walk.NewWalk("user-123", "Test", "")
+			tt.setup(w)
+
+			errs := validateWalkInvariants(w)
+
+			fields := make([]string, 0, len(errs))
+			for _, e := range errs {
+				fields = append(fields, e.Field)
+			}
+			assert.ElementsMatch(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func TestValidateImportWalkInput(t *testing.T) {
+	walkID := uuid.New()
+	now := time.Now()
+
+	// 期待値: 妥当な入力ではエラーなし
+	errs := validateImportWalkInput("朝の散歩", "", []*walk.WalkLocation{
+		walk.NewWalkLocationWithOptionals(walkID, 35.0, 139.0, nil, now, nil, nil, nil, nil, 0),
+	})
+	assert.Empty(t, errs)
+
+	// 期待値: 長すぎるタイトルと範囲外の座標はすべて報告される
+	errs = validateImportWalkInput(strings.Repeat("a", maxTitleLength+1), "", []*walk.WalkLocation{
+		walk.NewWalkLocationWithOptionals(walkID, 35.0, 139.0, nil, now, nil, nil, nil, nil, 0),
+		walk.NewWalkLocationWithOptionals(walkID, 35.0, 181.0, nil, now, nil, nil, nil, nil, 1),
+	})
+	fields := make([]string, 0, len(errs))
+	for _, e := range errs {
+		fields = append(fields, e.Field)
+	}
+	assert.ElementsMatch(t, []string{"title", "points[1]"}, fields)
+}
+
+func TestValidateListQuery(t *testing.T) {
+	from := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	negative := -1.0
+	small := 100.0
+	large := 1000.0
+
+	tests := []struct {
+		name       string
+		query      ListQuery
+		cursor     bool
+		wantFields []string
+	}{
+		{
+			// 期待値: ゼロ値（絞り込みなし・デフォルトの並び替え）はエラーなし
+			name:       "zero value",
+			query:      ListQuery{},
+			wantFields: nil,
+		},
+		{
+			// 期待値: 妥当な条件はエラーなし
+			name: "valid query",
+			query: ListQuery{
+				Filter: walk.WalkFilter{Statuses: []walk.WalkStatus{walk.StatusCompleted}, MinDistance: &small, MaxDistance: &large},
+				Sort:   walk.SortOption{Field: walk.SortByDistance, Order: walk.SortAsc},
+			},
+			wantFields: nil,
+		},
+		{
+			// 期待値: 未定義のステータス・並び替え項目・並び順はそれぞれ報告される
+			name: "undefined values",
+			query: ListQuery{
+				Filter: walk.WalkFilter{Statuses: []walk.WalkStatus{"running"}},
+				Sort:   walk.SortOption{Field: "title", Order: "up"},
+			},
+			wantFields: []string{"status", "sort", "order"},
+		},
+		{
+			// 期待値: 範囲の上下が逆転している場合は上限側のエラー
+			name: "reversed ranges",
+			query: ListQuery{
+				Filter: walk.WalkFilter{StartFrom: &from, StartTo: &to, MinDistance: &large, MaxDistance: &small},
+			},
+			wantFields: []string{"start_to", "max_distance"},
+		},
+		{
+			// 期待値: 負の距離はエラー
+			name:       "negative distance",
+			query:      ListQuery{Filter: walk.WalkFilter{MinDistance: &negative}},
+			wantFields: []string{"min_distance"},
+		},
+		{
+			// 期待値: カーソル方式では作成日時以外の並び替えはエラー
+			name:       "cursor with distance sort",
+			query:      ListQuery{Sort: walk.SortOption{Field: walk.SortByDistance}},
+			cursor:     true,
+			wantFields: []string{"sort"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateListQuery(tt.query, tt.cursor)
+
+			fields := make([]string, 0, len(errs))
+			for _, e := range errs {
+				fields = append(fields, e.Field)
+			}
+			assert.ElementsMatch(t, tt.wantFields, fields)
+		})
+	}
+}
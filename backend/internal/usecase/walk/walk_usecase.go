@@ -4,7 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/photo"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/privacy"
 	"github.com/RRRRRRR-777/TekuToko/backend/internal/domain/walk"
+	"github.com/RRRRRRR-777/TekuToko/backend/internal/pkg/trackfile"
 	"github.com/google/uuid"
 )
 
@@ -22,6 +25,7 @@ type UpdateWalkInput struct {
 	Title               *string
 	Description         *string
 	Status              *walk.WalkStatus
+	Visibility          *walk.Visibility
 	TotalSteps          *int
 	StartTime           *time.Time
 	EndTime             *time.Time
@@ -31,12 +35,95 @@ type UpdateWalkInput struct {
 	PausedAt            *time.Time
 	TotalPausedDuration *float64
 	Locations           []*walk.WalkLocation // 位置情報（オプション）
+	Tags                *[]string            // タグ名（指定した場合は置き換える。空の一覧ですべて外す）
+	IfMatch             *int                 // 更新対象の版数（指定した場合は一致しなければ walk.ErrVersionMismatch を返す）
+}
+
+// ImportWalkInput はトラックファイルからのWalk作成の入力
+type ImportWalkInput struct {
+	UserID      string
+	Title       string // 空の場合はファイル内のトラック名を使用する
+	Description string // 空の場合はファイル内の説明を使用する
+	Track       *trackfile.Track
+}
+
+// ListQuery は散歩一覧の絞り込み・並び替え条件
+// ゼロ値は絞り込みなし・作成日時の降順
+type ListQuery struct {
+	Filter walk.WalkFilter
+	Sort   walk.SortOption
+}
+
+// ListWalksByCursorInput はカーソル方式の散歩一覧取得の入力
+// カーソル方式は作成日時順の並び替えのみ対応する
+type ListWalksByCursorInput struct {
+	UserID       string
+	Query        ListQuery
+	Cursor       *walk.ListCursor // nilの場合は先頭から取得する
+	Limit        int
+	IncludeTotal bool // trueの場合のみ総件数を数える
+}
+
+// WalkPage はカーソル方式の散歩一覧の1ページ
+type WalkPage struct {
+	Walks      []*walk.Walk
+	NextCursor *walk.ListCursor // 次のページがない場合はnil
+	TotalCount *int             // IncludeTotalがfalseの場合はnil
 }
 
 // WalkWithLocations はWalkと位置情報をまとめた構造体
 type WalkWithLocations struct {
 	Walk      *walk.Walk
 	Locations []*walk.WalkLocation
+	Photos    []*photo.Photo
+}
+
+// TxManager は複数の書き込みを1つのトランザクションにまとめるインターフェース
+type TxManager interface {
+	// WithinTx は fn を1つのトランザクションで実行し、fn がエラーを返した場合は全ての書き込みを取り消す
+	// リポジトリには fn に渡されたコンテキストを渡すこと
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// RelationChecker は散歩の閲覧者と所有者の関係を判定するインターフェース
+type RelationChecker interface {
+	// IsBlocked は2人のどちらかがもう一方をブロックしているかどうかを返す
+	IsBlocked(ctx context.Context, userID, otherID string) (bool, error)
+	// AreFriends は2人が相互に承認済みのフォロー関係にあるかどうかを返す
+	AreFriends(ctx context.Context, userID, otherID string) (bool, error)
+}
+
+// ZoneLister は散歩の所有者のプライバシーゾーンを取得するインターフェース
+type ZoneLister interface {
+	// ListByUserID はユーザーのプライバシーゾーンを取得する
+	ListByUserID(ctx context.Context, userID string) ([]*privacy.Zone, error)
+}
+
+// PhotoAttacher は散歩に添付された写真を扱うインターフェース
+type PhotoAttacher interface {
+	// ListWalkPhotos は散歩の写真を取得する
+	ListWalkPhotos(ctx context.Context, walkID uuid.UUID) ([]*photo.Photo, error)
+	// RemoveObjects は写真の保存済みのファイルを削除する（失敗はログに残す）
+	RemoveObjects(ctx context.Context, photos []*photo.Photo)
+}
+
+// ThumbnailRemover は散歩のサムネイル画像を削除するインターフェース
+type ThumbnailRemover interface {
+	// RemoveThumbnail は散歩のサムネイル画像を削除する（失敗はログに残す）
+	RemoveThumbnail(ctx context.Context, w *walk.Walk)
+}
+
+// WalkExporter は散歩を外部フォーマットへ逐次書き出すインターフェース
+// 位置情報は1件ずつ渡されるため、実装側で全件をバッファしないこと
+type WalkExporter interface {
+	// WriteHeader は散歩のメタデータを書き出す（最初に1回だけ呼ばれる）
+	WriteHeader(w *walk.Walk) error
+
+	// WriteLocation は位置情報を1件書き出す（sequence_number順に呼ばれる）
+	WriteLocation(loc *walk.WalkLocation) error
+
+	// Close は書き出しを完了する
+	Close() error
 }
 
 // Usecase はWalkのユースケースインターフェース
@@ -45,19 +132,25 @@ type Usecase interface {
 	CreateWalk(ctx context.Context, input CreateWalkInput) (*walk.Walk, error)
 
 	// GetWalk はIDでWalkを取得する
+	// 他のユーザーの散歩は公開範囲で閲覧が許可されている場合のみ取得できる
 	GetWalk(ctx context.Context, id uuid.UUID, userID string) (*walk.Walk, error)
 
 	// GetWalkWithLocations はIDでWalkと位置情報を取得する
 	GetWalkWithLocations(ctx context.Context, id uuid.UUID, userID string) (*WalkWithLocations, error)
 
-	// ListWalks はユーザーのWalk一覧を取得する
-	ListWalks(ctx context.Context, userID string, limit, offset int) ([]*walk.Walk, int, error)
+	// ListWalks はユーザーのWalk一覧を絞り込み・並び替えて取得する
+	ListWalks(ctx context.Context, userID string, query ListQuery, limit, offset int) ([]*walk.Walk, int, error)
+
+	// ListWalksByCursor はユーザーのWalk一覧をカーソル方式で取得する
+	ListWalksByCursor(ctx context.Context, input ListWalksByCursorInput) (*WalkPage, error)
 
 	// UpdateWalk はWalkを更新する
+	// input.IfMatch を指定しない場合は版数の競合時に読み直して後勝ちで更新する
 	UpdateWalk(ctx context.Context, input UpdateWalkInput, userID string) (*walk.Walk, error)
 
 	// DeleteWalk はWalkを削除する
-	DeleteWalk(ctx context.Context, id uuid.UUID, userID string) error
+	// ifMatch を指定した場合は版数が一致しなければ walk.ErrVersionMismatch を返す
+	DeleteWalk(ctx context.Context, id uuid.UUID, userID string, ifMatch *int) error
 
 	// StartWalk は散歩を開始する
 	StartWalk(ctx context.Context, id uuid.UUID, userID string) (*walk.Walk, error)
@@ -70,4 +163,10 @@ type Usecase interface {
 
 	// CompleteWalk は散歩を完了する
 	CompleteWalk(ctx context.Context, id uuid.UUID, userID string) (*walk.Walk, error)
+
+	// ImportWalk はトラックファイルの経路から完了済みのWalkを作成する
+	ImportWalk(ctx context.Context, input ImportWalkInput) (*walk.Walk, error)
+
+	// ExportWalk は散歩と位置情報をエクスポーターへ逐次書き出す
+	ExportWalk(ctx context.Context, id uuid.UUID, userID string, exporter WalkExporter) error
 }
@@ -0,0 +1,9 @@
+-- walksテーブルにサーバー算出の計測値カラムを追加
+
+ALTER TABLE walks
+  ADD COLUMN moving_time DOUBLE PRECISION NOT NULL DEFAULT 0.0,   -- seconds（一時停止を除く）
+  ADD COLUMN average_pace DOUBLE PRECISION NOT NULL DEFAULT 0.0,  -- seconds per km
+  ADD COLUMN max_speed DOUBLE PRECISION NOT NULL DEFAULT 0.0,     -- meters per second
+  ADD CONSTRAINT chk_moving_time CHECK (moving_time >= 0),
+  ADD CONSTRAINT chk_average_pace CHECK (average_pace >= 0),
+  ADD CONSTRAINT chk_max_speed CHECK (max_speed >= 0);
@@ -0,0 +1,7 @@
+-- 散歩一覧のキーセットページネーション用インデックス
+-- (created_at, id) の行値比較とORDER BYを同一インデックスで処理する
+
+CREATE INDEX idx_walks_user_created_at_id ON walks(user_id, created_at DESC, id DESC)
+  WHERE user_id IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_walks_user_created_at;
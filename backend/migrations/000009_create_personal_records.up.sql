@@ -0,0 +1,25 @@
+-- personal_recordsテーブル
+-- 散歩の完了ごとに差分で更新する連続記録・自己ベスト（ユーザーごとに1行）
+
+CREATE TABLE personal_records (
+  user_id VARCHAR(255) PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  current_streak INTEGER NOT NULL DEFAULT 0,         -- last_walk_date で終わる連続日数
+  longest_streak INTEGER NOT NULL DEFAULT 0,
+  last_walk_date DATE,
+  longest_walk_id UUID REFERENCES walks(id) ON DELETE SET NULL,
+  longest_walk_distance DOUBLE PRECISION NOT NULL DEFAULT 0.0,  -- meters
+  fastest_km_walk_id UUID REFERENCES walks(id) ON DELETE SET NULL,
+  fastest_km_pace DOUBLE PRECISION,                  -- seconds per km（NULLは未記録）
+  most_steps_date DATE,
+  most_steps_in_day INTEGER NOT NULL DEFAULT 0,
+  updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT chk_streaks CHECK (current_streak >= 0 AND longest_streak >= current_streak),
+  CONSTRAINT chk_longest_walk_distance CHECK (longest_walk_distance >= 0),
+  CONSTRAINT chk_fastest_km_pace CHECK (fastest_km_pace IS NULL OR fastest_km_pace > 0),
+  CONSTRAINT chk_most_steps_in_day CHECK (most_steps_in_day >= 0)
+);
+
+-- 日ごとの集計で使用する完了済み散歩の開始時刻インデックス
+CREATE INDEX idx_walks_user_completed_start_time ON walks(user_id, start_time)
+  WHERE status = 'completed';
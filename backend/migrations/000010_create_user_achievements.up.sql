@@ -0,0 +1,15 @@
+-- user_achievementsテーブル
+-- ユーザーが獲得した実績（バッジ）。実績の定義はアプリケーション側で管理する
+
+CREATE TABLE user_achievements (
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  code VARCHAR(100) NOT NULL,
+  walk_id UUID REFERENCES walks(id) ON DELETE SET NULL,  -- 獲得のきっかけになった散歩
+  awarded_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+  -- 同じ実績を二重に付与しない
+  PRIMARY KEY (user_id, code)
+);
+
+-- インデックス
+CREATE INDEX idx_user_achievements_user_awarded_at ON user_achievements(user_id, awarded_at);
@@ -0,0 +1,40 @@
+-- フォロー・ブロック関係
+
+-- フォローステータス
+CREATE TYPE follow_status AS ENUM (
+  'pending',
+  'accepted'
+);
+
+-- followsテーブル（follower_id が followee_id をフォローする）
+CREATE TABLE follows (
+  follower_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  followee_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  status follow_status NOT NULL DEFAULT 'pending',
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+  PRIMARY KEY (follower_id, followee_id),
+  CONSTRAINT chk_follows_not_self CHECK (follower_id <> followee_id)
+);
+
+-- blocksテーブル（blocker_id が blocked_id をブロックする）
+CREATE TABLE blocks (
+  blocker_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  blocked_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+  PRIMARY KEY (blocker_id, blocked_id),
+  CONSTRAINT chk_blocks_not_self CHECK (blocker_id <> blocked_id)
+);
+
+-- インデックス
+-- 主キーで引けない逆方向（フォローされている側・ブロックされている側）の検索用
+CREATE INDEX idx_follows_followee_status ON follows(followee_id, status);
+CREATE INDEX idx_blocks_blocked ON blocks(blocked_id);
+
+-- followsテーブル用トリガー
+CREATE TRIGGER update_follows_updated_at
+  BEFORE UPDATE ON follows
+  FOR EACH ROW
+  EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,61 @@
+-- タグ・コレクション
+
+-- tagsテーブル（タグはユーザーごとに管理し、名前は大文字小文字を区別せず一意）
+CREATE TABLE tags (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name VARCHAR(50) NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT chk_tags_name_not_blank CHECK (BTRIM(name) <> '')
+);
+
+-- walk_tagsテーブル（散歩とタグの多対多）
+CREATE TABLE walk_tags (
+  walk_id UUID NOT NULL REFERENCES walks(id) ON DELETE CASCADE,
+  tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+  PRIMARY KEY (walk_id, tag_id)
+);
+
+-- collectionsテーブル（ユーザーが名前を付けて散歩をまとめたもの）
+CREATE TABLE collections (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name VARCHAR(100) NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- collection_walksテーブル（コレクション内の散歩と並び順）
+CREATE TABLE collection_walks (
+  collection_id UUID NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+  walk_id UUID NOT NULL REFERENCES walks(id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+
+  PRIMARY KEY (collection_id, walk_id),
+  CONSTRAINT chk_collection_walks_position CHECK (position >= 0)
+);
+
+-- インデックス
+CREATE UNIQUE INDEX idx_tags_user_name ON tags(user_id, LOWER(name));
+-- タグで散歩を絞り込む際の逆方向の検索用
+CREATE INDEX idx_walk_tags_tag ON walk_tags(tag_id);
+CREATE INDEX idx_collections_user_created_at ON collections(user_id, created_at DESC);
+CREATE INDEX idx_collection_walks_position ON collection_walks(collection_id, position);
+CREATE INDEX idx_collection_walks_walk ON collection_walks(walk_id);
+
+-- tagsテーブル用トリガー
+CREATE TRIGGER update_tags_updated_at
+  BEFORE UPDATE ON tags
+  FOR EACH ROW
+  EXECUTE FUNCTION update_updated_at_column();
+
+-- collectionsテーブル用トリガー
+CREATE TRIGGER update_collections_updated_at
+  BEFORE UPDATE ON collections
+  FOR EACH ROW
+  EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,12 @@
+-- 散歩の公開範囲
+
+-- 公開範囲（private: 本人のみ / friends: 本人と友達 / public_link: 散歩のIDを知っている全ユーザー）
+CREATE TYPE walk_visibility AS ENUM (
+  'private',
+  'friends',
+  'public_link'
+);
+
+-- 既存の散歩は本人のみ閲覧可能とする
+ALTER TABLE walks
+  ADD COLUMN visibility walk_visibility NOT NULL DEFAULT 'private';
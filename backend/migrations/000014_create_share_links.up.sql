@@ -0,0 +1,13 @@
+-- 散歩の共有リンク
+
+-- share_linksテーブル（トークンはIDから署名付きで発行するため保存しない）
+CREATE TABLE share_links (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  walk_id UUID NOT NULL REFERENCES walks(id) ON DELETE CASCADE,
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  expires_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- インデックス
+CREATE INDEX idx_share_links_walk_created_at ON share_links(walk_id, created_at DESC);
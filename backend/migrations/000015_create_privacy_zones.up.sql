@@ -0,0 +1,19 @@
+-- プライバシーゾーン
+
+-- privacy_zonesテーブル（所有者以外には範囲内の位置情報を見せない円形の範囲）
+CREATE TABLE privacy_zones (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name VARCHAR(50) NOT NULL DEFAULT '',
+  latitude DOUBLE PRECISION NOT NULL,
+  longitude DOUBLE PRECISION NOT NULL,
+  radius_meters DOUBLE PRECISION NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT chk_privacy_zones_latitude CHECK (latitude BETWEEN -90 AND 90),
+  CONSTRAINT chk_privacy_zones_longitude CHECK (longitude BETWEEN -180 AND 180),
+  CONSTRAINT chk_privacy_zones_radius CHECK (radius_meters > 0)
+);
+
+-- インデックス
+CREATE INDEX idx_privacy_zones_user_created_at ON privacy_zones(user_id, created_at);
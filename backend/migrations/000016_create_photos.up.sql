@@ -0,0 +1,23 @@
+-- 散歩の写真
+
+-- photosテーブル（ファイル本体はストレージに保存し、パスとURLのみ保持する）
+CREATE TABLE photos (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  walk_id UUID NOT NULL REFERENCES walks(id) ON DELETE CASCADE,
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  storage_path TEXT NOT NULL,
+  url TEXT NOT NULL,
+  content_type VARCHAR(50) NOT NULL,
+  captured_at TIMESTAMP,
+  latitude DOUBLE PRECISION,
+  longitude DOUBLE PRECISION,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT chk_photos_latitude CHECK (latitude BETWEEN -90 AND 90),
+  CONSTRAINT chk_photos_longitude CHECK (longitude BETWEEN -180 AND 180),
+  -- 撮影場所は緯度・経度の両方があるか、どちらもないかのいずれか
+  CONSTRAINT chk_photos_location CHECK ((latitude IS NULL) = (longitude IS NULL))
+);
+
+-- インデックス
+CREATE INDEX idx_photos_walk_id ON photos(walk_id);
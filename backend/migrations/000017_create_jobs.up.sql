@@ -0,0 +1,23 @@
+-- バックグラウンドジョブのキュー
+
+-- jobsテーブル（成功したジョブは削除し、デッドレターのみ残す）
+CREATE TABLE jobs (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  type VARCHAR(100) NOT NULL,
+  payload JSONB NOT NULL DEFAULT '{}',
+  status VARCHAR(20) NOT NULL DEFAULT 'pending',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  max_attempts INTEGER NOT NULL,
+  run_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  last_error TEXT,
+  locked_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT chk_jobs_status CHECK (status IN ('pending', 'running', 'dead')),
+  CONSTRAINT chk_jobs_attempts CHECK (attempts >= 0 AND max_attempts >= 1)
+);
+
+-- インデックス（ワーカーが実行待ちのジョブ・取得期限切れのジョブを探す）
+CREATE INDEX idx_jobs_pending_run_at ON jobs(run_at) WHERE status = 'pending';
+CREATE INDEX idx_jobs_running_locked_at ON jobs(locked_at) WHERE status = 'running';
@@ -0,0 +1,24 @@
+-- ドメインイベントのアウトボックス
+
+-- outbox_eventsテーブル（散歩の保存と同じトランザクションで書き込み、配信に成功したものは削除する）
+-- 削除された散歩のイベントも配信するため、walksへの外部キーは持たない
+CREATE TABLE outbox_events (
+  id BIGSERIAL PRIMARY KEY,
+  walk_id UUID NOT NULL,
+  user_id VARCHAR(255) NOT NULL,
+  event_name VARCHAR(100) NOT NULL,
+  payload JSONB NOT NULL,
+  occurred_at TIMESTAMP NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'pending',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  last_error TEXT,
+  locked_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT chk_outbox_events_status CHECK (status IN ('pending', 'dead'))
+);
+
+-- インデックス（ディスパッチャーが配信待ちのイベントを散歩ごとに発生順で探す）
+CREATE INDEX idx_outbox_events_pending ON outbox_events(walk_id, id) WHERE status = 'pending';
+CREATE INDEX idx_outbox_events_next_attempt_at ON outbox_events(next_attempt_at) WHERE status = 'pending';
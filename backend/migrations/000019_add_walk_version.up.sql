@@ -0,0 +1,7 @@
+-- walksテーブルに楽観的排他制御の版数カラムを追加
+
+-- 散歩を更新するたびに1増やし、APIではETagとして返す
+-- サーバーが生成するサムネイルの更新でも増やし、古い内容による上書きを防ぐ
+ALTER TABLE walks
+  ADD COLUMN version INTEGER NOT NULL DEFAULT 1,
+  ADD CONSTRAINT chk_walks_version CHECK (version >= 1);
@@ -0,0 +1,10 @@
+-- jobsテーブルの日時をタイムゾーン付きにする
+
+-- 実行日時はアプリケーション（Goの時刻）で計算し、NOW()（DBセッションのタイムゾーン）と比較する
+-- タイムゾーンなしの列では両者のタイムゾーンが異なると実行日時がずれるため、時刻そのものを保存する
+-- 既存の値はDBセッションのタイムゾーンの時刻として解釈する
+ALTER TABLE jobs
+  ALTER COLUMN run_at TYPE TIMESTAMPTZ,
+  ALTER COLUMN locked_at TYPE TIMESTAMPTZ,
+  ALTER COLUMN created_at TYPE TIMESTAMPTZ,
+  ALTER COLUMN updated_at TYPE TIMESTAMPTZ;
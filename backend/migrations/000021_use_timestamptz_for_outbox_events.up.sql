@@ -0,0 +1,10 @@
+-- outbox_eventsテーブルの日時をタイムゾーン付きにする
+
+-- 再配信日時はアプリケーション（Goの時刻）で計算し、NOW()（DBセッションのタイムゾーン）と比較する
+-- タイムゾーンなしの列では両者のタイムゾーンが異なると再配信日時がずれるため、時刻そのものを保存する
+-- 既存の値はDBセッションのタイムゾーンの時刻として解釈する
+ALTER TABLE outbox_events
+  ALTER COLUMN occurred_at TYPE TIMESTAMPTZ,
+  ALTER COLUMN next_attempt_at TYPE TIMESTAMPTZ,
+  ALTER COLUMN locked_at TYPE TIMESTAMPTZ,
+  ALTER COLUMN created_at TYPE TIMESTAMPTZ;